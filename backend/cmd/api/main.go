@@ -160,6 +160,44 @@ func main() {
 
 	logger.Info("services inicializados")
 
+	// ============================================
+	// 7.1 INICIALIZAR AGENDADOR DE JOBS
+	// ============================================
+	// Cada job roda em uma única réplica por vez (advisory lock no PostgreSQL)
+	jobScheduler := service.NewJobScheduler(repos.JobRun, repos.DB, logger,
+		service.Job{
+			Name:        "expire-reservations",
+			Description: "Expira reservas ativas com prazo vencido e devolve as chapas ao estoque",
+			Interval:    time.Hour,
+			Run:         services.Reservation.ExpireReservations,
+		},
+		service.Job{
+			Name:        "expire-pending-approvals",
+			Description: "Expira reservas pendentes cujo prazo de aprovação venceu",
+			Interval:    15 * time.Minute,
+			Run:         services.Reservation.ExpirePendingApprovals,
+		},
+		service.Job{
+			Name:        "reservation-reminders",
+			Description: "Avisa por email reservas e prazos de aprovação prestes a vencer",
+			Interval:    15 * time.Minute,
+			Run:         services.Reservation.SendReservationReminders,
+		},
+		service.Job{
+			Name:        "apply-price-schedules",
+			Description: "Aplica as alterações de preço agendadas com vigência vencida",
+			Interval:    5 * time.Minute,
+			Run:         services.Price.ApplyScheduled,
+		},
+		service.Job{
+			Name:        "stock-alerts",
+			Description: "Detecta lotes com estoque baixo/parado e notifica os administradores",
+			Interval:    time.Hour,
+			Run:         services.StockAlert.CheckAlerts,
+		},
+	)
+	services.Jobs = jobScheduler
+
 	// ============================================
 	// 8. INICIALIZAR MIDDLEWARES
 	// ============================================
@@ -192,12 +230,12 @@ func main() {
 	logger.Info("router configurado")
 
 	// ============================================
-	// 10.1 INICIAR JOB DE EXPIRAÇÃO DE RESERVAS
+	// 10.1 INICIAR AGENDADOR DE JOBS
 	// ============================================
-	reservationExpirationCtx, cancelExpiration := context.WithCancel(context.Background())
-	go startReservationExpirationJob(reservationExpirationCtx, services.Reservation, logger)
+	jobSchedulerCtx, cancelJobScheduler := context.WithCancel(context.Background())
+	jobScheduler.Start(jobSchedulerCtx)
 
-	logger.Info("job de expiração de reservas iniciado")
+	logger.Info("agendador de jobs iniciado")
 
 	// ============================================
 	// 10.2 INICIAR CLEANUP DE RATE LIMITERS
@@ -255,8 +293,8 @@ func main() {
 			zap.String("signal", sig.String()),
 		)
 
-		// Cancelar job de expiração de reservas
-		cancelExpiration()
+		// Cancelar agendador de jobs
+		cancelJobScheduler()
 
 		// Cancelar cleanup de rate limiters
 		cancelRateLimiterCleanup()
@@ -313,13 +351,33 @@ type Repositories struct {
 	Session                 domainRepo.SessionRepository
 	Product                 domainRepo.ProductRepository
 	Batch                   domainRepo.BatchRepository
+	Slab                    domainRepo.SlabRepository
+	BatchMovement           domainRepo.BatchMovementRepository
+	BatchCodeSequence       domainRepo.BatchCodeSequenceRepository
+	BatchLineage            domainRepo.BatchLineageRepository
+	Block                   domainRepo.BlockRepository
+	Warehouse               domainRepo.WarehouseRepository
+	BatchTransfer           domainRepo.BatchTransferRepository
+	InventoryCount          domainRepo.InventoryCountRepository
+	DamageReport            domainRepo.DamageReportRepository
+	Remnant                 domainRepo.RemnantRepository
+	StockAlert              domainRepo.StockAlertRepository
+	JobRun                  domainRepo.JobRunRepository
+	PriceHistory            domainRepo.PriceHistoryRepository
+	PriceList               domainRepo.PriceListRepository
+	ExchangeRate            domainRepo.ExchangeRateRepository
 	Media                   domainRepo.MediaRepository
 	Reservation             domainRepo.ReservationRepository
+	ReservationWaitlist     domainRepo.ReservationWaitlistRepository
+	ReservationExtension    domainRepo.ReservationExtensionRepository
+	ReservationEvent        domainRepo.ReservationEventRepository
+	ReservationOffer        domainRepo.ReservationOfferRepository
 	SalesLink               domainRepo.SalesLinkRepository
 	CatalogLink             domainRepo.CatalogLinkRepository
 	Cliente                 domainRepo.ClienteRepository
 	ClienteInteraction      domainRepo.ClienteInteractionRepository
 	SalesHistory            domainRepo.SalesHistoryRepository
+	PackingList             domainRepo.PackingListRepository
 	SharedInventory         domainRepo.SharedInventoryRepository
 	Industry                domainRepo.IndustryRepository
 	BI                      domainRepo.BIRepository
@@ -334,13 +392,33 @@ func initRepositories(db *repository.DB) Repositories {
 		Session:                 repository.NewSessionRepository(db),
 		Product:                 repository.NewProductRepository(db),
 		Batch:                   repository.NewBatchRepository(db),
+		Slab:                    repository.NewSlabRepository(db),
+		BatchMovement:           repository.NewBatchMovementRepository(db),
+		BatchCodeSequence:       repository.NewBatchCodeSequenceRepository(db),
+		BatchLineage:            repository.NewBatchLineageRepository(db),
+		Block:                   repository.NewBlockRepository(db),
+		Warehouse:               repository.NewWarehouseRepository(db),
+		BatchTransfer:           repository.NewBatchTransferRepository(db),
+		InventoryCount:          repository.NewInventoryCountRepository(db),
+		DamageReport:            repository.NewDamageReportRepository(db),
+		Remnant:                 repository.NewRemnantRepository(db),
+		StockAlert:              repository.NewStockAlertRepository(db),
+		JobRun:                  repository.NewJobRunRepository(db),
+		PriceHistory:            repository.NewPriceHistoryRepository(db),
+		PriceList:               repository.NewPriceListRepository(db),
+		ExchangeRate:            repository.NewExchangeRateRepository(db),
 		Media:                   repository.NewMediaRepository(db),
 		Reservation:             repository.NewReservationRepository(db),
+		ReservationWaitlist:     repository.NewReservationWaitlistRepository(db),
+		ReservationExtension:    repository.NewReservationExtensionRepository(db),
+		ReservationEvent:        repository.NewReservationEventRepository(db),
+		ReservationOffer:        repository.NewReservationOfferRepository(db),
 		SalesLink:               repository.NewSalesLinkRepository(db),
 		CatalogLink:             repository.NewCatalogLinkRepository(db),
 		Cliente:                 repository.NewClienteRepository(db),
 		ClienteInteraction:      repository.NewClienteInteractionRepository(db),
 		SalesHistory:            repository.NewSalesHistoryRepository(db),
+		PackingList:             repository.NewPackingListRepository(db),
 		SharedInventory:         repository.NewSharedInventoryRepository(db),
 		Industry:                repository.NewIndustryRepository(db),
 		BI:                      repository.NewBIRepository(db),
@@ -383,15 +461,36 @@ func initServices(
 	productService := service.NewProductService(
 		repos.Product,
 		repos.Media,
+		repos.PriceHistory,
+		repos.DB,
 		logger,
 	)
 
-	// Batch Service
-	batchService := service.NewBatchService(
+	// Storage Service
+	storageService := service.NewStorageService(
+		s3Adapter,
+		cfg.Storage.BucketName,
+		logger,
+	)
+
+	// Packing List Service (romaneios das vendas)
+	packingListService := service.NewPackingListService(
+		repos.PackingList,
+		repos.SalesHistory,
 		repos.Batch,
 		repos.Product,
-		repos.Media,
-		repos.SalesHistory,
+		repos.Slab,
+		repos.Remnant,
+		repos.Industry,
+		storageService,
+		logger,
+	)
+
+	// Price List Service
+	priceListService := service.NewPriceListService(
+		repos.PriceList,
+		repos.Product,
+		repos.User,
 		repos.Cliente,
 		repos.DB,
 		logger,
@@ -401,9 +500,119 @@ func initServices(
 	reservationService := service.NewReservationService(
 		repos.Reservation,
 		repos.Batch,
+		repos.Remnant,
 		repos.Cliente,
 		repos.SalesHistory,
 		repos.User,
+		repos.SharedInventory,
+		repos.Slab,
+		repos.BatchMovement,
+		priceListService,
+		packingListService,
+		repos.ReservationWaitlist,
+		repos.ReservationExtension,
+		repos.Industry,
+		repos.ReservationEvent,
+		repos.ReservationOffer,
+		emailSender,
+		cfg.Server.FrontendURL,
+		repos.DB,
+		logger,
+	)
+
+	// Batch Service
+	batchService := service.NewBatchService(
+		repos.Batch,
+		repos.Product,
+		repos.Media,
+		repos.SalesHistory,
+		repos.Cliente,
+		repos.Slab,
+		repos.BatchMovement,
+		repos.Industry,
+		repos.BatchCodeSequence,
+		repos.BatchLineage,
+		repos.Block,
+		repos.Warehouse,
+		repos.BatchTransfer,
+		repos.PriceHistory,
+		packingListService,
+		reservationService,
+		repos.DB,
+		logger,
+	)
+
+	// Batch Label Service
+	batchLabelService := service.NewBatchLabelService(
+		repos.Batch,
+		cfg.Server.FrontendURL,
+		logger,
+	)
+
+	// Block Service
+	blockService := service.NewBlockService(
+		repos.Block,
+		repos.Batch,
+		repos.Slab,
+		repos.BatchLineage,
+		logger,
+	)
+
+	// Warehouse Service
+	warehouseService := service.NewWarehouseService(
+		repos.Warehouse,
+		logger,
+	)
+
+	// Inventory Count Service
+	inventoryCountService := service.NewInventoryCountService(
+		repos.InventoryCount,
+		repos.Product,
+		batchService,
+		reservationService,
+		repos.DB,
+		logger,
+	)
+
+	// Damage Report Service
+	damageReportService := service.NewDamageReportService(
+		repos.DamageReport,
+		repos.Batch,
+		batchService,
+		repos.DB,
+		logger,
+	)
+
+	// Remnant Service
+	remnantService := service.NewRemnantService(
+		repos.Remnant,
+		repos.Batch,
+		repos.DB,
+		logger,
+	)
+
+	// Stock Alert Service
+	stockAlertService := service.NewStockAlertService(
+		repos.StockAlert,
+		repos.Industry,
+		repos.User,
+		emailSender,
+		cfg.Server.FrontendURL,
+		logger,
+	)
+
+	// Price Service
+	priceService := service.NewPriceService(
+		repos.PriceHistory,
+		repos.Product,
+		repos.Batch,
+		repos.DB,
+		logger,
+	)
+
+	// Exchange Rate Service
+	exchangeRateService := service.NewExchangeRateService(
+		repos.ExchangeRate,
 		repos.DB,
 		logger,
 	)
@@ -426,6 +635,8 @@ func initServices(
 		repos.Media,
 		repos.User,
 		repos.SharedInventory,
+		priceListService,
+		exchangeRateService,
 		cfg.App.PublicLinkBaseURL,
 		logger,
 	)
@@ -437,6 +648,7 @@ func initServices(
 		repos.Product,
 		repos.Media,
 		repos.Industry,
+		exchangeRateService,
 		cfg.App.PublicLinkBaseURL,
 		logger,
 	)
@@ -461,6 +673,9 @@ func initServices(
 		repos.Batch,
 		repos.User,
 		repos.Cliente,
+		repos.Slab,
+		repos.BatchMovement,
+		reservationService,
 		repos.DB,
 		logger,
 	)
@@ -472,13 +687,7 @@ func initServices(
 		repos.User,
 		repos.Media,
 		repos.Product,
-		logger,
-	)
-
-	// Storage Service
-	storageService := service.NewStorageService(
-		s3Adapter,
-		cfg.Storage.BucketName,
+		priceListService,
 		logger,
 	)
 
@@ -493,12 +702,23 @@ func initServices(
 		User:                  userService,
 		Product:               productService,
 		Batch:                 batchService,
+		BatchLabel:            batchLabelService,
+		Block:                 blockService,
+		Warehouse:             warehouseService,
+		InventoryCount:        inventoryCountService,
+		DamageReport:          damageReportService,
+		Remnant:               remnantService,
+		StockAlert:            stockAlertService,
+		Price:                 priceService,
+		PriceList:             priceListService,
+		ExchangeRate:          exchangeRateService,
 		Reservation:           reservationService,
 		Dashboard:             dashboardService,
 		SalesLink:             salesLinkService,
 		CatalogLink:           catalogLinkService,
 		Cliente:               clienteService,
 		SalesHistory:          salesHistoryService,
+		PackingList:           packingListService,
 		SharedInventory:       sharedInventoryService,
 		Storage:               storageService,
 		BI:                    biService,
@@ -562,32 +782,6 @@ func (a *dbExecutorAdapter) ExecuteInTx(ctx context.Context, fn func(tx interfac
 	})
 }
 
-// startReservationExpirationJob executa periodicamente a expiração de reservas vencidas
-func startReservationExpirationJob(ctx context.Context, reservationService domainService.ReservationService, logger *zap.Logger) {
-	ticker := time.NewTicker(1 * time.Hour) // Executar a cada hora
-	defer ticker.Stop()
-
-	logger.Info("job de expiração de reservas configurado para executar a cada 1 hora")
-
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info("job de expiração de reservas encerrado")
-			return
-		case <-ticker.C:
-			logger.Info("executando job de expiração de reservas")
-			count, err := reservationService.ExpireReservations(ctx)
-			if err != nil {
-				logger.Error("erro ao executar job de expiração", zap.Error(err))
-			} else {
-				logger.Info("job de expiração de reservas concluído",
-					zap.Int("expiredCount", count),
-				)
-			}
-		}
-	}
-}
-
 // runMigrations executa as migrations pendentes usando golang-migrate
 func runMigrations(cfg *config.Config, logger *zap.Logger) error {
 	// Usar URL no formato aceito pelo driver postgres do migrate
@@ -93,34 +93,106 @@ func (b BatchCode) String() string {
 	return string(b)
 }
 
+// BatchCodeMaxSequence é o maior número sequencial suportado pelo formato AAA-999999
+const BatchCodeMaxSequence = 999999
+
+// FormatBatchCode monta o código de lote a partir do prefixo e do número sequencial
+func FormatBatchCode(prefix string, sequence int) BatchCode {
+	return BatchCode(fmt.Sprintf("%s-%06d", prefix, sequence))
+}
+
+var batchCodePrefixReplacer = strings.NewReplacer(
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A", "Ä", "A",
+	"É", "E", "Ê", "E", "È", "E", "Ë", "E",
+	"Í", "I", "Î", "I", "Ì", "I", "Ï", "I",
+	"Ó", "O", "Ô", "O", "Õ", "O", "Ò", "O", "Ö", "O",
+	"Ú", "U", "Û", "U", "Ù", "U", "Ü", "U",
+	"Ç", "C",
+)
+
+// NewBatchCodePrefix normaliza um texto em prefixo de 3 letras (sem acentos, completado com X)
+func NewBatchCodePrefix(text string) string {
+	text = batchCodePrefixReplacer.Replace(strings.ToUpper(text))
+
+	var b strings.Builder
+	for _, r := range text {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteRune(r)
+			if b.Len() == 3 {
+				break
+			}
+		}
+	}
+	for b.Len() < 3 {
+		b.WriteRune('X')
+	}
+	return b.String()
+}
+
+// ResolveBatchCodePrefix determina o prefixo do código de lote conforme a configuração da indústria
+func ResolveBatchCodePrefix(settings BatchCodeSettings, productName string, material MaterialType) string {
+	switch settings.PrefixSource {
+	case BatchCodePrefixFixo:
+		if settings.FixedPrefix != nil && *settings.FixedPrefix != "" {
+			return NewBatchCodePrefix(*settings.FixedPrefix)
+		}
+	case BatchCodePrefixProduto:
+		if productName != "" {
+			return NewBatchCodePrefix(productName)
+		}
+	}
+	return NewBatchCodePrefix(string(material))
+}
+
+// NextBatchCodeInput representa os dados para prévia do próximo código de lote
+type NextBatchCodeInput struct {
+	ProductID   *string       `json:"productId,omitempty" validate:"omitempty,uuid"`
+	ProductName *string       `json:"productName,omitempty" validate:"omitempty,max=100"`
+	Material    *MaterialType `json:"material,omitempty" validate:"omitempty,oneof=GRANITO MARMORE QUARTZITO LIMESTONE TRAVERTINO OUTROS"`
+}
+
+// NextBatchCodeResponse representa a prévia do próximo código de lote automático
+type NextBatchCodeResponse struct {
+	BatchCode    string                `json:"batchCode"`
+	Prefix       string                `json:"prefix"`
+	Sequence     int                   `json:"sequence"`
+	PrefixSource BatchCodePrefixSource `json:"prefixSource"`
+}
+
 // Batch representa um lote físico de estoque
 type Batch struct {
-	ID             string      `json:"id"`
-	ProductID      string      `json:"productId"`
-	IndustryID     string      `json:"industryId"`
-	BatchCode      string      `json:"batchCode"`
-	Height         float64     `json:"height"`         // cm
-	Width          float64     `json:"width"`          // cm
-	Thickness      float64     `json:"thickness"`      // cm
-	QuantitySlabs  int         `json:"quantitySlabs"`  // quantidade total de chapas
-	AvailableSlabs int         `json:"availableSlabs"` // quantidade de chapas disponíveis
-	ReservedSlabs  int         `json:"reservedSlabs"`  // quantidade de chapas reservadas
-	SoldSlabs      int         `json:"soldSlabs"`      // quantidade de chapas vendidas
-	InactiveSlabs  int         `json:"inactiveSlabs"`  // quantidade de chapas inativas
-	TotalArea      float64     `json:"totalArea"`      // m² (calculado)
-	IndustryPrice  float64     `json:"industryPrice"`  // preço por unidade de área (m² ou ft²)
-	PriceUnit      PriceUnit   `json:"priceUnit"`      // unidade de preço (M2 ou FT2)
-	PriceOverride  bool        `json:"priceOverride"`  // se TRUE, preço foi definido manualmente; se FALSE, usa preço do produto
-	OriginQuarry   *string     `json:"originQuarry,omitempty"`
-	EntryDate      time.Time   `json:"entryDate"`
-	Status         BatchStatus `json:"status"`
-	IsActive       bool        `json:"isActive"`
-	IsPublic       bool        `json:"isPublic"` // visível na página pública do depósito
-	Medias         []Media     `json:"medias,omitempty"`
-	Product        *Product    `json:"product,omitempty"` // Populated quando necessário
-	CreatedAt      time.Time   `json:"createdAt"`
-	UpdatedAt      time.Time   `json:"updatedAt"`
-	DeletedAt      *time.Time  `json:"deletedAt,omitempty"` // soft delete
+	ID             string         `json:"id"`
+	ProductID      string         `json:"productId"`
+	IndustryID     string         `json:"industryId"`
+	BatchCode      string         `json:"batchCode"`
+	Height         float64        `json:"height"`         // cm
+	Width          float64        `json:"width"`          // cm
+	Thickness      float64        `json:"thickness"`      // cm
+	QuantitySlabs  int            `json:"quantitySlabs"`  // quantidade total de chapas
+	AvailableSlabs int            `json:"availableSlabs"` // quantidade de chapas disponíveis
+	ReservedSlabs  int            `json:"reservedSlabs"`  // quantidade de chapas reservadas
+	SoldSlabs      int            `json:"soldSlabs"`      // quantidade de chapas vendidas
+	InactiveSlabs  int            `json:"inactiveSlabs"`  // quantidade de chapas inativas
+	TotalArea      float64        `json:"totalArea"`      // m² (calculado)
+	IndustryPrice  float64        `json:"industryPrice"`  // preço por unidade de área (m² ou ft²)
+	PriceUnit      PriceUnit      `json:"priceUnit"`      // unidade de preço (M2 ou FT2)
+	Currency       Currency       `json:"currency"`       // moeda do preço (BRL, USD ou EUR)
+	PriceOverride  bool           `json:"priceOverride"`  // se TRUE, preço foi definido manualmente; se FALSE, usa preço do produto
+	OriginQuarry   *string        `json:"originQuarry,omitempty"`
+	BlockID        *string        `json:"blockId,omitempty"` // bloco de pedreira de origem
+	WarehouseID    *string        `json:"warehouseId,omitempty"`
+	LocationID     *string        `json:"locationId,omitempty"`
+	Location       *BatchLocation `json:"location,omitempty"` // Populated com nome do depósito e código da posição
+	EntryDate      time.Time      `json:"entryDate"`
+	Status         BatchStatus    `json:"status"`
+	IsActive       bool           `json:"isActive"`
+	IsPublic       bool           `json:"isPublic"` // visível na página pública do depósito
+	Medias         []Media        `json:"medias,omitempty"`
+	Slabs          []Slab         `json:"slabs,omitempty"`   // Populated quando o lote possui rastreamento por chapa
+	Product        *Product       `json:"product,omitempty"` // Populated quando necessário
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt"`
+	DeletedAt      *time.Time     `json:"deletedAt,omitempty"` // soft delete
 
 	// Campos calculados (não persistidos, preenchidos na API)
 	SlabArea  float64 `json:"slabArea,omitempty"`  // área de uma chapa em m² (calculado)
@@ -185,14 +257,18 @@ func (b *Batch) CalculatePriceForSlabs(quantity int) float64 {
 // CreateBatchInput representa os dados para criar um lote
 type CreateBatchInput struct {
 	ProductID     *string   `json:"productId,omitempty" validate:"omitempty,uuid"`
-	BatchCode     string    `json:"batchCode" validate:"required,batchcode"` // AAA-999999
+	BatchCode     string    `json:"batchCode,omitempty" validate:"omitempty,batchcode"` // AAA-999999 (vazio = gerado automaticamente)
 	Height        float64   `json:"height" validate:"required,gt=0,lte=1000"`
 	Width         float64   `json:"width" validate:"required,gt=0,lte=1000"`
 	Thickness     float64   `json:"thickness" validate:"required,gt=0,lte=100"`
 	QuantitySlabs int       `json:"quantitySlabs" validate:"required,gt=0"`
 	IndustryPrice float64   `json:"industryPrice" validate:"required,gt=0"`
 	PriceUnit     PriceUnit `json:"priceUnit" validate:"omitempty,oneof=M2 FT2"`
+	Currency      Currency  `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
 	OriginQuarry  *string   `json:"originQuarry,omitempty" validate:"omitempty,max=100"`
+	BlockID       *string   `json:"blockId,omitempty" validate:"omitempty,uuid"`
+	WarehouseID   *string   `json:"warehouseId,omitempty" validate:"omitempty,uuid"` // alocação inicial
+	LocationID    *string   `json:"locationId,omitempty" validate:"omitempty,uuid"`
 	EntryDate     string    `json:"entryDate" validate:"required"` // ISO date
 	// Inline product creation support
 	NewProduct *CreateProductInlineInput `json:"newProduct,omitempty"`
@@ -217,7 +293,9 @@ type UpdateBatchInput struct {
 	QuantitySlabs *int       `json:"quantitySlabs,omitempty" validate:"omitempty,gt=0"`
 	IndustryPrice *float64   `json:"industryPrice,omitempty" validate:"omitempty,gt=0"`
 	PriceUnit     *PriceUnit `json:"priceUnit,omitempty" validate:"omitempty,oneof=M2 FT2"`
+	Currency      *Currency  `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
 	OriginQuarry  *string    `json:"originQuarry,omitempty" validate:"omitempty,max=100"`
+	BlockID       *string    `json:"blockId,omitempty" validate:"omitempty,uuid"` // "" desvincula o bloco
 	IsPublic      *bool      `json:"isPublic,omitempty"`
 }
 
@@ -228,18 +306,26 @@ type UpdateBatchStatusInput struct {
 
 // UpdateBatchAvailabilityInput representa os dados para ajustar disponibilidade/estado por quantidade
 type UpdateBatchAvailabilityInput struct {
-	Status     BatchStatus  `json:"status" validate:"required,oneof=DISPONIVEL RESERVADO VENDIDO INATIVO"`
-	FromStatus *BatchStatus `json:"fromStatus,omitempty" validate:"omitempty,oneof=DISPONIVEL RESERVADO VENDIDO INATIVO"`
-	Quantity   int          `json:"quantity" validate:"required,gt=0"`
+	Status     BatchStatus     `json:"status" validate:"required,oneof=DISPONIVEL RESERVADO VENDIDO INATIVO"`
+	FromStatus *BatchStatus    `json:"fromStatus,omitempty" validate:"omitempty,oneof=DISPONIVEL RESERVADO VENDIDO INATIVO"`
+	Quantity   int             `json:"quantity" validate:"required,gt=0"`
+	Reason     *MovementReason `json:"reason,omitempty" validate:"omitempty,oneof=AJUSTE_MANUAL AVARIA INVENTARIO"`
+	Notes      *string         `json:"notes,omitempty" validate:"omitempty,max=500"`
 }
 
 // BatchFilters representa os filtros para busca de lotes
 type BatchFilters struct {
+	IDs               []string     `json:"-"` // Restringe a lotes específicos (uso interno)
 	ProductID         *string      `json:"productId,omitempty"`
+	BlockID           *string      `json:"blockId,omitempty"`
+	WarehouseID       *string      `json:"warehouseId,omitempty"`
+	LocationID        *string      `json:"locationId,omitempty"`
 	Status            *BatchStatus `json:"status,omitempty"`
 	Code              *string      `json:"code,omitempty"`              // Busca parcial
+	Search            *string      `json:"search,omitempty"`            // Busca textual (código, produto, material, acabamento, pedreira)
 	OnlyWithAvailable bool         `json:"onlyWithAvailable,omitempty"` // Apenas lotes com chapas disponíveis
-	LowStock          bool         `json:"lowStock,omitempty"`          // Apenas lotes com estoque baixo (≤3)
+	LowStock          bool         `json:"lowStock,omitempty"`          // Apenas lotes com estoque baixo (limite configurado da indústria/produto)
+	Stale             bool         `json:"stale,omitempty"`             // Apenas lotes parados além do limite de dias configurado
 	NoStock           bool         `json:"noStock,omitempty"`           // Apenas lotes sem estoque
 	IncludeArchived   bool         `json:"includeArchived,omitempty"`   // Incluir lotes arquivados
 	OnlyArchived      bool         `json:"onlyArchived,omitempty"`      // Apenas lotes arquivados
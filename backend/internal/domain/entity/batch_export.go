@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"math"
+)
+
+// BatchExportColumns são os cabeçalhos da planilha de exportação de lotes
+var BatchExportColumns = []interface{}{
+	"Código do Lote",
+	"Produto",
+	"SKU",
+	"Material",
+	"Acabamento",
+	"Status",
+	"Altura (cm)",
+	"Largura (cm)",
+	"Espessura (cm)",
+	"Chapas",
+	"Disponíveis",
+	"Reservadas",
+	"Vendidas",
+	"Inativas",
+	"Área da Chapa (m²)",
+	"Área Total (m²)",
+	"Área Total (ft²)",
+	"Preço Cadastrado",
+	"Unidade",
+	"Preço por m²",
+	"Preço por ft²",
+	"Preço da Chapa",
+	"Preço Total",
+	"Preço Total Disponível",
+	"Pedreira",
+	"Data de Entrada",
+	"Público",
+	"Arquivado",
+}
+
+// BatchExportRow converte o lote em uma linha de exportação, na ordem de BatchExportColumns
+func BatchExportRow(b *Batch) []interface{} {
+	productName, sku, material, finish := "", "", "", ""
+	if b.Product != nil {
+		productName = b.Product.Name
+		material = string(b.Product.Material)
+		finish = string(b.Product.Finish)
+		if b.Product.SKU != nil {
+			sku = *b.Product.SKU
+		}
+	}
+
+	originQuarry := ""
+	if b.OriginQuarry != nil {
+		originQuarry = *b.OriginQuarry
+	}
+
+	slabArea := b.CalculateSlabArea()
+	slabPrice := b.CalculateSlabPrice()
+
+	return []interface{}{
+		b.BatchCode,
+		productName,
+		sku,
+		material,
+		finish,
+		string(b.Status),
+		b.Height,
+		b.Width,
+		b.Thickness,
+		b.QuantitySlabs,
+		b.AvailableSlabs,
+		b.ReservedSlabs,
+		b.SoldSlabs,
+		b.InactiveSlabs,
+		roundTo(slabArea, 4),
+		roundTo(b.TotalArea, 4),
+		roundTo(ConvertArea(b.TotalArea, PriceUnitM2, PriceUnitFT2), 4),
+		roundTo(b.IndustryPrice, 2),
+		string(b.PriceUnit),
+		roundTo(b.GetPriceInUnit(PriceUnitM2), 2),
+		roundTo(b.GetPriceInUnit(PriceUnitFT2), 2),
+		roundTo(slabPrice, 2),
+		roundTo(b.CalculateTotalPrice(), 2),
+		roundTo(b.CalculatePriceForSlabs(b.AvailableSlabs), 2),
+		originQuarry,
+		b.EntryDate.Format("2006-01-02"),
+		yesNo(b.IsPublic),
+		yesNo(!b.IsActive),
+	}
+}
+
+func yesNo(v bool) string {
+	if v {
+		return "Sim"
+	}
+	return "Não"
+}
+
+func roundTo(value float64, places int) float64 {
+	factor := math.Pow(10, float64(places))
+	return math.Round(value*factor) / factor
+}
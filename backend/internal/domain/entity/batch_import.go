@@ -0,0 +1,320 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BatchImportMaxRows é o limite de linhas aceitas em uma importação
+const BatchImportMaxRows = 1000
+
+// BatchImportRow representa uma linha da planilha de importação de lotes
+type BatchImportRow struct {
+	Line       int              `json:"line"` // número da linha na planilha (cabeçalho = 1)
+	BatchCode  string           `json:"batchCode"`
+	ProductSKU *string          `json:"productSku,omitempty"`
+	ProductID  *string          `json:"productId,omitempty"` // produto resolvido para a linha
+	NewProduct bool             `json:"newProduct"`          // linha cria (ou reutiliza) produto da própria planilha
+	BatchID    *string          `json:"batchId,omitempty"`   // preenchido após a importação
+	Errors     []string         `json:"errors,omitempty"`
+	Input      CreateBatchInput `json:"-"`
+}
+
+// AddError adiciona um erro à linha
+func (r *BatchImportRow) AddError(message string) {
+	r.Errors = append(r.Errors, message)
+}
+
+// IsValid verifica se a linha não possui erros
+func (r *BatchImportRow) IsValid() bool {
+	return len(r.Errors) == 0
+}
+
+// BatchImportResult representa o resultado de uma importação (ou simulação) de lotes
+type BatchImportResult struct {
+	DryRun          bool             `json:"dryRun"`
+	Committed       bool             `json:"committed"`
+	TotalRows       int              `json:"totalRows"`
+	ValidRows       int              `json:"validRows"`
+	InvalidRows     int              `json:"invalidRows"`
+	CreatedProducts int              `json:"createdProducts"`
+	Rows            []BatchImportRow `json:"rows"`
+}
+
+// Colunas reconhecidas na planilha de importação
+const (
+	importColProductID       = "productId"
+	importColProductSKU      = "productSku"
+	importColProductName     = "productName"
+	importColMaterial        = "material"
+	importColFinish          = "finish"
+	importColDescription     = "description"
+	importColProductIsPublic = "productIsPublic"
+	importColBatchCode       = "batchCode"
+	importColHeight          = "height"
+	importColWidth           = "width"
+	importColThickness       = "thickness"
+	importColQuantitySlabs   = "quantitySlabs"
+	importColIndustryPrice   = "industryPrice"
+	importColPriceUnit       = "priceUnit"
+	importColOriginQuarry    = "originQuarry"
+	importColEntryDate       = "entryDate"
+)
+
+// importHeaderAliases mapeia cabeçalhos normalizados (minúsculos, sem acento e separadores) para colunas
+var importHeaderAliases = map[string]string{
+	"productid":        importColProductID,
+	"idproduto":        importColProductID,
+	"produtoid":        importColProductID,
+	"productsku":       importColProductSKU,
+	"sku":              importColProductSKU,
+	"skuproduto":       importColProductSKU,
+	"productname":      importColProductName,
+	"produto":          importColProductName,
+	"nomeproduto":      importColProductName,
+	"material":         importColMaterial,
+	"tipomaterial":     importColMaterial,
+	"finish":           importColFinish,
+	"acabamento":       importColFinish,
+	"description":      importColDescription,
+	"descricao":        importColDescription,
+	"productispublic":  importColProductIsPublic,
+	"produtopublico":   importColProductIsPublic,
+	"batchcode":        importColBatchCode,
+	"codigo":           importColBatchCode,
+	"codigolote":       importColBatchCode,
+	"lote":             importColBatchCode,
+	"height":           importColHeight,
+	"altura":           importColHeight,
+	"width":            importColWidth,
+	"largura":          importColWidth,
+	"thickness":        importColThickness,
+	"espessura":        importColThickness,
+	"quantityslabs":    importColQuantitySlabs,
+	"quantidade":       importColQuantitySlabs,
+	"quantidadechapas": importColQuantitySlabs,
+	"chapas":           importColQuantitySlabs,
+	"industryprice":    importColIndustryPrice,
+	"preco":            importColIndustryPrice,
+	"precoindustria":   importColIndustryPrice,
+	"priceunit":        importColPriceUnit,
+	"unidade":          importColPriceUnit,
+	"unidadepreco":     importColPriceUnit,
+	"originquarry":     importColOriginQuarry,
+	"pedreira":         importColOriginQuarry,
+	"origem":           importColOriginQuarry,
+	"entrydate":        importColEntryDate,
+	"dataentrada":      importColEntryDate,
+	"data":             importColEntryDate,
+}
+
+// importRequiredColumns são as colunas obrigatórias (batchCode é opcional: vazio = código automático)
+var importRequiredColumns = []string{
+	importColHeight, importColWidth, importColThickness,
+	importColQuantitySlabs, importColIndustryPrice, importColEntryDate,
+}
+
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "ê", "e", "è", "e", "ë", "e",
+	"í", "i", "î", "i", "ì", "i", "ï", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ò", "o", "ö", "o",
+	"ú", "u", "û", "u", "ù", "u", "ü", "u",
+	"ç", "c",
+)
+
+// normalizeImportHeader deixa o cabeçalho minúsculo, sem acentos e sem separadores
+func normalizeImportHeader(header string) string {
+	header = accentReplacer.Replace(strings.ToLower(strings.TrimSpace(header)))
+	var b strings.Builder
+	for _, r := range header {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// ParseBatchImportRecords converte as linhas da planilha (com cabeçalho) em linhas de importação.
+// Erros de formato de cada célula são registrados na própria linha; erros de estrutura
+// (cabeçalho ausente, colunas obrigatórias faltando) são retornados.
+func ParseBatchImportRecords(records [][]string) ([]BatchImportRow, error) {
+	if len(records) == 0 {
+		return nil, fmt.Errorf("planilha vazia")
+	}
+
+	columns := make(map[string]int)
+	for i, header := range records[0] {
+		if column, ok := importHeaderAliases[normalizeImportHeader(header)]; ok {
+			if _, exists := columns[column]; !exists {
+				columns[column] = i
+			}
+		}
+	}
+
+	var missing []string
+	for _, column := range importRequiredColumns {
+		if _, ok := columns[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	_, hasProductID := columns[importColProductID]
+	_, hasProductSKU := columns[importColProductSKU]
+	_, hasProductName := columns[importColProductName]
+	if !hasProductID && !hasProductSKU && !hasProductName {
+		missing = append(missing, importColProductID+"|"+importColProductSKU+"|"+importColProductName)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("colunas obrigatórias ausentes: %s", strings.Join(missing, ", "))
+	}
+
+	rows := make([]BatchImportRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		cell := func(column string) string {
+			idx, ok := columns[column]
+			if !ok || idx >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[idx])
+		}
+
+		if isBlankRecord(record) {
+			continue
+		}
+		if len(rows) >= BatchImportMaxRows {
+			return nil, fmt.Errorf("planilha excede o limite de %d linhas", BatchImportMaxRows)
+		}
+
+		rows = append(rows, parseBatchImportRow(i+2, cell))
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("planilha não possui linhas de dados")
+	}
+
+	return rows, nil
+}
+
+func parseBatchImportRow(line int, cell func(string) string) BatchImportRow {
+	row := BatchImportRow{Line: line}
+	input := &row.Input
+
+	input.BatchCode = strings.ToUpper(cell(importColBatchCode))
+	row.BatchCode = input.BatchCode
+
+	if v := cell(importColProductID); v != "" {
+		input.ProductID = &v
+	}
+	if v := cell(importColProductSKU); v != "" {
+		row.ProductSKU = &v
+	}
+
+	// Produto inline quando o nome é informado e não há productId
+	if name := cell(importColProductName); name != "" && input.ProductID == nil {
+		product := &CreateProductInlineInput{
+			Name:     name,
+			SKU:      row.ProductSKU,
+			Material: MaterialType(strings.ToUpper(accentReplacer.Replace(strings.ToLower(cell(importColMaterial))))),
+			Finish:   FinishType(strings.ToUpper(accentReplacer.Replace(strings.ToLower(cell(importColFinish))))),
+		}
+		if v := cell(importColDescription); v != "" {
+			product.Description = &v
+		}
+		if v := cell(importColProductIsPublic); v != "" {
+			isPublic, err := parseImportBool(v)
+			if err != nil {
+				row.AddError(fmt.Sprintf("%s: %s", importColProductIsPublic, err.Error()))
+			}
+			product.IsPublic = isPublic
+		}
+		input.NewProduct = product
+		row.NewProduct = true
+	}
+
+	parseFloat := func(column string, target *float64) {
+		v := cell(column)
+		if v == "" {
+			return
+		}
+		f, err := parseImportDecimal(v)
+		if err != nil {
+			row.AddError(fmt.Sprintf("%s: número inválido (%s)", column, v))
+			return
+		}
+		*target = f
+	}
+	parseFloat(importColHeight, &input.Height)
+	parseFloat(importColWidth, &input.Width)
+	parseFloat(importColThickness, &input.Thickness)
+	parseFloat(importColIndustryPrice, &input.IndustryPrice)
+
+	if v := cell(importColQuantitySlabs); v != "" {
+		f, err := parseImportDecimal(v)
+		if err != nil || f != float64(int(f)) {
+			row.AddError(fmt.Sprintf("%s: número inteiro inválido (%s)", importColQuantitySlabs, v))
+		} else {
+			input.QuantitySlabs = int(f)
+		}
+	}
+
+	if v := cell(importColPriceUnit); v != "" {
+		input.PriceUnit = PriceUnit(strings.ToUpper(strings.ReplaceAll(v, "²", "2")))
+	}
+	if v := cell(importColOriginQuarry); v != "" {
+		input.OriginQuarry = &v
+	}
+
+	if v := cell(importColEntryDate); v != "" {
+		date, err := parseImportDate(v)
+		if err != nil {
+			row.AddError(fmt.Sprintf("%s: data inválida (%s). Use AAAA-MM-DD ou DD/MM/AAAA", importColEntryDate, v))
+		}
+		input.EntryDate = date
+	}
+
+	return row
+}
+
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseImportDecimal aceita "1234.5", "1234,5" e "1.234,5"
+func parseImportDecimal(v string) (float64, error) {
+	v = strings.ReplaceAll(v, " ", "")
+	if strings.Contains(v, ",") {
+		v = strings.ReplaceAll(v, ".", "")
+		v = strings.ReplaceAll(v, ",", ".")
+	}
+	return strconv.ParseFloat(v, 64)
+}
+
+func parseImportBool(v string) (bool, error) {
+	switch strings.ToLower(accentReplacer.Replace(strings.TrimSpace(v))) {
+	case "1", "true", "sim", "s", "yes", "y", "x":
+		return true, nil
+	case "0", "false", "nao", "n", "no", "":
+		return false, nil
+	}
+	return false, fmt.Errorf("valor booleano inválido (%s)", v)
+}
+
+// parseImportDate normaliza a data para AAAA-MM-DD (ou mantém RFC3339)
+func parseImportDate(v string) (string, error) {
+	if _, err := time.Parse(time.RFC3339, v); err == nil {
+		return v, nil
+	}
+	for _, layout := range []string{"2006-01-02", "02/01/2006", "2/1/2006", "02-01-2006"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.Format("2006-01-02"), nil
+		}
+	}
+	return v, fmt.Errorf("data inválida")
+}
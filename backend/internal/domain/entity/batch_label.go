@@ -0,0 +1,23 @@
+package entity
+
+// LabelLayout descreve um formato de folha de etiquetas (medidas em mm)
+type LabelLayout struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	PageWidth   float64 `json:"pageWidth"`
+	PageHeight  float64 `json:"pageHeight"`
+	Columns     int     `json:"columns"`
+	Rows        int     `json:"rows"`
+	LabelWidth  float64 `json:"labelWidth"`
+	LabelHeight float64 `json:"labelHeight"`
+	PerPage     int     `json:"perPage"`
+}
+
+// GenerateBatchLabelsInput representa os dados para gerar a folha de etiquetas
+type GenerateBatchLabelsInput struct {
+	BatchIDs      []string `json:"batchIds" validate:"required,min=1,max=500,dive,uuid"`
+	Layout        string   `json:"layout,omitempty"`                                   // vazio = layout padrão (a4-3x8)
+	Copies        int      `json:"copies,omitempty" validate:"omitempty,min=1,max=50"` // etiquetas por lote (padrão 1)
+	StartPosition int      `json:"startPosition,omitempty" validate:"omitempty,min=1"` // posição da primeira etiqueta na folha
+	ShowBorder    bool     `json:"showBorder,omitempty"`                               // contorno de corte
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"time"
+)
+
+// BatchLineageOperation representa a operação que originou o vínculo entre lotes
+type BatchLineageOperation string
+
+const (
+	BatchLineageDivisao    BatchLineageOperation = "DIVISAO"
+	BatchLineageUnificacao BatchLineageOperation = "UNIFICACAO"
+)
+
+// BatchLineage representa a passagem de chapas de um lote (pai) para outro (filho)
+type BatchLineage struct {
+	ID              string                `json:"id"`
+	ParentBatchID   string                `json:"parentBatchId"`
+	ParentBatchCode string                `json:"parentBatchCode,omitempty"`
+	ChildBatchID    string                `json:"childBatchId"`
+	ChildBatchCode  string                `json:"childBatchCode,omitempty"`
+	Operation       BatchLineageOperation `json:"operation"`
+	Quantity        int                   `json:"quantity"`
+	SlabNumbers     []int                 `json:"slabNumbers,omitempty"` // números no lote de origem
+	Notes           *string               `json:"notes,omitempty"`
+	ActorUserID     *string               `json:"actorUserId,omitempty"`
+	ActorName       *string               `json:"actorName,omitempty"`
+	CreatedAt       time.Time             `json:"createdAt"`
+}
+
+// BatchLineageResponse representa a árvore imediata de origem e destino de um lote
+type BatchLineageResponse struct {
+	Parents  []BatchLineage `json:"parents"`  // lotes que deram origem a este
+	Children []BatchLineage `json:"children"` // lotes gerados/unificados a partir deste
+}
+
+// SplitBatchInput representa os dados para dividir chapas disponíveis em um novo lote
+type SplitBatchInput struct {
+	Quantity    int     `json:"quantity" validate:"required,gt=0"`
+	SlabNumbers []int   `json:"slabNumbers,omitempty" validate:"omitempty,dive,gt=0"` // chapas específicas (lotes rastreados)
+	BatchCode   string  `json:"batchCode,omitempty" validate:"omitempty,batchcode"`   // vazio = código automático
+	Notes       *string `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// MergeBatchesInput representa os dados para unificar lotes compatíveis em um lote de destino
+type MergeBatchesInput struct {
+	TargetBatchID  string   `json:"targetBatchId" validate:"required,uuid"`
+	SourceBatchIDs []string `json:"sourceBatchIds" validate:"required,min=1,max=20,dive,uuid"`
+	Notes          *string  `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// BatchSplitResponse representa o resultado de uma divisão
+type BatchSplitResponse struct {
+	Parent *Batch `json:"parent"`
+	Child  *Batch `json:"child"`
+}
+
+// IsMergeCompatible verifica se as chapas do lote podem ser incorporadas ao destino:
+// mesma indústria, produto, bloco de origem, dimensões e unidade de preço
+func (b *Batch) IsMergeCompatible(target *Batch) bool {
+	sameBlock := (b.BlockID == nil && target.BlockID == nil) ||
+		(b.BlockID != nil && target.BlockID != nil && *b.BlockID == *target.BlockID)
+	return sameBlock &&
+		b.IndustryID == target.IndustryID &&
+		b.ProductID == target.ProductID &&
+		b.Height == target.Height &&
+		b.Width == target.Width &&
+		b.Thickness == target.Thickness &&
+		b.PriceUnit == target.PriceUnit
+}
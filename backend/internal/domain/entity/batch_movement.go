@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"time"
+)
+
+// MovementReason representa o motivo de uma movimentação de estoque
+type MovementReason string
+
+const (
+	MovementReasonSaldoInicial        MovementReason = "SALDO_INICIAL"
+	MovementReasonEntrada             MovementReason = "ENTRADA"
+	MovementReasonAjusteQuantidade    MovementReason = "AJUSTE_QUANTIDADE"
+	MovementReasonAjusteManual        MovementReason = "AJUSTE_MANUAL"
+	MovementReasonAvaria              MovementReason = "AVARIA"
+	MovementReasonInventario          MovementReason = "INVENTARIO"
+	MovementReasonReserva             MovementReason = "RESERVA"
+	MovementReasonCancelamentoReserva MovementReason = "CANCELAMENTO_RESERVA"
+	MovementReasonExpiracaoReserva    MovementReason = "EXPIRACAO_RESERVA"
+	MovementReasonRejeicaoReserva     MovementReason = "REJEICAO_RESERVA"
+	MovementReasonVendaReserva        MovementReason = "VENDA_RESERVA"
+	MovementReasonVendaDireta         MovementReason = "VENDA_DIRETA"
+	MovementReasonEstornoVenda        MovementReason = "ESTORNO_VENDA"
+	MovementReasonDivisaoLote         MovementReason = "DIVISAO_LOTE"
+	MovementReasonUnificacaoLote      MovementReason = "UNIFICACAO_LOTE"
+)
+
+// IsManual verifica se o motivo pode ser informado em ajustes manuais de disponibilidade
+func (m MovementReason) IsManual() bool {
+	switch m {
+	case MovementReasonAjusteManual, MovementReasonAvaria, MovementReasonInventario:
+		return true
+	}
+	return false
+}
+
+// BatchMovement representa um lançamento imutável no razão de estoque do lote
+type BatchMovement struct {
+	ID            string         `json:"id"`
+	BatchID       string         `json:"batchId"`
+	FromStatus    *BatchStatus   `json:"fromStatus,omitempty"` // nil = entrada no lote
+	ToStatus      *BatchStatus   `json:"toStatus,omitempty"`   // nil = saída do lote
+	Quantity      int            `json:"quantity"`
+	SlabNumbers   []int          `json:"slabNumbers,omitempty"`
+	Reason        MovementReason `json:"reason"`
+	Notes         *string        `json:"notes,omitempty"`
+	ActorUserID   *string        `json:"actorUserId,omitempty"` // nil = sistema
+	ActorName     *string        `json:"actorName,omitempty"`
+	ReservationID *string        `json:"reservationId,omitempty"`
+	SaleID        *string        `json:"saleId,omitempty"`
+	CreatedAt     time.Time      `json:"createdAt"`
+}
+
+// BatchMovementFilters representa os filtros para busca de movimentações
+type BatchMovementFilters struct {
+	Reason *MovementReason `json:"reason,omitempty"`
+	Page   int             `json:"page" validate:"min=1"`
+	Limit  int             `json:"limit" validate:"min=1,max=100"`
+}
+
+// BatchMovementListResponse representa a resposta de listagem de movimentações
+type BatchMovementListResponse struct {
+	Movements []BatchMovement `json:"movements"`
+	Total     int             `json:"total"`
+	Page      int             `json:"page"`
+	Ledger    SlabCounts      `json:"ledger"`   // saldo reconstruído a partir do razão
+	Counters  SlabCounts      `json:"counters"` // contadores atuais do lote
+	Balanced  bool            `json:"balanced"` // razão e contadores coincidem
+}
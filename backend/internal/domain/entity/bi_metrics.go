@@ -9,7 +9,8 @@ type SalesMetrics struct {
 	NetRevenue       float64 `json:"netRevenue"`
 	SalesCount       int     `json:"salesCount"`
 	AverageTicket    float64 `json:"averageTicket"`
-	TotalSlabs       int     `json:"totalSlabs"`
+	TotalSlabs       int     `json:"totalSlabs"`   // chapas inteiras (retalhos ficam em RemnantsSold)
+	RemnantsSold     int     `json:"remnantsSold"` // retalhos vendidos (peça única)
 	TotalArea        float64 `json:"totalArea"`
 	CommissionRate   float64 `json:"commissionRate"` // Porcentagem média de comissão
 }
@@ -38,10 +39,46 @@ type InventoryMetrics struct {
 	SoldSlabs       int     `json:"soldSlabs"`
 	InventoryValue  float64 `json:"inventoryValue"`  // Valor total em estoque
 	AvgDaysInStock  int     `json:"avgDaysInStock"`  // Dias médios em estoque
-	LowStockCount   int     `json:"lowStockCount"`   // Lotes com <= 3 chapas disponíveis
-	StaleBatchCount int     `json:"staleBatchCount"` // Lotes > 90 dias sem movimento
+	LowStockCount   int     `json:"lowStockCount"`   // Lotes no limite de estoque baixo configurado
+	StaleBatchCount int     `json:"staleBatchCount"` // Lotes sem movimento além do limite de dias configurado
 	Turnover        float64 `json:"turnover"`        // Rotatividade do estoque
 	OccupancyRate   float64 `json:"occupancyRate"`   // Taxa de ocupação (reserved/available)
+	LostSlabs       int     `json:"lostSlabs"`       // Chapas perdidas em avarias aprovadas
+	LossRate        float64 `json:"lossRate"`        // Taxa de perda (perdidas / total de chapas)
+
+	LossByProduct []ProductLossMetric `json:"lossByProduct"`
+	LossByCause   []LossCauseMetric   `json:"lossByCause"`
+
+	Remnants RemnantInventoryMetrics `json:"remnants"` // retalhos, fora da contagem de chapas
+}
+
+// RemnantInventoryMetrics representa o estoque de retalhos
+type RemnantInventoryMetrics struct {
+	TotalRemnants     int     `json:"totalRemnants"`
+	AvailableRemnants int     `json:"availableRemnants"`
+	ReservedRemnants  int     `json:"reservedRemnants"`
+	SoldRemnants      int     `json:"soldRemnants"`
+	AvailableArea     float64 `json:"availableArea"`  // m² de retalhos disponíveis
+	InventoryValue    float64 `json:"inventoryValue"` // valor dos retalhos disponíveis na moeda base
+}
+
+// ProductLossMetric representa a perda por avaria de um produto
+type ProductLossMetric struct {
+	ProductID   string  `json:"productId"`
+	ProductName string  `json:"productName"`
+	Material    string  `json:"material"`
+	TotalSlabs  int     `json:"totalSlabs"`
+	LostSlabs   int     `json:"lostSlabs"`
+	LossRate    float64 `json:"lossRate"` // perdidas / total de chapas do produto
+}
+
+// LossCauseMetric representa a perda por causa de avaria
+type LossCauseMetric struct {
+	Cause       DamageCause `json:"cause"`
+	Reports     int         `json:"reports"`
+	LostSlabs   int         `json:"lostSlabs"`
+	LossRate    float64     `json:"lossRate"`    // perdidas pela causa / total de chapas
+	ShareOfLoss float64     `json:"shareOfLoss"` // participação da causa nas perdas
 }
 
 // BrokerPerformance representa performance de um broker/vendedor
@@ -68,18 +105,20 @@ type TrendPoint struct {
 
 // ProductMetric representa métricas de um produto
 type ProductMetric struct {
-	ProductID   string  `json:"productId"`
-	ProductName string  `json:"productName"`
-	Material    string  `json:"material"`
-	SalesCount  int     `json:"salesCount"`
-	Revenue     float64 `json:"revenue"`
-	SlabsSold   int     `json:"slabsSold"`
-	AreaSold    float64 `json:"areaSold"`
+	ProductID    string  `json:"productId"`
+	ProductName  string  `json:"productName"`
+	Material     string  `json:"material"`
+	SalesCount   int     `json:"salesCount"`
+	Revenue      float64 `json:"revenue"`
+	SlabsSold    int     `json:"slabsSold"`    // chapas inteiras (retalhos ficam em RemnantsSold)
+	RemnantsSold int     `json:"remnantsSold"` // retalhos vendidos (peça única)
+	AreaSold     float64 `json:"areaSold"`
 }
 
 // BIDashboard representa o dashboard completo de BI
 type BIDashboard struct {
 	Period           string              `json:"period"` // Ex: "2024-01-01 a 2024-01-31"
+	BaseCurrency     Currency            `json:"baseCurrency"` // Moeda em que os valores estão normalizados
 	Sales            SalesMetrics        `json:"sales"`
 	Conversion       ConversionMetrics   `json:"conversion"`
 	Inventory        InventoryMetrics    `json:"inventory"`
@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"time"
+)
+
+// Block representa um bloco bruto de pedreira, serrado em chapas que formam lotes
+type Block struct {
+	ID           string        `json:"id"`
+	IndustryID   string        `json:"industryId"`
+	BlockCode    string        `json:"blockCode"`
+	QuarryName   string        `json:"quarryName"`
+	SupplierName *string       `json:"supplierName,omitempty"`
+	Material     *MaterialType `json:"material,omitempty"`
+	Length       *float64      `json:"length,omitempty"` // cm
+	Width        *float64      `json:"width,omitempty"`  // cm
+	Height       *float64      `json:"height,omitempty"` // cm
+	VolumeM3     float64       `json:"volumeM3"`
+	PurchaseCost float64       `json:"purchaseCost"`
+	ArrivalDate  time.Time     `json:"arrivalDate"`
+	Notes        *string       `json:"notes,omitempty"`
+	IsActive     bool          `json:"isActive"`
+	Yield        BlockYield    `json:"yield"`
+	Batches      []Batch       `json:"batches,omitempty"` // Populated no detalhe do bloco
+	CreatedAt    time.Time     `json:"createdAt"`
+	UpdatedAt    time.Time     `json:"updatedAt"`
+}
+
+// BlockYield representa o rendimento do bloco a partir dos lotes serrados
+type BlockYield struct {
+	BatchCount    int     `json:"batchCount"`
+	QuantitySlabs int     `json:"quantitySlabs"`
+	SoldSlabs     int     `json:"soldSlabs"`
+	SlabAreaM2    float64 `json:"slabAreaM2"` // área total das chapas serradas
+	M2PerM3       float64 `json:"m2PerM3"`    // rendimento: m² de chapa por m³ de bloco
+	CostPerM2     float64 `json:"costPerM2"`  // custo do bloco rateado pela área serrada
+}
+
+// Calculate calcula os indicadores de rendimento a partir do volume e custo do bloco
+func (y *BlockYield) Calculate(volumeM3, purchaseCost float64) {
+	y.M2PerM3, y.CostPerM2 = 0, 0
+	if volumeM3 > 0 {
+		y.M2PerM3 = roundTo(y.SlabAreaM2/volumeM3, 2)
+	}
+	if y.SlabAreaM2 > 0 {
+		y.CostPerM2 = roundTo(purchaseCost/y.SlabAreaM2, 2)
+	}
+}
+
+// CalculateBlockVolume calcula o volume em m³ a partir das dimensões em cm (quando todas informadas)
+func CalculateBlockVolume(length, width, height *float64) float64 {
+	if length == nil || width == nil || height == nil {
+		return 0
+	}
+	return roundTo((*length)*(*width)*(*height)/1000000, 3)
+}
+
+// CreateBlockInput representa os dados para cadastrar um bloco
+type CreateBlockInput struct {
+	BlockCode    string        `json:"blockCode" validate:"required,min=1,max=50"`
+	QuarryName   string        `json:"quarryName" validate:"required,min=2,max=255"`
+	SupplierName *string       `json:"supplierName,omitempty" validate:"omitempty,max=255"`
+	Material     *MaterialType `json:"material,omitempty"`
+	Length       *float64      `json:"length,omitempty" validate:"omitempty,gt=0,lte=2000"`
+	Width        *float64      `json:"width,omitempty" validate:"omitempty,gt=0,lte=2000"`
+	Height       *float64      `json:"height,omitempty" validate:"omitempty,gt=0,lte=2000"`
+	VolumeM3     *float64      `json:"volumeM3,omitempty" validate:"omitempty,gt=0"` // vazio = calculado pelas dimensões
+	PurchaseCost float64       `json:"purchaseCost" validate:"gte=0"`
+	ArrivalDate  string        `json:"arrivalDate" validate:"required"` // ISO date
+	Notes        *string       `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// UpdateBlockInput representa os dados para atualizar um bloco
+type UpdateBlockInput struct {
+	BlockCode    *string       `json:"blockCode,omitempty" validate:"omitempty,min=1,max=50"`
+	QuarryName   *string       `json:"quarryName,omitempty" validate:"omitempty,min=2,max=255"`
+	SupplierName *string       `json:"supplierName,omitempty" validate:"omitempty,max=255"`
+	Material     *MaterialType `json:"material,omitempty"`
+	Length       *float64      `json:"length,omitempty" validate:"omitempty,gt=0,lte=2000"`
+	Width        *float64      `json:"width,omitempty" validate:"omitempty,gt=0,lte=2000"`
+	Height       *float64      `json:"height,omitempty" validate:"omitempty,gt=0,lte=2000"`
+	VolumeM3     *float64      `json:"volumeM3,omitempty" validate:"omitempty,gt=0"`
+	PurchaseCost *float64      `json:"purchaseCost,omitempty" validate:"omitempty,gte=0"`
+	ArrivalDate  *string       `json:"arrivalDate,omitempty"`
+	Notes        *string       `json:"notes,omitempty" validate:"omitempty,max=1000"`
+	IsActive     *bool         `json:"isActive,omitempty"`
+}
+
+// BlockFilters representa os filtros para busca de blocos
+type BlockFilters struct {
+	Search          *string `json:"search,omitempty"` // código, pedreira ou fornecedor
+	IncludeInactive bool    `json:"includeInactive,omitempty"`
+	Page            int     `json:"page" validate:"min=1"`
+	Limit           int     `json:"limit" validate:"min=1,max=100"`
+}
+
+// BlockListResponse representa a resposta de listagem de blocos
+type BlockListResponse struct {
+	Blocks []Block `json:"blocks"`
+	Total  int     `json:"total"`
+	Page   int     `json:"page"`
+}
+
+// SlabTrace representa a rastreabilidade de uma chapa até o bloco de origem
+type SlabTrace struct {
+	Slab    *Slab          `json:"slab"`
+	Batch   *Batch         `json:"batch"`
+	Block   *Block         `json:"block,omitempty"`   // nil = lote sem bloco vinculado
+	Lineage []BatchLineage `json:"lineage,omitempty"` // divisões/unificações percorridas até o lote com bloco
+}
@@ -12,6 +12,7 @@ type CatalogLink struct {
 	SlugToken       string    `json:"slugToken"`
 	Title           *string   `json:"title,omitempty"`
 	CustomMessage   *string   `json:"customMessage,omitempty"`
+	ShowPrice       bool      `json:"showPrice"` // exibe o preço dos lotes no catálogo público
 	ViewsCount      int       `json:"viewsCount"`
 	ExpiresAt       *time.Time `json:"expiresAt,omitempty"`
 	IsActive        bool      `json:"isActive"`
@@ -36,6 +37,7 @@ type CreateCatalogLinkInput struct {
 	Title         *string  `json:"title,omitempty" validate:"omitempty,max=100"`
 	CustomMessage *string  `json:"customMessage,omitempty" validate:"omitempty,max=500"`
 	BatchIDs      []string `json:"batchIds" validate:"required,min=1,dive,uuid"` // IDs dos lotes a incluir
+	ShowPrice     bool     `json:"showPrice"`
 	ExpiresAt     *string  `json:"expiresAt,omitempty"` // ISO date
 	IsActive      bool     `json:"isActive"`
 }
@@ -45,6 +47,7 @@ type UpdateCatalogLinkInput struct {
 	Title         *string  `json:"title,omitempty" validate:"omitempty,max=100"`
 	CustomMessage *string  `json:"customMessage,omitempty" validate:"omitempty,max=500"`
 	BatchIDs      *[]string `json:"batchIds,omitempty" validate:"omitempty,min=1,dive,uuid"`
+	ShowPrice     *bool    `json:"showPrice,omitempty"`
 	ExpiresAt     *string  `json:"expiresAt,omitempty"` // ISO date
 	IsActive      *bool    `json:"isActive,omitempty"`
 }
@@ -53,6 +56,8 @@ type UpdateCatalogLinkInput struct {
 type PublicCatalogLink struct {
 	Title         *string      `json:"title,omitempty"`
 	CustomMessage *string      `json:"customMessage,omitempty"`
+	ShowPrice     bool          `json:"showPrice"`
+	Currency      *Currency     `json:"currency,omitempty"` // moeda dos preços exibidos
 	Batches       []PublicBatch `json:"batches"`
 	DepositName   string        `json:"depositName"`
 	DepositCity   *string       `json:"depositCity,omitempty"`
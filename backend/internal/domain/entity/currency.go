@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"time"
+)
+
+// Currency representa a moeda de um preço ou venda (ISO 4217)
+type Currency string
+
+const (
+	CurrencyBRL Currency = "BRL" // Real
+	CurrencyUSD Currency = "USD" // Dólar americano
+	CurrencyEUR Currency = "EUR" // Euro
+)
+
+// DefaultCurrency é a moeda assumida quando nenhuma é informada
+const DefaultCurrency = CurrencyBRL
+
+// IsValid verifica se a moeda é suportada
+func (c Currency) IsValid() bool {
+	switch c {
+	case CurrencyBRL, CurrencyUSD, CurrencyEUR:
+		return true
+	}
+	return false
+}
+
+// OrDefault retorna a moeda ou BRL quando vazia
+func (c Currency) OrDefault() Currency {
+	if c == "" {
+		return DefaultCurrency
+	}
+	return c
+}
+
+// ExchangeRateSource representa a origem de uma cotação
+type ExchangeRateSource string
+
+const (
+	ExchangeRateSourceManual  ExchangeRateSource = "MANUAL"  // cadastrada pelo admin
+	ExchangeRateSourceArquivo ExchangeRateSource = "ARQUIVO" // importada de arquivo
+)
+
+// ExchangeRate representa a cotação de um par de moedas: 1 FromCurrency = Rate ToCurrency
+type ExchangeRate struct {
+	ID              string             `json:"id"`
+	IndustryID      string             `json:"industryId"`
+	FromCurrency    Currency           `json:"fromCurrency"`
+	ToCurrency      Currency           `json:"toCurrency"`
+	Rate            float64            `json:"rate"`
+	EffectiveDate   time.Time          `json:"effectiveDate"` // vigente a partir desta data
+	Source          ExchangeRateSource `json:"source"`
+	CreatedByUserID *string            `json:"createdByUserId,omitempty"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+// UpsertExchangeRateInput representa os dados para cadastrar ou atualizar uma cotação
+type UpsertExchangeRateInput struct {
+	FromCurrency  Currency `json:"fromCurrency" validate:"required,oneof=BRL USD EUR"`
+	ToCurrency    Currency `json:"toCurrency" validate:"required,oneof=BRL USD EUR,nefield=FromCurrency"`
+	Rate          float64  `json:"rate" validate:"required,gt=0"`
+	EffectiveDate string   `json:"effectiveDate" validate:"required"` // ISO date (YYYY-MM-DD)
+}
+
+// ExchangeRateFilters representa os filtros para listagem de cotações
+type ExchangeRateFilters struct {
+	FromCurrency *Currency `json:"fromCurrency,omitempty"`
+	ToCurrency   *Currency `json:"toCurrency,omitempty"`
+	StartDate    *string   `json:"startDate,omitempty"`
+	EndDate      *string   `json:"endDate,omitempty"`
+}
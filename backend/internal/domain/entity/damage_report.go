@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"time"
+)
+
+// DamageCause representa a causa da avaria
+type DamageCause string
+
+const (
+	DamageCauseQuebraTransporte DamageCause = "QUEBRA_TRANSPORTE"
+	DamageCauseTrinca           DamageCause = "TRINCA"
+	DamageCauseMancha           DamageCause = "MANCHA"
+	DamageCauseOutro            DamageCause = "OUTRO"
+)
+
+// IsValid verifica se a causa é válida
+func (c DamageCause) IsValid() bool {
+	switch c {
+	case DamageCauseQuebraTransporte, DamageCauseTrinca, DamageCauseMancha, DamageCauseOutro:
+		return true
+	}
+	return false
+}
+
+// DamageReportStatus representa o status do relatório de avaria
+type DamageReportStatus string
+
+const (
+	DamageReportStatusPendente  DamageReportStatus = "PENDENTE"
+	DamageReportStatusAprovado  DamageReportStatus = "APROVADO"
+	DamageReportStatusRejeitado DamageReportStatus = "REJEITADO"
+)
+
+// IsValid verifica se o status é válido
+func (s DamageReportStatus) IsValid() bool {
+	switch s {
+	case DamageReportStatusPendente, DamageReportStatusAprovado, DamageReportStatusRejeitado:
+		return true
+	}
+	return false
+}
+
+// DamageReport representa um relatório de avaria de chapas de um lote
+type DamageReport struct {
+	ID               string             `json:"id"`
+	IndustryID       string             `json:"industryId"`
+	BatchID          string             `json:"batchId"`
+	BatchCode        string             `json:"batchCode"`
+	ProductName      *string            `json:"productName,omitempty"`
+	Quantity         int                `json:"quantity"`
+	Cause            DamageCause        `json:"cause"`
+	Description      *string            `json:"description,omitempty"`
+	Status           DamageReportStatus `json:"status"`
+	ReportedByUserID *string            `json:"reportedByUserId,omitempty"`
+	ReportedByName   *string            `json:"reportedByName,omitempty"`
+	ReviewedByUserID *string            `json:"reviewedByUserId,omitempty"` // quem aprovou/rejeitou
+	ReviewedByName   *string            `json:"reviewedByName,omitempty"`
+	ReviewedAt       *time.Time         `json:"reviewedAt,omitempty"`
+	ReviewNotes      *string            `json:"reviewNotes,omitempty"`
+	Photos           []Media            `json:"photos,omitempty"` // Populated no detalhe do relatório
+	CreatedAt        time.Time          `json:"createdAt"`
+	UpdatedAt        time.Time          `json:"updatedAt"`
+}
+
+// IsPending verifica se o relatório aguarda aprovação
+func (d *DamageReport) IsPending() bool {
+	return d.Status == DamageReportStatusPendente
+}
+
+// CreateDamageReportInput representa os dados para registrar uma avaria
+type CreateDamageReportInput struct {
+	BatchID     string      `json:"batchId" validate:"required,uuid"`
+	Quantity    int         `json:"quantity" validate:"required,gt=0"`
+	Cause       DamageCause `json:"cause" validate:"required,oneof=QUEBRA_TRANSPORTE TRINCA MANCHA OUTRO"`
+	Description *string     `json:"description,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ApproveDamageReportInput representa os dados para aprovar uma avaria
+type ApproveDamageReportInput struct {
+	Notes *string `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// RejectDamageReportInput representa os dados para rejeitar uma avaria
+type RejectDamageReportInput struct {
+	Reason string `json:"reason" validate:"required,min=5,max=500"`
+}
+
+// DamageReportFilters representa os filtros para busca de relatórios de avaria
+type DamageReportFilters struct {
+	BatchID *string             `json:"batchId,omitempty"`
+	Status  *DamageReportStatus `json:"status,omitempty"`
+	Cause   *DamageCause        `json:"cause,omitempty"`
+	Page    int                 `json:"page" validate:"min=1"`
+	Limit   int                 `json:"limit" validate:"min=1,max=100"`
+}
+
+// DamageReportListResponse representa a resposta de listagem de relatórios de avaria
+type DamageReportListResponse struct {
+	Reports []DamageReport `json:"reports"`
+	Total   int            `json:"total"`
+	Page    int            `json:"page"`
+}
@@ -0,0 +1,134 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExchangeRateImportMaxRows é o limite de linhas aceitas em uma importação de cotações
+const ExchangeRateImportMaxRows = 5000
+
+// ExchangeRateImportRow representa uma linha do arquivo de cotações
+type ExchangeRateImportRow struct {
+	Line   int                     `json:"line"` // número da linha no arquivo (cabeçalho = 1)
+	Errors []string                `json:"errors,omitempty"`
+	Input  UpsertExchangeRateInput `json:"-"`
+}
+
+// AddError adiciona um erro à linha
+func (r *ExchangeRateImportRow) AddError(message string) {
+	r.Errors = append(r.Errors, message)
+}
+
+// IsValid verifica se a linha não possui erros
+func (r *ExchangeRateImportRow) IsValid() bool {
+	return len(r.Errors) == 0
+}
+
+// ExchangeRateImportResult representa o resultado de uma importação de cotações
+type ExchangeRateImportResult struct {
+	TotalRows   int                     `json:"totalRows"`
+	Imported    int                     `json:"imported"`
+	InvalidRows int                     `json:"invalidRows"`
+	Rows        []ExchangeRateImportRow `json:"rows"` // apenas linhas rejeitadas
+}
+
+// Colunas reconhecidas no arquivo de cotações
+const (
+	rateColFrom = "fromCurrency"
+	rateColTo   = "toCurrency"
+	rateColRate = "rate"
+	rateColDate = "effectiveDate"
+)
+
+// rateHeaderAliases mapeia cabeçalhos normalizados para as colunas do arquivo
+var rateHeaderAliases = map[string]string{
+	"fromcurrency":  rateColFrom,
+	"from":          rateColFrom,
+	"de":            rateColFrom,
+	"moedaorigem":   rateColFrom,
+	"tocurrency":    rateColTo,
+	"to":            rateColTo,
+	"para":          rateColTo,
+	"moedadestino":  rateColTo,
+	"rate":          rateColRate,
+	"taxa":          rateColRate,
+	"cotacao":       rateColRate,
+	"effectivedate": rateColDate,
+	"date":          rateColDate,
+	"data":          rateColDate,
+	"vigencia":      rateColDate,
+}
+
+// ParseExchangeRateRecords converte as linhas do arquivo (com cabeçalho) em cotações.
+// Erros de cada célula são registrados na própria linha; erros de estrutura são retornados.
+func ParseExchangeRateRecords(records [][]string) ([]ExchangeRateImportRow, error) {
+	if len(records) == 0 {
+		return nil, fmt.Errorf("arquivo vazio")
+	}
+
+	columns := make(map[string]int)
+	for i, header := range records[0] {
+		if column, ok := rateHeaderAliases[normalizeImportHeader(header)]; ok {
+			if _, exists := columns[column]; !exists {
+				columns[column] = i
+			}
+		}
+	}
+
+	var missing []string
+	for _, column := range []string{rateColFrom, rateColTo, rateColRate, rateColDate} {
+		if _, ok := columns[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("colunas obrigatórias ausentes: %s", strings.Join(missing, ", "))
+	}
+
+	rows := make([]ExchangeRateImportRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		if isBlankRecord(record) {
+			continue
+		}
+		if len(rows) >= ExchangeRateImportMaxRows {
+			return nil, fmt.Errorf("arquivo excede o limite de %d linhas", ExchangeRateImportMaxRows)
+		}
+
+		cell := func(column string) string {
+			idx := columns[column]
+			if idx >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[idx])
+		}
+
+		row := ExchangeRateImportRow{Line: i + 2}
+		row.Input.FromCurrency = Currency(strings.ToUpper(cell(rateColFrom)))
+		row.Input.ToCurrency = Currency(strings.ToUpper(cell(rateColTo)))
+
+		if v := cell(rateColRate); v != "" {
+			rate, err := parseImportDecimal(v)
+			if err != nil {
+				row.AddError(fmt.Sprintf("%s: número inválido (%s)", rateColRate, v))
+			}
+			row.Input.Rate = rate
+		}
+
+		if v := cell(rateColDate); v != "" {
+			date, err := parseImportDate(v)
+			if err != nil {
+				row.AddError(fmt.Sprintf("%s: %s (%s)", rateColDate, err.Error(), v))
+			}
+			row.Input.EffectiveDate = date
+		}
+
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("arquivo não possui linhas de dados")
+	}
+
+	return rows, nil
+}
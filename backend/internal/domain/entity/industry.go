@@ -29,6 +29,10 @@ type Industry struct {
 	AddressZipCode           *string                  `json:"addressZipCode,omitempty"`
 	SocialLinks              SocialLinkList           `json:"socialLinks,omitempty"`
 	PortfolioDisplaySettings PortfolioDisplaySettings `json:"portfolioDisplaySettings,omitempty"`
+	BatchCodeSettings        BatchCodeSettings        `json:"batchCodeSettings"`
+	BaseCurrency             Currency                 `json:"baseCurrency"` // moeda base das métricas de BI
+	StockAlertSettings       StockAlertSettings       `json:"stockAlertSettings"`
+	ReservationPolicy        ReservationPolicy        `json:"reservationPolicy"`
 	IsPublic                 bool                     `json:"isPublic"`
 	CreatedAt                time.Time                `json:"createdAt"`
 	UpdatedAt                time.Time                `json:"updatedAt"`
@@ -76,6 +80,204 @@ func (p *PortfolioDisplaySettings) Scan(value interface{}) error {
 	return json.Unmarshal(bytes, p)
 }
 
+// BatchCodePrefixSource define a origem do prefixo dos códigos de lote automáticos
+type BatchCodePrefixSource string
+
+const (
+	BatchCodePrefixMaterial BatchCodePrefixSource = "MATERIAL" // 3 primeiras letras do material (ex.: GRA)
+	BatchCodePrefixProduto  BatchCodePrefixSource = "PRODUTO"  // 3 primeiras letras do nome do produto
+	BatchCodePrefixFixo     BatchCodePrefixSource = "FIXO"     // prefixo fixo configurado
+)
+
+// BatchCodeSettings representa a configuração de geração automática de códigos de lote
+type BatchCodeSettings struct {
+	PrefixSource BatchCodePrefixSource `json:"prefixSource" validate:"omitempty,oneof=MATERIAL PRODUTO FIXO"`
+	FixedPrefix  *string               `json:"fixedPrefix,omitempty" validate:"omitempty,len=3,alpha"`
+}
+
+// Value implements the driver.Valuer interface
+func (b BatchCodeSettings) Value() (driver.Value, error) {
+	if b.PrefixSource == "" {
+		b.PrefixSource = BatchCodePrefixMaterial
+	}
+	return json.Marshal(b)
+}
+
+// Scan implements the sql.Scanner interface
+func (b *BatchCodeSettings) Scan(value interface{}) error {
+	*b = BatchCodeSettings{PrefixSource: BatchCodePrefixMaterial}
+	if value == nil {
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	if err := json.Unmarshal(bytes, b); err != nil {
+		return err
+	}
+	if b.PrefixSource == "" {
+		b.PrefixSource = BatchCodePrefixMaterial
+	}
+	return nil
+}
+
+// Limites padrão de alerta de estoque
+const (
+	DefaultLowStockSlabs = 3
+	DefaultStaleDays     = 90
+)
+
+// StockAlertSettings representa os limites de estoque baixo/parado e o envio de alertas
+type StockAlertSettings struct {
+	LowStockSlabs    int                     `json:"lowStockSlabs" validate:"min=0,max=1000"` // estoque baixo: até N chapas disponíveis
+	StaleDays        int                     `json:"staleDays" validate:"min=1,max=3650"`     // estoque parado: mais de N dias sem movimento
+	EmailEnabled     bool                    `json:"emailEnabled"`                            // enviar alertas por email aos administradores
+	ProductOverrides []ProductStockThreshold `json:"productOverrides" validate:"max=500,dive"`
+}
+
+// ProductStockThreshold representa limites específicos de um produto (nil = limite da indústria)
+type ProductStockThreshold struct {
+	ProductID     string `json:"productId" validate:"required,uuid"`
+	LowStockSlabs *int   `json:"lowStockSlabs,omitempty" validate:"omitempty,min=0,max=1000"`
+	StaleDays     *int   `json:"staleDays,omitempty" validate:"omitempty,min=1,max=3650"`
+}
+
+// DefaultStockAlertSettings retorna a configuração padrão de alertas
+func DefaultStockAlertSettings() StockAlertSettings {
+	return StockAlertSettings{
+		LowStockSlabs:    DefaultLowStockSlabs,
+		StaleDays:        DefaultStaleDays,
+		EmailEnabled:     true,
+		ProductOverrides: []ProductStockThreshold{},
+	}
+}
+
+// Value implements the driver.Valuer interface
+func (s StockAlertSettings) Value() (driver.Value, error) {
+	if s.ProductOverrides == nil {
+		s.ProductOverrides = []ProductStockThreshold{}
+	}
+	return json.Marshal(s)
+}
+
+// Scan implements the sql.Scanner interface
+func (s *StockAlertSettings) Scan(value interface{}) error {
+	*s = DefaultStockAlertSettings()
+	if value == nil {
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	if err := json.Unmarshal(bytes, s); err != nil {
+		return err
+	}
+	if s.ProductOverrides == nil {
+		s.ProductOverrides = []ProductStockThreshold{}
+	}
+	return nil
+}
+
+// Limites padrão da política de reservas
+const (
+	DefaultReservationExpiryDays    = 7
+	DefaultMaxReservationExtensions = 2
+	DefaultMaxExtensionDays         = 7
+	DefaultReservationReminderHours = 24
+)
+
+// ReservationPolicy representa as regras de reserva da indústria (limites com 0 = sem limite)
+type ReservationPolicy struct {
+	DefaultExpiryDays     int                         `json:"defaultExpiryDays" validate:"min=1,max=365"`    // prazo quando não informado e na aprovação
+	MaxExpiryDays         int                         `json:"maxExpiryDays" validate:"min=0,max=365"`        // prazo máximo que pode ser informado
+	MaxActivePerBroker    int                         `json:"maxActivePerBroker" validate:"min=0,max=1000"`  // reservas em aberto por broker
+	MaxSlabsPerBroker     int                         `json:"maxSlabsPerBroker" validate:"min=0,max=100000"` // chapas reservadas em aberto por broker
+	RequireBrokerApproval bool                        `json:"requireBrokerApproval"`                         // reservas de brokers ficam pendentes de aprovação
+	AutoApprove           ReservationAutoApproveRules `json:"autoApprove"`                                   // exceções à aprovação de brokers
+	MaxExtensions         int                         `json:"maxExtensions" validate:"min=0,max=20"`         // prorrogações aprovadas por reserva (0 = não permite)
+	MaxExtensionDays      int                         `json:"maxExtensionDays" validate:"min=1,max=90"`      // dias adicionados por prorrogação
+	ReminderHoursBefore   int                         `json:"reminderHoursBefore" validate:"min=0,max=168"`  // lembrete por email antes do vencimento (0 = desativado)
+}
+
+// ReservationAutoApproveRules representa as regras que dispensam a aprovação de reservas de brokers
+type ReservationAutoApproveRules struct {
+	TrustedBrokerIDs    []string `json:"trustedBrokerIds" validate:"max=500,dive,uuid"` // brokers de confiança
+	SlabsBelow          int      `json:"slabsBelow" validate:"min=0,max=1000"`          // reservas com menos de N chapas (0 = desativado)
+	PriceAtOrAboveBatch bool     `json:"priceAtOrAboveBatch"`                           // preço indicado igual ou acima do preço do lote
+}
+
+// DefaultReservationPolicy retorna a política de reservas padrão
+func DefaultReservationPolicy() ReservationPolicy {
+	return ReservationPolicy{
+		DefaultExpiryDays: DefaultReservationExpiryDays,
+		AutoApprove: ReservationAutoApproveRules{
+			TrustedBrokerIDs: []string{},
+		},
+		MaxExtensions:       DefaultMaxReservationExtensions,
+		MaxExtensionDays:    DefaultMaxExtensionDays,
+		ReminderHoursBefore: DefaultReservationReminderHours,
+	}
+}
+
+// DefaultExpiry retorna o prazo padrão de uma reserva a partir de now
+func (p ReservationPolicy) DefaultExpiry(now time.Time) time.Time {
+	return now.Add(time.Duration(p.DefaultExpiryDays) * 24 * time.Hour)
+}
+
+// ExceedsMaxExpiry verifica se o prazo informado ultrapassa o máximo da política
+func (p ReservationPolicy) ExceedsMaxExpiry(expiresAt, now time.Time) bool {
+	return p.MaxExpiryDays > 0 && expiresAt.After(now.Add(time.Duration(p.MaxExpiryDays)*24*time.Hour))
+}
+
+// Matches verifica se a reserva do broker dispensa aprovação.
+// reservedPrice e batchPrice devem estar na mesma unidade (nil = broker não indicou preço)
+func (r ReservationAutoApproveRules) Matches(brokerID string, slabs int, reservedPrice *float64, batchPrice float64) bool {
+	for _, id := range r.TrustedBrokerIDs {
+		if id == brokerID {
+			return true
+		}
+	}
+	if r.SlabsBelow > 0 && slabs < r.SlabsBelow {
+		return true
+	}
+	return r.PriceAtOrAboveBatch && reservedPrice != nil && *reservedPrice >= batchPrice
+}
+
+// Value implements the driver.Valuer interface
+func (p ReservationPolicy) Value() (driver.Value, error) {
+	if p.AutoApprove.TrustedBrokerIDs == nil {
+		p.AutoApprove.TrustedBrokerIDs = []string{}
+	}
+	return json.Marshal(p)
+}
+
+// Scan implements the sql.Scanner interface
+func (p *ReservationPolicy) Scan(value interface{}) error {
+	*p = DefaultReservationPolicy()
+	if value == nil {
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	if err := json.Unmarshal(bytes, p); err != nil {
+		return err
+	}
+	if p.AutoApprove.TrustedBrokerIDs == nil {
+		p.AutoApprove.TrustedBrokerIDs = []string{}
+	}
+	return nil
+}
+
 // SocialLinkList é uma lista de links de rede social que implementa interfaces SQL
 type SocialLinkList []SocialLink
 
@@ -136,13 +338,14 @@ type UpdateIndustryInput struct {
 
 // PublicDeposit representa dados sanitizados de um depósito para exibição pública
 type PublicDeposit struct {
-	Name      string  `json:"name"`
-	Slug      string  `json:"slug"`
-	City      *string `json:"city,omitempty"`
-	State     *string `json:"state,omitempty"`
-	BannerURL *string `json:"bannerUrl,omitempty"`
-	LogoURL   *string `json:"logoUrl,omitempty"`
-	Preview   []Media `json:"preview"` // até 4 fotos de lotes públicos
+	Name       string            `json:"name"`
+	Slug       string            `json:"slug"`
+	City       *string           `json:"city,omitempty"`
+	State      *string           `json:"state,omitempty"`
+	BannerURL  *string           `json:"bannerUrl,omitempty"`
+	LogoURL    *string           `json:"logoUrl,omitempty"`
+	Preview    []Media           `json:"preview"`    // até 4 fotos de lotes públicos
+	Warehouses []PublicWarehouse `json:"warehouses"` // depósitos ativos para filtro de localização
 }
 
 // PublicDepositListResponse representa a resposta de listagem de depósitos públicos
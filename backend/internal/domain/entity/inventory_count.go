@@ -0,0 +1,151 @@
+package entity
+
+import (
+	"time"
+)
+
+// InventoryCountScope representa o escopo de lotes congelado na sessão de inventário
+type InventoryCountScope string
+
+const (
+	InventoryCountScopeProduto  InventoryCountScope = "PRODUTO"
+	InventoryCountScopeMaterial InventoryCountScope = "MATERIAL"
+	InventoryCountScopeTodos    InventoryCountScope = "TODOS"
+)
+
+// IsValid verifica se o escopo é válido
+func (s InventoryCountScope) IsValid() bool {
+	switch s {
+	case InventoryCountScopeProduto, InventoryCountScopeMaterial, InventoryCountScopeTodos:
+		return true
+	}
+	return false
+}
+
+// InventoryCountStatus representa o status da sessão de inventário
+type InventoryCountStatus string
+
+const (
+	InventoryCountStatusAberta    InventoryCountStatus = "ABERTA"
+	InventoryCountStatusAprovada  InventoryCountStatus = "APROVADA"
+	InventoryCountStatusCancelada InventoryCountStatus = "CANCELADA"
+)
+
+// IsValid verifica se o status é válido
+func (s InventoryCountStatus) IsValid() bool {
+	switch s {
+	case InventoryCountStatusAberta, InventoryCountStatusAprovada, InventoryCountStatusCancelada:
+		return true
+	}
+	return false
+}
+
+// InventoryCount representa uma sessão de inventário físico (contagem cíclica)
+type InventoryCount struct {
+	ID               string                `json:"id"`
+	IndustryID       string                `json:"industryId"`
+	Scope            InventoryCountScope   `json:"scope"`
+	ProductID        *string               `json:"productId,omitempty"`
+	ProductName      *string               `json:"productName,omitempty"`
+	Material         *MaterialType         `json:"material,omitempty"`
+	Status           InventoryCountStatus  `json:"status"`
+	Notes            *string               `json:"notes,omitempty"`
+	AdjustmentReason *MovementReason       `json:"adjustmentReason,omitempty"` // motivo registrado no razão na aprovação
+	CreatedByUserID  *string               `json:"createdByUserId,omitempty"`
+	CreatedByName    *string               `json:"createdByName,omitempty"`
+	ClosedByUserID   *string               `json:"closedByUserId,omitempty"` // quem aprovou/cancelou
+	ClosedByName     *string               `json:"closedByName,omitempty"`
+	ClosedAt         *time.Time            `json:"closedAt,omitempty"`
+	Summary          InventoryCountSummary `json:"summary"`
+	Items            []InventoryCountItem  `json:"items,omitempty"` // Populated no detalhe da sessão
+	CreatedAt        time.Time             `json:"createdAt"`
+	UpdatedAt        time.Time             `json:"updatedAt"`
+}
+
+// IsOpen verifica se a sessão ainda aceita contagens
+func (c *InventoryCount) IsOpen() bool {
+	return c.Status == InventoryCountStatusAberta
+}
+
+// InventoryCountSummary resume o andamento da contagem
+type InventoryCountSummary struct {
+	TotalItems    int `json:"totalItems"`
+	CountedItems  int `json:"countedItems"`
+	VarianceItems int `json:"varianceItems"` // lotes contados com divergência
+	NetVariance   int `json:"netVariance"`   // soma das divergências (contado - sistema)
+}
+
+// InventoryCountItem representa um lote congelado na sessão com a quantidade contada
+type InventoryCountItem struct {
+	ID                 string     `json:"id"`
+	CountID            string     `json:"countId"`
+	BatchID            string     `json:"batchId"`
+	BatchCode          string     `json:"batchCode"`
+	ProductName        *string    `json:"productName,omitempty"`
+	ExpectedSlabs      int        `json:"expectedSlabs"`                // disponíveis na abertura da sessão
+	SystemSlabs        int        `json:"systemSlabs"`                  // disponíveis atualmente no sistema
+	CountedSystemSlabs *int       `json:"countedSystemSlabs,omitempty"` // disponíveis no sistema no momento da contagem
+	InactiveSlabs      int        `json:"inactiveSlabs"`                // inativas atualmente (limite para sobras)
+	CountedSlabs       *int       `json:"countedSlabs,omitempty"`
+	Variance           *int       `json:"variance,omitempty"` // contado - sistema na contagem (nil = não contado)
+	CountedByUserID    *string    `json:"countedByUserId,omitempty"`
+	CountedByName      *string    `json:"countedByName,omitempty"`
+	CountedAt          *time.Time `json:"countedAt,omitempty"`
+	Notes              *string    `json:"notes,omitempty"`
+	AdjustedQuantity   *int       `json:"adjustedQuantity,omitempty"` // divergência aplicada na aprovação
+	AdjustedAt         *time.Time `json:"adjustedAt,omitempty"`
+}
+
+// CalculateVariance calcula a divergência entre o contado e o disponível no sistema quando o lote foi contado;
+// reservas e vendas posteriores à contagem não entram na divergência
+func (i *InventoryCountItem) CalculateVariance() {
+	i.Variance = nil
+	if i.CountedSlabs != nil {
+		system := i.SystemSlabs
+		if i.CountedSystemSlabs != nil {
+			system = *i.CountedSystemSlabs
+		}
+		variance := *i.CountedSlabs - system
+		i.Variance = &variance
+	}
+}
+
+// CreateInventoryCountInput representa os dados para abrir uma sessão de inventário
+type CreateInventoryCountInput struct {
+	Scope     InventoryCountScope `json:"scope" validate:"required,oneof=PRODUTO MATERIAL TODOS"`
+	ProductID *string             `json:"productId,omitempty" validate:"omitempty,uuid"`
+	Material  *MaterialType       `json:"material,omitempty"`
+	Notes     *string             `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// RecordInventoryCountInput representa as quantidades contadas por lote
+type RecordInventoryCountInput struct {
+	Items []InventoryCountEntry `json:"items" validate:"required,min=1,max=500,dive"`
+}
+
+// InventoryCountEntry representa a contagem de um lote
+type InventoryCountEntry struct {
+	BatchID      string  `json:"batchId" validate:"required,uuid"`
+	CountedSlabs int     `json:"countedSlabs" validate:"gte=0"`
+	Notes        *string `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// ApproveInventoryCountInput representa os dados para aprovar os ajustes da sessão
+type ApproveInventoryCountInput struct {
+	Reason *MovementReason `json:"reason,omitempty" validate:"omitempty,oneof=AJUSTE_MANUAL AVARIA INVENTARIO"` // padrão INVENTARIO
+	Notes  *string         `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// InventoryCountFilters representa os filtros para busca de sessões de inventário
+type InventoryCountFilters struct {
+	Status *InventoryCountStatus `json:"status,omitempty"`
+	Page   int                   `json:"page" validate:"min=1"`
+	Limit  int                   `json:"limit" validate:"min=1,max=100"`
+}
+
+// InventoryCountListResponse representa a resposta de listagem de sessões de inventário
+type InventoryCountListResponse struct {
+	Counts []InventoryCount `json:"counts"`
+	Total  int              `json:"total"`
+	Page   int              `json:"page"`
+}
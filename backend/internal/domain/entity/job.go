@@ -0,0 +1,43 @@
+package entity
+
+import "time"
+
+// JobRunStatus representa o status de uma execução de job
+type JobRunStatus string
+
+const (
+	JobRunStatusExecutando JobRunStatus = "EXECUTANDO"
+	JobRunStatusSucesso    JobRunStatus = "SUCESSO"
+	JobRunStatusFalha      JobRunStatus = "FALHA"
+)
+
+// JobTrigger representa a origem de uma execução de job
+type JobTrigger string
+
+const (
+	JobTriggerAgendado JobTrigger = "AGENDADO"
+	JobTriggerManual   JobTrigger = "MANUAL"
+)
+
+// JobRun representa uma execução de job agendado
+type JobRun struct {
+	ID                string       `json:"id"`
+	JobName           string       `json:"jobName"`
+	Trigger           JobTrigger   `json:"trigger"`
+	Status            JobRunStatus `json:"status"`
+	TriggeredByUserID *string      `json:"triggeredByUserId,omitempty"` // apenas execuções manuais
+	Instance          string       `json:"instance"`                    // réplica que executou (host-pid)
+	Affected          *int         `json:"affected,omitempty"`          // registros processados
+	Error             *string      `json:"error,omitempty"`
+	StartedAt         time.Time    `json:"startedAt"`
+	FinishedAt        *time.Time   `json:"finishedAt,omitempty"`
+}
+
+// ScheduledJob representa um job registrado no agendador
+type ScheduledJob struct {
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	IntervalSeconds int        `json:"intervalSeconds"`
+	LastRun         *JobRun    `json:"lastRun,omitempty"`
+	NextRunAt       *time.Time `json:"nextRunAt,omitempty"` // previsão da próxima execução agendada
+}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// StoneDensity é a densidade média usada para estimar o peso das chapas (kg/m³, granitos e mármores)
+const StoneDensity = 2700.0
+
+// EstimateSlabWeight estima o peso em kg a partir da área (m²) e da espessura (cm)
+func EstimateSlabWeight(area, thickness float64) float64 {
+	return area * (thickness / 100) * StoneDensity
+}
+
+// PackingListNumber gera o número do romaneio a partir do ID da venda
+func PackingListNumber(saleID string) string {
+	short := strings.ReplaceAll(saleID, "-", "")
+	if len(short) > 8 {
+		short = short[:8]
+	}
+	return "ROM-" + strings.ToUpper(short)
+}
+
+// PackingListCarrier representa a transportadora responsável pela retirada
+type PackingListCarrier struct {
+	Name         string  `json:"name" validate:"required,min=2,max=255"`
+	Document     *string `json:"document,omitempty" validate:"omitempty,max=20"` // CNPJ/CPF
+	VehiclePlate *string `json:"vehiclePlate,omitempty" validate:"omitempty,max=10"`
+	DriverName   *string `json:"driverName,omitempty" validate:"omitempty,max=255"`
+}
+
+// PackingListParty representa o emitente ou o destinatário do romaneio
+type PackingListParty struct {
+	Name     string  `json:"name"`
+	Document *string `json:"document,omitempty"`
+	Contact  *string `json:"contact,omitempty"`
+	Address  *string `json:"address,omitempty"`
+}
+
+// PackingListItem representa uma linha do romaneio: uma chapa numerada,
+// um retalho ou o agregado de um lote sem rastreamento por chapa
+type PackingListItem struct {
+	BatchID     string  `json:"batchId"`
+	BatchCode   string  `json:"batchCode"`
+	SlabNumber  *int    `json:"slabNumber,omitempty"`
+	RemnantCode *string `json:"remnantCode,omitempty"`
+	ProductName string  `json:"productName"`
+	Material    string  `json:"material,omitempty"`
+	Finish      string  `json:"finish,omitempty"`
+	Quantity    int     `json:"quantity"`
+	Height      float64 `json:"height"`    // cm
+	Width       float64 `json:"width"`     // cm
+	Thickness   float64 `json:"thickness"` // cm
+	Area        float64 `json:"area"`      // m² (total da linha)
+	Weight      float64 `json:"weight"`    // kg estimado (total da linha)
+}
+
+// PackingListDocument é o conteúdo estruturado do romaneio (também exportado em JSON)
+type PackingListDocument struct {
+	Number      string              `json:"number"`
+	SaleID      string              `json:"saleId"`
+	SaleDate    time.Time           `json:"saleDate"`
+	Issuer      PackingListParty    `json:"issuer"`
+	Customer    PackingListParty    `json:"customer"`
+	Carrier     *PackingListCarrier `json:"carrier,omitempty"`
+	Items       []PackingListItem   `json:"items"`
+	TotalSlabs  int                 `json:"totalSlabs"`
+	TotalArea   float64             `json:"totalArea"`   // m²
+	TotalWeight float64             `json:"totalWeight"` // kg estimado
+	Notes       *string             `json:"notes,omitempty"`
+	GeneratedAt time.Time           `json:"generatedAt"`
+}
+
+// Value implements the driver.Valuer interface
+func (d PackingListDocument) Value() (driver.Value, error) {
+	if d.Items == nil {
+		d.Items = []PackingListItem{}
+	}
+	return json.Marshal(d)
+}
+
+// Scan implements the sql.Scanner interface
+func (d *PackingListDocument) Scan(value interface{}) error {
+	if value == nil {
+		*d = PackingListDocument{}
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, d)
+}
+
+// PackingList representa o romaneio armazenado de uma venda
+type PackingList struct {
+	ID                string              `json:"id"`
+	SaleID            string              `json:"saleId"`
+	IndustryID        string              `json:"industryId"`
+	Number            string              `json:"number"`
+	Document          PackingListDocument `json:"document"`
+	PDFURL            string              `json:"pdfUrl"`  // PDF no storage
+	JSONURL           string              `json:"jsonUrl"` // JSON no storage
+	GeneratedByUserID *string             `json:"generatedByUserId,omitempty"`
+	GeneratedAt       time.Time           `json:"generatedAt"`
+	CreatedAt         time.Time           `json:"createdAt"`
+	UpdatedAt         time.Time           `json:"updatedAt"`
+}
+
+// GeneratePackingListInput representa os dados para (re)gerar o romaneio de uma venda
+type GeneratePackingListInput struct {
+	Carrier *PackingListCarrier `json:"carrier,omitempty"` // vazio mantém a transportadora do romaneio anterior
+	Notes   *string             `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
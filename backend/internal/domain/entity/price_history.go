@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+)
+
+// PriceChangeStatus representa o status de uma alteração de preço
+type PriceChangeStatus string
+
+const (
+	PriceChangeStatusAgendado  PriceChangeStatus = "AGENDADO"
+	PriceChangeStatusAplicado  PriceChangeStatus = "APLICADO"
+	PriceChangeStatusCancelado PriceChangeStatus = "CANCELADO"
+)
+
+// PriceChange representa um registro do histórico de preços de um produto ou lote
+type PriceChange struct {
+	ID                string            `json:"id"`
+	IndustryID        string            `json:"industryId"`
+	ProductID         *string           `json:"productId,omitempty"` // preenchido para preço base de produto
+	BatchID           *string           `json:"batchId,omitempty"`   // preenchido para preço de lote
+	Price             float64           `json:"price"`
+	PriceUnit         PriceUnit         `json:"priceUnit"`
+	PreviousPrice     *float64          `json:"previousPrice,omitempty"` // preço substituído na aplicação
+	PreviousPriceUnit *PriceUnit        `json:"previousPriceUnit,omitempty"`
+	EffectiveFrom     time.Time         `json:"effectiveFrom"`
+	Status            PriceChangeStatus `json:"status"`
+	AppliedAt         *time.Time        `json:"appliedAt,omitempty"`
+	Notes             *string           `json:"notes,omitempty"`
+	CreatedByUserID   *string           `json:"createdByUserId,omitempty"` // nil = alteração direta no cadastro
+	CreatedByName     *string           `json:"createdByName,omitempty"`
+	CreatedAt         time.Time         `json:"createdAt"`
+}
+
+// IsScheduled verifica se a alteração ainda aguarda aplicação
+func (p *PriceChange) IsScheduled() bool {
+	return p.Status == PriceChangeStatusAgendado
+}
+
+// SchedulePriceChangeInput representa os dados para agendar uma alteração de preço
+type SchedulePriceChangeInput struct {
+	Price         float64   `json:"price" validate:"required,gt=0"`
+	PriceUnit     PriceUnit `json:"priceUnit" validate:"omitempty,oneof=M2 FT2"`
+	EffectiveFrom string    `json:"effectiveFrom" validate:"required"` // ISO date (RFC3339)
+	Notes         *string   `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
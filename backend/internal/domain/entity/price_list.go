@@ -0,0 +1,132 @@
+package entity
+
+import (
+	"time"
+)
+
+// PriceRuleType representa o tipo de regra de uma tabela de preço
+type PriceRuleType string
+
+const (
+	PriceRuleTypePercentual PriceRuleType = "PERCENTUAL" // ajuste percentual sobre o preço do lote
+	PriceRuleTypeFixo       PriceRuleType = "FIXO"       // preço fixo por unidade de área
+)
+
+// IsValid verifica se o tipo de regra é válido
+func (t PriceRuleType) IsValid() bool {
+	switch t {
+	case PriceRuleTypePercentual, PriceRuleTypeFixo:
+		return true
+	}
+	return false
+}
+
+// PriceList representa uma tabela de preço nomeada da indústria
+type PriceList struct {
+	ID              string          `json:"id"`
+	IndustryID      string          `json:"industryId"`
+	Name            string          `json:"name"`
+	Description     *string         `json:"description,omitempty"`
+	IsActive        bool            `json:"isActive"`
+	Rules           []PriceListRule `json:"rules"`
+	AssignmentCount int             `json:"assignmentCount"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	UpdatedAt       time.Time       `json:"updatedAt"`
+}
+
+// PriceListRule representa uma regra de preço por produto, material ou geral
+type PriceListRule struct {
+	ID          string        `json:"id"`
+	PriceListID string        `json:"priceListId"`
+	ProductID   *string       `json:"productId,omitempty"` // regra específica do produto
+	Material    *MaterialType `json:"material,omitempty"`  // regra por material
+	RuleType    PriceRuleType `json:"ruleType"`
+	Value       float64       `json:"value"`               // percentual (ex: -10) ou preço fixo
+	PriceUnit   *PriceUnit    `json:"priceUnit,omitempty"` // obrigatório para FIXO
+	CreatedAt   time.Time     `json:"createdAt"`
+}
+
+// RuleFor retorna a regra mais específica para o produto (produto > material > geral)
+func (l *PriceList) RuleFor(productID string, material MaterialType) *PriceListRule {
+	var byMaterial, general *PriceListRule
+	for i := range l.Rules {
+		rule := &l.Rules[i]
+		switch {
+		case rule.ProductID != nil:
+			if *rule.ProductID == productID {
+				return rule
+			}
+		case rule.Material != nil:
+			if *rule.Material == material && byMaterial == nil {
+				byMaterial = rule
+			}
+		default:
+			if general == nil {
+				general = rule
+			}
+		}
+	}
+	if byMaterial != nil {
+		return byMaterial
+	}
+	return general
+}
+
+// Apply aplica a regra sobre um preço, retornando o valor na mesma unidade
+func (r *PriceListRule) Apply(price float64, unit PriceUnit) float64 {
+	if r.RuleType == PriceRuleTypeFixo && r.PriceUnit != nil {
+		return ConvertPrice(r.Value, *r.PriceUnit, unit)
+	}
+	return price * (1 + r.Value/100)
+}
+
+// PriceListAssignment representa a atribuição de uma tabela a um usuário ou cliente
+type PriceListAssignment struct {
+	ID          string    `json:"id"`
+	IndustryID  string    `json:"industryId"`
+	PriceListID string    `json:"priceListId"`
+	UserID      *string   `json:"userId,omitempty"`
+	UserName    *string   `json:"userName,omitempty"`
+	ClienteID   *string   `json:"clienteId,omitempty"`
+	ClienteName *string   `json:"clienteName,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// ResolvedPrice representa o preço de um lote após aplicar a tabela atribuída
+type ResolvedPrice struct {
+	Price         float64   `json:"price"`
+	PriceUnit     PriceUnit `json:"priceUnit"`
+	Currency      Currency  `json:"currency"`              // moeda do lote ou produto (regras FIXO usam a mesma moeda)
+	PriceListID   *string   `json:"priceListId,omitempty"` // nil = preço do lote sem tabela
+	PriceListName *string   `json:"priceListName,omitempty"`
+}
+
+// PriceListRuleInput representa os dados de uma regra da tabela de preço
+type PriceListRuleInput struct {
+	ProductID *string       `json:"productId,omitempty" validate:"omitempty,uuid"`
+	Material  *MaterialType `json:"material,omitempty"`
+	RuleType  PriceRuleType `json:"ruleType" validate:"required,oneof=PERCENTUAL FIXO"`
+	Value     float64       `json:"value"`
+	PriceUnit *PriceUnit    `json:"priceUnit,omitempty" validate:"omitempty,oneof=M2 FT2"`
+}
+
+// CreatePriceListInput representa os dados para criar uma tabela de preço
+type CreatePriceListInput struct {
+	Name        string               `json:"name" validate:"required,min=2,max=100"`
+	Description *string              `json:"description,omitempty" validate:"omitempty,max=500"`
+	Rules       []PriceListRuleInput `json:"rules" validate:"max=500,dive"`
+}
+
+// UpdatePriceListInput representa os dados para atualizar uma tabela de preço (rules substitui todas as regras)
+type UpdatePriceListInput struct {
+	Name        *string               `json:"name,omitempty" validate:"omitempty,min=2,max=100"`
+	Description *string               `json:"description,omitempty" validate:"omitempty,max=500"`
+	IsActive    *bool                 `json:"isActive,omitempty"`
+	Rules       *[]PriceListRuleInput `json:"rules,omitempty" validate:"omitempty,max=500,dive"`
+}
+
+// AssignPriceListInput representa os dados para atribuir uma tabela (informar userId ou clienteId)
+type AssignPriceListInput struct {
+	UserID    *string `json:"userId,omitempty" validate:"omitempty,uuid"`
+	ClienteID *string `json:"clienteId,omitempty" validate:"omitempty,uuid"`
+}
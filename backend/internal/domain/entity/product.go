@@ -57,6 +57,7 @@ type Product struct {
 	Description *string      `json:"description,omitempty"`
 	BasePrice   *float64     `json:"basePrice,omitempty"`   // Preço base por m² definido no produto
 	PriceUnit   PriceUnit    `json:"priceUnit,omitempty"`   // Unidade do preço (M2 ou FT2)
+	Currency    Currency     `json:"currency"`              // Moeda do preço (BRL, USD ou EUR)
 	IsPublic    bool         `json:"isPublic"`
 	IsPublicCatalog bool     `json:"isPublicCatalog"`       // Visível no catálogo público do depósito
 	IsActive    bool         `json:"isActive"`
@@ -75,6 +76,7 @@ type CreateProductInput struct {
 	Description *string      `json:"description,omitempty" validate:"omitempty,max=1000"`
 	BasePrice   *float64     `json:"basePrice,omitempty" validate:"omitempty,gt=0"`   // Preço base por m²
 	PriceUnit   PriceUnit    `json:"priceUnit,omitempty" validate:"omitempty,oneof=M2 FT2"`
+	Currency    Currency     `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
 	IsPublic    bool         `json:"isPublic"`
 	IsPublicCatalog bool     `json:"isPublicCatalog"`
 }
@@ -88,6 +90,7 @@ type UpdateProductInput struct {
 	Description     *string       `json:"description,omitempty" validate:"omitempty,max=1000"`
 	BasePrice       *float64      `json:"basePrice,omitempty" validate:"omitempty,gt=0"`
 	PriceUnit       *PriceUnit    `json:"priceUnit,omitempty" validate:"omitempty,oneof=M2 FT2"`
+	Currency        *Currency     `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
 	IsPublic        *bool         `json:"isPublic,omitempty"`
 	IsPublicCatalog *bool         `json:"isPublicCatalog,omitempty"`
 }
@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Remnant representa um retalho: peça irregular que sobra do corte, vinculada ao lote de origem
+type Remnant struct {
+	ID              string       `json:"id"`
+	IndustryID      string       `json:"industryId"`
+	SourceBatchID   string       `json:"sourceBatchId"`
+	BatchCode       string       `json:"batchCode"` // código do lote de origem
+	ProductID       string       `json:"productId"`
+	ProductName     string       `json:"productName"`
+	Material        MaterialType `json:"material"`
+	Code            string       `json:"code"`          // código do lote + sequencial (ex: GRA-000123-R01)
+	Height          float64      `json:"height"`        // cm (maior comprimento)
+	Width           float64      `json:"width"`         // cm (maior largura)
+	Thickness       float64      `json:"thickness"`     // cm
+	Area            float64      `json:"area"`          // m² útil
+	IndustryPrice   float64      `json:"industryPrice"` // preço por unidade de área
+	PriceUnit       PriceUnit    `json:"priceUnit"`
+	Currency        Currency     `json:"currency"`
+	Status          BatchStatus  `json:"status"`
+	Notes           *string      `json:"notes,omitempty"`
+	CreatedByUserID *string      `json:"createdByUserId,omitempty"`
+	Photos          []Media      `json:"photos,omitempty"` // Populated no detalhe do retalho
+	CreatedAt       time.Time    `json:"createdAt"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+
+	// Campo calculado (não persistido)
+	TotalPrice float64 `json:"totalPrice"` // preço da peça (preço por m² × área)
+}
+
+// FormatRemnantCode monta o código do retalho a partir do código do lote e do sequencial
+func FormatRemnantCode(batchCode string, sequence int) string {
+	return fmt.Sprintf("%s-R%02d", batchCode, sequence)
+}
+
+// BoundingArea retorna a área do retângulo que envolve a peça em m²
+func BoundingArea(height, width float64) float64 {
+	return (height * width) / 10000
+}
+
+// CalculateTotalPrice calcula o preço da peça inteira
+func (r *Remnant) CalculateTotalPrice() float64 {
+	return ConvertPrice(r.IndustryPrice, r.PriceUnit, PriceUnitM2) * r.Area
+}
+
+// PopulateCalculatedFields preenche os campos calculados
+func (r *Remnant) PopulateCalculatedFields() {
+	r.TotalPrice = r.CalculateTotalPrice()
+}
+
+// IsAvailable verifica se o retalho pode ser reservado
+func (r *Remnant) IsAvailable() bool {
+	return r.Status == BatchStatusDisponivel
+}
+
+// CreateRemnantInput representa os dados para cadastrar um retalho
+type CreateRemnantInput struct {
+	SourceBatchID string     `json:"sourceBatchId" validate:"required,uuid"`
+	Height        float64    `json:"height" validate:"required,gt=0,lte=1000"`
+	Width         float64    `json:"width" validate:"required,gt=0,lte=1000"`
+	Thickness     *float64   `json:"thickness,omitempty" validate:"omitempty,gt=0,lte=100"`     // padrão: espessura do lote
+	Area          *float64   `json:"area,omitempty" validate:"omitempty,gt=0"`                  // padrão: altura × largura
+	IndustryPrice *float64   `json:"industryPrice,omitempty" validate:"omitempty,gt=0"`         // padrão: preço do lote
+	PriceUnit     *PriceUnit `json:"priceUnit,omitempty" validate:"omitempty,oneof=M2 FT2"`     // padrão: unidade do lote
+	Currency      *Currency  `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"` // padrão: moeda do lote
+	Notes         *string    `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// UpdateRemnantInput representa os dados para atualizar um retalho
+type UpdateRemnantInput struct {
+	Height        *float64     `json:"height,omitempty" validate:"omitempty,gt=0,lte=1000"`
+	Width         *float64     `json:"width,omitempty" validate:"omitempty,gt=0,lte=1000"`
+	Thickness     *float64     `json:"thickness,omitempty" validate:"omitempty,gt=0,lte=100"`
+	Area          *float64     `json:"area,omitempty" validate:"omitempty,gt=0"`
+	IndustryPrice *float64     `json:"industryPrice,omitempty" validate:"omitempty,gt=0"`
+	PriceUnit     *PriceUnit   `json:"priceUnit,omitempty" validate:"omitempty,oneof=M2 FT2"`
+	Currency      *Currency    `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"`
+	Status        *BatchStatus `json:"status,omitempty" validate:"omitempty,oneof=DISPONIVEL INATIVO"` // reserva e venda seguem o fluxo de reservas
+	Notes         *string      `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// RemnantFilters representa os filtros para busca de retalhos
+type RemnantFilters struct {
+	SourceBatchID *string       `json:"sourceBatchId,omitempty"`
+	ProductID     *string       `json:"productId,omitempty"`
+	Material      *MaterialType `json:"material,omitempty"`
+	Status        *BatchStatus  `json:"status,omitempty"`
+	Search        *string       `json:"search,omitempty"`    // código do retalho, do lote ou nome do produto
+	MinHeight     *float64      `json:"minHeight,omitempty"` // peça com pelo menos estas dimensões (cm)
+	MinWidth      *float64      `json:"minWidth,omitempty"`
+	MinArea       *float64      `json:"minArea,omitempty"` // m²
+	MaxArea       *float64      `json:"maxArea,omitempty"`
+	Page          int           `json:"page" validate:"min=1"`
+	Limit         int           `json:"limit" validate:"min=1,max=100"`
+}
+
+// RemnantListResponse representa a resposta de listagem de retalhos
+type RemnantListResponse struct {
+	Remnants []Remnant `json:"remnants"`
+	Total    int       `json:"total"`
+	Page     int       `json:"page"`
+}
@@ -20,6 +20,10 @@ const (
 	ReservationStatusRejeitada         ReservationStatus = "REJEITADA"
 )
 
+// ReservationApprovalWindow é o prazo para o admin aprovar uma reserva pendente;
+// vencido o prazo, a reserva expira e as chapas voltam ao estoque
+const ReservationApprovalWindow = 48 * time.Hour
+
 // IsValid verifica se o status da reserva é válido
 func (r ReservationStatus) IsValid() bool {
 	switch r {
@@ -51,6 +55,7 @@ func (r ReservationStatus) CanBeConverted() bool {
 type Reservation struct {
 	ID                    string            `json:"id"`
 	BatchID               string            `json:"batchId"`
+	RemnantID             *string           `json:"remnantId,omitempty"` // retalho reservado (batchId aponta para o lote de origem)
 	IndustryID            *string           `json:"industryId,omitempty"`
 	ClienteID             *string           `json:"clienteId,omitempty"`
 	ReservedByUserID      string            `json:"reservedByUserId"`
@@ -60,42 +65,65 @@ type Reservation struct {
 	ExpiresAt             time.Time         `json:"expiresAt"`
 	CreatedAt             time.Time         `json:"createdAt"`
 	IsActive              bool              `json:"isActive"`
+	SlabNumbers           []int             `json:"slabNumbers,omitempty"` // Chapas específicas reservadas (quando o lote possui rastreamento por chapa)
 
 	// Campos de preço do broker (todos em PREÇO POR M²)
 	ReservedPrice   *float64 `json:"reservedPrice,omitempty"`   // Preço por m² indicado pelo broker para indústria
 	BrokerSoldPrice *float64 `json:"brokerSoldPrice,omitempty"` // Preço por m² que broker vendeu ao cliente (privado)
 
+	// Preço do lote vigente na criação da reserva
+	IndustryPrice *float64   `json:"industryPrice,omitempty"`
+	PriceUnit     *PriceUnit `json:"priceUnit,omitempty"`
+	Currency      *Currency  `json:"currency,omitempty"`
+
+	// Preço por m² acordado na negociação (definido na aprovação; usado na confirmação da venda)
+	AgreedPrice *float64 `json:"agreedPrice,omitempty"`
+
 	// Campos de aprovação
 	ApprovedBy        *string    `json:"approvedBy,omitempty"`
 	ApprovedAt        *time.Time `json:"approvedAt,omitempty"`
 	RejectionReason   *string    `json:"rejectionReason,omitempty"`
 	ApprovalExpiresAt *time.Time `json:"approvalExpiresAt,omitempty"`
 
+	// Histórico de prorrogações do prazo
+	Extensions []ReservationExtension `json:"extensions,omitempty"`
+
+	// Propostas de preço da negociação
+	Offers []ReservationOffer `json:"offers,omitempty"`
+
 	// Relacionamentos (populated quando necessário)
 	Batch          *Batch   `json:"batch,omitempty"`
+	Remnant        *Remnant `json:"remnant,omitempty"`
 	Cliente        *Cliente `json:"cliente,omitempty"`
 	ReservedBy     *User    `json:"reservedBy,omitempty"`
 	ApprovedByUser *User    `json:"approvedByUser,omitempty"`
 }
 
+// reservedArea retorna a área total reservada em m² (área do retalho ou chapas × área da chapa)
+func (r *Reservation) reservedArea() float64 {
+	if r.Remnant != nil {
+		return r.Remnant.Area
+	}
+	if r.Batch == nil {
+		return 0
+	}
+	return r.Batch.CalculateSlabArea() * float64(r.QuantitySlabsReserved)
+}
+
 // GetReservedTotalPrice retorna o valor total da reserva (preço por m² × área total reservada)
 func (r *Reservation) GetReservedTotalPrice() float64 {
-	if r.ReservedPrice == nil || r.Batch == nil {
+	if r.ReservedPrice == nil {
 		return 0
 	}
-	slabArea := r.Batch.CalculateSlabArea()
-	totalArea := slabArea * float64(r.QuantitySlabsReserved)
-	return *r.ReservedPrice * totalArea
+	return *r.ReservedPrice * r.reservedArea()
 }
 
 // GetBrokerSoldTotalPrice retorna o valor total que broker vendeu (preço por m² × área total)
 func (r *Reservation) GetBrokerSoldTotalPrice() float64 {
-	if r.BrokerSoldPrice == nil || r.Batch == nil {
+	if r.BrokerSoldPrice == nil {
 		return 0
 	}
-	slabArea := r.Batch.CalculateSlabArea()
-	totalArea := slabArea * float64(r.QuantitySlabsReserved)
-	return *r.BrokerSoldPrice * totalArea
+	return *r.BrokerSoldPrice * r.reservedArea()
 }
 
 // IsExpired verifica se a reserva está expirada
@@ -103,25 +131,34 @@ func (r *Reservation) IsExpired() bool {
 	return time.Now().After(r.ExpiresAt) && r.Status == ReservationStatusAtiva
 }
 
+// IsApprovalExpired verifica se o prazo de aprovação da reserva pendente já passou
+func (r *Reservation) IsApprovalExpired() bool {
+	return r.Status == ReservationStatusPendenteAprovacao && r.ApprovalExpiresAt != nil && time.Now().After(*r.ApprovalExpiresAt)
+}
+
 // CreateReservationInput representa os dados para criar uma reserva
 type CreateReservationInput struct {
-	BatchID               string   `json:"batchId" validate:"required,uuid"`
-	QuantitySlabsReserved int      `json:"quantitySlabsReserved" validate:"required,gt=0"`
+	BatchID               string   `json:"batchId" validate:"required_without=RemnantID,omitempty,uuid"`
+	RemnantID             *string  `json:"remnantId,omitempty" validate:"omitempty,uuid"` // reserva de retalho (dispensa batchId e quantidade)
+	QuantitySlabsReserved int      `json:"quantitySlabsReserved" validate:"required_without=RemnantID,omitempty,gt=0"`
 	ClienteID             *string  `json:"clienteId,omitempty" validate:"omitempty,uuid"`
 	CustomerName          *string  `json:"customerName,omitempty" validate:"omitempty,min=2"`
 	CustomerContact       *string  `json:"customerContact,omitempty"`
 	ReservedPrice         *float64 `json:"reservedPrice,omitempty" validate:"omitempty,gt=0"`   // Preço por m² indicado pelo broker
 	BrokerSoldPrice       *float64 `json:"brokerSoldPrice,omitempty" validate:"omitempty,gt=0"` // Preço por m² que broker vendeu
-	ExpiresAt             *string  `json:"expiresAt,omitempty"`                                 // ISO date, default +7 dias
+	ExpiresAt             *string  `json:"expiresAt,omitempty"`                                 // ISO date, default pela política da indústria
 	Notes                 *string  `json:"notes,omitempty" validate:"omitempty,max=500"`
+	SlabNumbers           []int    `json:"slabNumbers,omitempty" validate:"omitempty,dive,gt=0"` // Chapas específicas (opcional)
 }
 
 // ConfirmSaleInput representa os dados para confirmar uma venda
 type ConfirmSaleInput struct {
-	QuantitySlabsSold int     `json:"quantitySlabsSold" validate:"required,gt=0"`
-	FinalSoldPrice    float64 `json:"finalSoldPrice" validate:"required,gt=0"`
-	InvoiceURL        *string `json:"invoiceUrl,omitempty" validate:"omitempty,url"`
-	Notes             *string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+	QuantitySlabsSold int                 `json:"quantitySlabsSold" validate:"required,gt=0"`
+	FinalSoldPrice    float64             `json:"finalSoldPrice" validate:"omitempty,gt=0"` // Valor total; opcional quando há preço acordado (preço × área vendida)
+	InvoiceURL        *string             `json:"invoiceUrl,omitempty" validate:"omitempty,url"`
+	Notes             *string             `json:"notes,omitempty" validate:"omitempty,max=1000"`
+	SlabNumbers       []int               `json:"slabNumbers,omitempty" validate:"omitempty,dive,gt=0"` // Chapas reservadas que foram vendidas (opcional)
+	Carrier           *PackingListCarrier `json:"carrier,omitempty"`                                    // Transportadora do romaneio (opcional)
 }
 
 // ReservationFilters representa os filtros para busca de reservas
@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+// ReservationEventType representa o tipo de evento na linha do tempo de uma reserva
+type ReservationEventType string
+
+const (
+	ReservationEventCriada                ReservationEventType = "CRIADA"
+	ReservationEventAprovada              ReservationEventType = "APROVADA"
+	ReservationEventRejeitada             ReservationEventType = "REJEITADA"
+	ReservationEventCancelada             ReservationEventType = "CANCELADA"
+	ReservationEventExpirada              ReservationEventType = "EXPIRADA"
+	ReservationEventVendida               ReservationEventType = "VENDIDA"
+	ReservationEventProrrogacaoSolicitada ReservationEventType = "PRORROGACAO_SOLICITADA"
+	ReservationEventProrrogada            ReservationEventType = "PRORROGADA"
+	ReservationEventProrrogacaoNegada     ReservationEventType = "PRORROGACAO_NEGADA"
+	ReservationEventPropostaPreco         ReservationEventType = "PROPOSTA_PRECO"
+	ReservationEventPropostaAceita        ReservationEventType = "PROPOSTA_ACEITA"
+)
+
+// ReservationEvent representa uma transição registrada no histórico da reserva
+type ReservationEvent struct {
+	ID            string               `json:"id"`
+	ReservationID string               `json:"reservationId"`
+	Type          ReservationEventType `json:"type"`
+	FromStatus    ReservationStatus    `json:"fromStatus,omitempty"`
+	ToStatus      ReservationStatus    `json:"toStatus,omitempty"`
+	ActorUserID   *string              `json:"actorUserId,omitempty"` // nil = sistema (ex: expiração automática)
+	OldPrice      *float64             `json:"oldPrice,omitempty"`    // preço por m² proposto antes do evento
+	NewPrice      *float64             `json:"newPrice,omitempty"`    // preço por m² proposto após o evento
+	OldExpiresAt  *time.Time           `json:"oldExpiresAt,omitempty"`
+	NewExpiresAt  *time.Time           `json:"newExpiresAt,omitempty"`
+	SaleID        *string              `json:"saleId,omitempty"`
+	Notes         *string              `json:"notes,omitempty"` // motivo, justificativa ou detalhes do evento
+	CreatedAt     time.Time            `json:"createdAt"`
+
+	// Relacionamentos (populated quando necessário)
+	ActorName string `json:"actorName,omitempty"` // nome de quem executou a ação
+}
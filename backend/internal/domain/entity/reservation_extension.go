@@ -0,0 +1,57 @@
+package entity
+
+import "time"
+
+// ReservationExtensionStatus representa o status de um pedido de prorrogação
+type ReservationExtensionStatus string
+
+const (
+	ReservationExtensionStatusPendente ReservationExtensionStatus = "PENDENTE"
+	ReservationExtensionStatusAprovada ReservationExtensionStatus = "APROVADA"
+	ReservationExtensionStatusNegada   ReservationExtensionStatus = "NEGADA"
+)
+
+// IsValid verifica se o status do pedido de prorrogação é válido
+func (s ReservationExtensionStatus) IsValid() bool {
+	switch s {
+	case ReservationExtensionStatusPendente, ReservationExtensionStatusAprovada, ReservationExtensionStatusNegada:
+		return true
+	}
+	return false
+}
+
+// ReservationExtension representa um pedido de prorrogação do prazo de uma reserva
+type ReservationExtension struct {
+	ID                 string                     `json:"id"`
+	ReservationID      string                     `json:"reservationId"`
+	IndustryID         string                     `json:"industryId"`
+	RequestedByUserID  string                     `json:"requestedByUserId"`
+	Justification      string                     `json:"justification"`
+	OldExpiresAt       time.Time                  `json:"oldExpiresAt"`
+	RequestedExpiresAt time.Time                  `json:"requestedExpiresAt"`
+	NewExpiresAt       *time.Time                 `json:"newExpiresAt,omitempty"` // prazo aplicado (apenas aprovados)
+	Status             ReservationExtensionStatus `json:"status"`
+	DecidedByUserID    *string                    `json:"decidedByUserId,omitempty"`
+	DecidedAt          *time.Time                 `json:"decidedAt,omitempty"`
+	DecisionReason     *string                    `json:"decisionReason,omitempty"` // motivo da negativa
+	CreatedAt          time.Time                  `json:"createdAt"`
+	UpdatedAt          time.Time                  `json:"updatedAt"`
+
+	// Relacionamentos (populated quando necessário)
+	RequestedBy string  `json:"requestedBy,omitempty"` // nome de quem pediu
+	DecidedBy   *string `json:"decidedBy,omitempty"`   // nome de quem decidiu
+}
+
+// RequestReservationExtensionInput representa os dados para pedir a prorrogação de uma reserva
+type RequestReservationExtensionInput struct {
+	ExpiresAt     string `json:"expiresAt" validate:"required"` // ISO date, novo prazo desejado
+	Justification string `json:"justification" validate:"required,min=10,max=1000"`
+}
+
+// ReservationExtensionFilters representa os filtros dos pedidos de prorrogação
+type ReservationExtensionFilters struct {
+	IndustryID    *string                     `json:"industryId,omitempty"`
+	UserID        *string                     `json:"userId,omitempty"`
+	ReservationID *string                     `json:"reservationId,omitempty"`
+	Status        *ReservationExtensionStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+// ReservationOfferSide representa o lado da negociação que fez a proposta
+type ReservationOfferSide string
+
+const (
+	ReservationOfferSideIndustria ReservationOfferSide = "INDUSTRIA" // admin da indústria (contraproposta)
+	ReservationOfferSideBroker    ReservationOfferSide = "BROKER"    // quem reservou
+)
+
+// ReservationOfferStatus representa o status de uma proposta de preço
+type ReservationOfferStatus string
+
+const (
+	ReservationOfferStatusPendente    ReservationOfferStatus = "PENDENTE" // aguarda resposta do outro lado
+	ReservationOfferStatusAceita      ReservationOfferStatus = "ACEITA"
+	ReservationOfferStatusSubstituida ReservationOfferStatus = "SUBSTITUIDA" // trocada por uma nova proposta
+	ReservationOfferStatusEncerrada   ReservationOfferStatus = "ENCERRADA"   // reserva rejeitada ou expirada
+)
+
+// ReservationOffer representa uma proposta de preço por m² na negociação de uma reserva pendente
+type ReservationOffer struct {
+	ID                string                 `json:"id"`
+	ReservationID     string                 `json:"reservationId"`
+	OfferedByUserID   string                 `json:"offeredByUserId"`
+	Side              ReservationOfferSide   `json:"side"`
+	Price             float64                `json:"price"` // preço por m²
+	Notes             *string                `json:"notes,omitempty"`
+	Status            ReservationOfferStatus `json:"status"`
+	RespondedByUserID *string                `json:"respondedByUserId,omitempty"`
+	RespondedAt       *time.Time             `json:"respondedAt,omitempty"`
+	CreatedAt         time.Time              `json:"createdAt"`
+
+	// Relacionamentos (populated quando necessário)
+	OfferedBy string `json:"offeredBy,omitempty"` // nome de quem fez a proposta
+}
+
+// MakeReservationOfferInput representa uma proposta (ou contraproposta) de preço por m²
+type MakeReservationOfferInput struct {
+	Price float64 `json:"price" validate:"required,gt=0"`
+	Notes *string `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
@@ -0,0 +1,67 @@
+package entity
+
+import "time"
+
+// WaitlistStatus representa o status de um pedido na fila de espera
+type WaitlistStatus string
+
+const (
+	WaitlistStatusAguardando WaitlistStatus = "AGUARDANDO"
+	WaitlistStatusConvertida WaitlistStatus = "CONVERTIDA" // virou reserva (pendente de aprovação ou ativa, conforme a política)
+	WaitlistStatusCancelada  WaitlistStatus = "CANCELADA"
+)
+
+// IsValid verifica se o status da fila de espera é válido
+func (s WaitlistStatus) IsValid() bool {
+	switch s {
+	case WaitlistStatusAguardando, WaitlistStatusConvertida, WaitlistStatusCancelada:
+		return true
+	}
+	return false
+}
+
+// WaitlistApprovalWindow é o prazo para o admin aprovar a reserva criada a partir da fila (quando a política exige aprovação);
+// vencido o prazo, as chapas seguem para o próximo da fila
+const WaitlistApprovalWindow = ReservationApprovalWindow
+
+// WaitlistEntry representa um pedido de reserva aguardando chapas de um lote (FIFO)
+type WaitlistEntry struct {
+	ID                string         `json:"id"`
+	BatchID           string         `json:"batchId"`
+	IndustryID        string         `json:"industryId"`
+	RequestedByUserID string         `json:"requestedByUserId"`
+	ClienteID         *string        `json:"clienteId,omitempty"`
+	QuantitySlabs     int            `json:"quantitySlabs"`
+	ReservedPrice     *float64       `json:"reservedPrice,omitempty"`   // Preço por m² indicado pelo broker
+	BrokerSoldPrice   *float64       `json:"brokerSoldPrice,omitempty"` // Preço por m² que broker vendeu
+	Notes             *string        `json:"notes,omitempty"`
+	Status            WaitlistStatus `json:"status"`
+	Position          int            `json:"position,omitempty"` // posição na fila do lote (apenas aguardando)
+	ReservationID     *string        `json:"reservationId,omitempty"`
+	ConvertedAt       *time.Time     `json:"convertedAt,omitempty"`
+	CreatedAt         time.Time      `json:"createdAt"`
+	UpdatedAt         time.Time      `json:"updatedAt"`
+
+	// Relacionamentos (populated quando necessário)
+	BatchCode   string `json:"batchCode,omitempty"`
+	ProductName string `json:"productName,omitempty"`
+	RequestedBy string `json:"requestedBy,omitempty"` // nome de quem pediu
+}
+
+// JoinWaitlistInput representa os dados para entrar na fila de espera de um lote
+type JoinWaitlistInput struct {
+	BatchID         string   `json:"batchId" validate:"required,uuid"`
+	QuantitySlabs   int      `json:"quantitySlabs" validate:"required,gt=0"`
+	ClienteID       *string  `json:"clienteId,omitempty" validate:"omitempty,uuid"`
+	ReservedPrice   *float64 `json:"reservedPrice,omitempty" validate:"omitempty,gt=0"`
+	BrokerSoldPrice *float64 `json:"brokerSoldPrice,omitempty" validate:"omitempty,gt=0"`
+	Notes           *string  `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// WaitlistFilters representa os filtros da fila de espera
+type WaitlistFilters struct {
+	IndustryID *string         `json:"industryId,omitempty"`
+	UserID     *string         `json:"userId,omitempty"`
+	BatchID    *string         `json:"batchId,omitempty"`
+	Status     *WaitlistStatus `json:"status,omitempty"`
+}
@@ -8,6 +8,7 @@ import (
 type Sale struct {
 	ID                string    `json:"id"`
 	BatchID           string    `json:"batchId"`
+	RemnantID         *string   `json:"remnantId,omitempty"` // retalho vendido (batchId aponta para o lote de origem)
 	SoldByUserID      *string   `json:"soldByUserId,omitempty"`
 	SellerName        string    `json:"sellerName"`        // Nome do vendedor (sistema ou custom)
 	IndustryID        string    `json:"industryId"`
@@ -19,6 +20,7 @@ type Sale struct {
 	PricePerUnit      float64   `json:"pricePerUnit"`      // Preço por unidade de área na venda
 	PriceUnit         PriceUnit `json:"priceUnit"`         // Unidade de preço usada na venda
 	SalePrice         float64   `json:"salePrice"`         // Preço final pago pelo cliente (valor da indústria)
+	Currency          Currency  `json:"currency"`          // Moeda dos valores da venda
 	BrokerSoldPrice   *float64  `json:"brokerSoldPrice,omitempty"` // Valor que o broker vendeu para o cliente final
 	BrokerCommission  float64   `json:"brokerCommission"`  // Comissão do broker/vendedor
 	NetIndustryValue  float64   `json:"netIndustryValue"`  // Valor líquido para indústria
@@ -26,6 +28,7 @@ type Sale struct {
 	InvoiceURL        *string   `json:"invoiceUrl,omitempty"`
 	Notes             *string   `json:"notes,omitempty"`
 	CreatedAt         time.Time `json:"createdAt"`
+	SlabNumbers       []int     `json:"slabNumbers,omitempty"` // Chapas vendidas (quando o lote possui rastreamento por chapa)
 	Batch             *Batch    `json:"batch,omitempty"`   // Populated quando necessário
 	SoldBy            *User     `json:"soldBy,omitempty"`  // Populated quando necessário
 	Cliente           *Cliente  `json:"cliente,omitempty"` // Populated quando necessário
@@ -45,11 +48,14 @@ type CreateSaleInput struct {
 	PricePerUnit      float64   `json:"pricePerUnit" validate:"required,gt=0"`
 	PriceUnit         PriceUnit `json:"priceUnit" validate:"required,oneof=M2 FT2"`
 	SalePrice         float64   `json:"salePrice" validate:"required,gt=0"`
+	Currency          Currency  `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"` // padrão: moeda do lote
 	BrokerCommission  float64   `json:"brokerCommission" validate:"gte=0"`
 	NetIndustryValue  float64   `json:"netIndustryValue" validate:"required,gt=0"`
 	InvoiceURL        *string                 `json:"invoiceUrl,omitempty" validate:"omitempty,url"`
 	Notes             *string                 `json:"notes,omitempty" validate:"omitempty,max=1000"`
 	NewClient         *CreateSaleClientInput  `json:"newClient,omitempty"` // Para criar cliente inline
+	SlabNumbers       []int                   `json:"slabNumbers,omitempty" validate:"omitempty,dive,gt=0"` // Chapas específicas vendidas (opcional)
+	Carrier           *PackingListCarrier     `json:"carrier,omitempty"`                                    // Transportadora do romaneio (opcional)
 }
 
 // CreateSaleClientInput representa os dados para criar cliente inline (renamed to avoid conflict)
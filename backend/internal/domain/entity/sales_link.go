@@ -35,6 +35,9 @@ type SalesLink struct {
 	Title           *string          `json:"title,omitempty"`
 	CustomMessage   *string          `json:"customMessage,omitempty"`
 	DisplayPrice    *float64         `json:"displayPrice,omitempty"`
+	PriceSnapshot   *float64         `json:"priceSnapshot,omitempty"` // preço vigente na criação do link
+	PriceSnapshotUnit *PriceUnit     `json:"priceSnapshotUnit,omitempty"`
+	Currency        Currency         `json:"currency"` // moeda do preço de exibição, do preço vigente e dos itens
 	ShowPrice       bool             `json:"showPrice"`
 	ViewsCount      int              `json:"viewsCount"`
 	ExpiresAt       *time.Time       `json:"expiresAt,omitempty"`
@@ -84,6 +87,7 @@ type CreateSalesLinkInput struct {
 	CustomMessage *string              `json:"customMessage,omitempty" validate:"omitempty,max=500"`
 	SlugToken     string               `json:"slugToken" validate:"required,min=3,max=50,slug"`
 	DisplayPrice  *float64             `json:"displayPrice,omitempty" validate:"omitempty,gt=0"`
+	Currency      Currency             `json:"currency,omitempty" validate:"omitempty,oneof=BRL USD EUR"` // padrão: moeda do lote/produto
 	ShowPrice     bool                 `json:"showPrice"`
 	ExpiresAt     *string              `json:"expiresAt,omitempty"` // ISO date
 	IsActive      bool                 `json:"isActive"`
@@ -141,6 +145,12 @@ type PublicBatch struct {
 	TotalArea      float64  `json:"totalArea"`
 	AvailableSlabs int      `json:"availableSlabs"`
 	OriginQuarry   *string  `json:"originQuarry,omitempty"`
+	WarehouseCode  *string  `json:"warehouseCode,omitempty"`
+	WarehouseName  *string  `json:"warehouseName,omitempty"`
+	LocationCode   *string  `json:"locationCode,omitempty"`
+	Price          *float64   `json:"price,omitempty"`     // preço por unidade de área (quando o catálogo exibe preços)
+	PriceUnit      *PriceUnit `json:"priceUnit,omitempty"`
+	Currency       *Currency  `json:"currency,omitempty"`
 	Medias         []Media  `json:"medias"`
 	ProductName    string   `json:"productName,omitempty"`
 	Material       string   `json:"material,omitempty"`
@@ -163,6 +173,8 @@ type PublicSalesLink struct {
 	Title         string              `json:"title,omitempty"`
 	CustomMessage string              `json:"customMessage,omitempty"`
 	DisplayPrice  *float64            `json:"displayPrice,omitempty"`
+	PriceUnit     *PriceUnit          `json:"priceUnit,omitempty"`
+	Currency      Currency            `json:"currency,omitempty"` // moeda dos preços exibidos
 	ShowPrice     bool                `json:"showPrice"`
 	Batch         *PublicBatch        `json:"batch,omitempty"`
 	Product       *PublicProduct      `json:"product,omitempty"`
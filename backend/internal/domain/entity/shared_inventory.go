@@ -18,16 +18,20 @@ type SharedInventoryBatch struct {
 	SharedWith          *User     `json:"sharedWith,omitempty"`          // Populated quando necessário (broker ou vendedor)
 
 	// Campos calculados (não persistidos, preenchidos na API)
-	EffectivePrice     float64 `json:"effectivePrice,omitempty"`     // Preço efetivo por m² (negociado ou do lote)
-	EffectiveSlabPrice float64 `json:"effectiveSlabPrice,omitempty"` // Preço efetivo da chapa (calculado)
+	PriceList          *ResolvedPrice `json:"priceList,omitempty"`          // Preço pela tabela atribuída ao usuário
+	EffectivePrice     float64        `json:"effectivePrice,omitempty"`     // Preço efetivo por m² (negociado, tabela ou lote)
+	EffectiveSlabPrice float64        `json:"effectiveSlabPrice,omitempty"` // Preço efetivo da chapa (calculado)
 }
 
-// GetEffectivePrice retorna o preço efetivo por m² (negociado se existir, senão do lote)
+// GetEffectivePrice retorna o preço efetivo por m² (negociado, senão da tabela de preço, senão do lote)
 func (s *SharedInventoryBatch) GetEffectivePrice() float64 {
 	if s.NegotiatedPrice != nil && *s.NegotiatedPrice > 0 {
 		// Converte para M2 se necessário
 		return ConvertPrice(*s.NegotiatedPrice, s.NegotiatedPriceUnit, PriceUnitM2)
 	}
+	if s.PriceList != nil && s.PriceList.PriceListID != nil {
+		return ConvertPrice(s.PriceList.Price, s.PriceList.PriceUnit, PriceUnitM2)
+	}
 	if s.Batch != nil {
 		return s.Batch.GetPriceInUnit(PriceUnitM2)
 	}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+)
+
+// Slab representa uma chapa individual dentro de um lote
+type Slab struct {
+	ID            string      `json:"id"`
+	BatchID       string      `json:"batchId"`
+	SlabNumber    int         `json:"slabNumber"` // número sequencial dentro do lote (1..N)
+	Height        float64     `json:"height"`     // cm
+	Width         float64     `json:"width"`      // cm
+	Thickness     float64     `json:"thickness"`  // cm
+	Area          float64     `json:"area"`       // m² (calculado)
+	Status        BatchStatus `json:"status"`
+	Defects       *string     `json:"defects,omitempty"`
+	ReservationID *string     `json:"reservationId,omitempty"`
+	SaleID        *string     `json:"saleId,omitempty"`
+	Medias        []Media     `json:"medias,omitempty"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	UpdatedAt     time.Time   `json:"updatedAt"`
+}
+
+// CalculateArea calcula a área da chapa em m²
+func (s *Slab) CalculateArea() {
+	s.Area = (s.Height * s.Width) / 10000
+}
+
+// SlabMedia representa uma mídia de chapa
+type SlabMedia struct {
+	Media
+	SlabID string `json:"slabId"`
+}
+
+// SlabCounts representa a distribuição de chapas de um lote por status
+type SlabCounts struct {
+	Available int `json:"availableSlabs"`
+	Reserved  int `json:"reservedSlabs"`
+	Sold      int `json:"soldSlabs"`
+	Inactive  int `json:"inactiveSlabs"`
+}
+
+// Total retorna a quantidade total de chapas
+func (c SlabCounts) Total() int {
+	return c.Available + c.Reserved + c.Sold + c.Inactive
+}
+
+// UpdateSlabInput representa os dados para atualizar uma chapa
+type UpdateSlabInput struct {
+	Height    *float64 `json:"height,omitempty" validate:"omitempty,gt=0,lte=1000"`
+	Width     *float64 `json:"width,omitempty" validate:"omitempty,gt=0,lte=1000"`
+	Thickness *float64 `json:"thickness,omitempty" validate:"omitempty,gt=0,lte=100"`
+	Defects   *string  `json:"defects,omitempty" validate:"omitempty,max=1000"`
+}
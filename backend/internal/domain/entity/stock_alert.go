@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"time"
+)
+
+// StockAlertType representa o tipo de alerta de estoque
+type StockAlertType string
+
+const (
+	StockAlertTypeEstoqueBaixo  StockAlertType = "ESTOQUE_BAIXO"  // chapas disponíveis no limite configurado
+	StockAlertTypeEstoqueParado StockAlertType = "ESTOQUE_PARADO" // lote sem movimento além do limite de dias
+)
+
+// IsValid verifica se o tipo de alerta é válido
+func (t StockAlertType) IsValid() bool {
+	switch t {
+	case StockAlertTypeEstoqueBaixo, StockAlertTypeEstoqueParado:
+		return true
+	}
+	return false
+}
+
+// StockAlert representa o cruzamento de um limite de estoque por um lote.
+// Fica aberto enquanto o lote permanecer na condição, evitando avisos repetidos.
+type StockAlert struct {
+	ID           string         `json:"id"`
+	IndustryID   string         `json:"industryId"`
+	BatchID      string         `json:"batchId"`
+	BatchCode    string         `json:"batchCode"`
+	ProductName  *string        `json:"productName,omitempty"`
+	Type         StockAlertType `json:"type"`
+	Threshold    int            `json:"threshold"`    // limite vigente no disparo
+	CurrentValue int            `json:"currentValue"` // chapas disponíveis ou dias sem movimento no disparo
+	TriggeredAt  time.Time      `json:"triggeredAt"`
+	NotifiedAt   *time.Time     `json:"notifiedAt,omitempty"`
+	ResolvedAt   *time.Time     `json:"resolvedAt,omitempty"`
+}
+
+// IsOpen verifica se o lote ainda está na condição do alerta
+func (a *StockAlert) IsOpen() bool {
+	return a.ResolvedAt == nil
+}
+
+// StockAlertFilters representa os filtros para busca de alertas de estoque
+type StockAlertFilters struct {
+	Type     *StockAlertType `json:"type,omitempty"`
+	BatchID  *string         `json:"batchId,omitempty"`
+	OnlyOpen bool            `json:"onlyOpen,omitempty"`
+	Page     int             `json:"page" validate:"min=1"`
+	Limit    int             `json:"limit" validate:"min=1,max=100"`
+}
+
+// StockAlertListResponse representa a resposta de listagem de alertas de estoque
+type StockAlertListResponse struct {
+	Alerts []StockAlert `json:"alerts"`
+	Total  int          `json:"total"`
+	Page   int          `json:"page"`
+}
@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"time"
+)
+
+// Warehouse representa um depósito físico da indústria (pátio, galpão, filial)
+type Warehouse struct {
+	ID         string              `json:"id"`
+	IndustryID string              `json:"industryId"`
+	Code       string              `json:"code"`
+	Name       string              `json:"name"`
+	Address    *string             `json:"address,omitempty"`
+	City       *string             `json:"city,omitempty"`
+	State      *string             `json:"state,omitempty"`
+	IsActive   bool                `json:"isActive"`
+	BatchCount int                 `json:"batchCount"` // lotes ativos alocados no depósito
+	Locations  []WarehouseLocation `json:"locations"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	UpdatedAt  time.Time           `json:"updatedAt"`
+}
+
+// WarehouseLocation representa uma posição dentro do depósito (pátio, rack, cavalete)
+type WarehouseLocation struct {
+	ID          string    `json:"id"`
+	WarehouseID string    `json:"warehouseId"`
+	Code        string    `json:"code"`
+	Description *string   `json:"description,omitempty"`
+	IsActive    bool      `json:"isActive"`
+	BatchCount  int       `json:"batchCount"` // lotes ativos alocados na posição
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// BatchLocation representa a localização física atual de um lote
+type BatchLocation struct {
+	WarehouseID   string  `json:"warehouseId"`
+	WarehouseCode string  `json:"warehouseCode"`
+	WarehouseName string  `json:"warehouseName"`
+	LocationID    *string `json:"locationId,omitempty"`
+	LocationCode  *string `json:"locationCode,omitempty"`
+}
+
+// BatchTransfer representa uma movimentação de lote entre depósitos/posições
+type BatchTransfer struct {
+	ID                string    `json:"id"`
+	BatchID           string    `json:"batchId"`
+	FromWarehouseID   *string   `json:"fromWarehouseId,omitempty"` // nil = primeira alocação
+	FromWarehouseName *string   `json:"fromWarehouseName,omitempty"`
+	FromLocationID    *string   `json:"fromLocationId,omitempty"`
+	FromLocationCode  *string   `json:"fromLocationCode,omitempty"`
+	ToWarehouseID     *string   `json:"toWarehouseId,omitempty"`
+	ToWarehouseName   *string   `json:"toWarehouseName,omitempty"`
+	ToLocationID      *string   `json:"toLocationId,omitempty"`
+	ToLocationCode    *string   `json:"toLocationCode,omitempty"`
+	Notes             *string   `json:"notes,omitempty"`
+	ActorUserID       *string   `json:"actorUserId,omitempty"`
+	ActorName         *string   `json:"actorName,omitempty"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+// CreateWarehouseInput representa os dados para cadastrar um depósito
+type CreateWarehouseInput struct {
+	Code    string  `json:"code" validate:"required,min=1,max=20"`
+	Name    string  `json:"name" validate:"required,min=2,max=255"`
+	Address *string `json:"address,omitempty" validate:"omitempty,max=500"`
+	City    *string `json:"city,omitempty" validate:"omitempty,max=100"`
+	State   *string `json:"state,omitempty" validate:"omitempty,len=2"`
+}
+
+// UpdateWarehouseInput representa os dados para atualizar um depósito
+type UpdateWarehouseInput struct {
+	Code     *string `json:"code,omitempty" validate:"omitempty,min=1,max=20"`
+	Name     *string `json:"name,omitempty" validate:"omitempty,min=2,max=255"`
+	Address  *string `json:"address,omitempty" validate:"omitempty,max=500"`
+	City     *string `json:"city,omitempty" validate:"omitempty,max=100"`
+	State    *string `json:"state,omitempty" validate:"omitempty,len=2"`
+	IsActive *bool   `json:"isActive,omitempty"`
+}
+
+// CreateWarehouseLocationInput representa os dados para cadastrar uma posição no depósito
+type CreateWarehouseLocationInput struct {
+	Code        string  `json:"code" validate:"required,min=1,max=50"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=255"`
+}
+
+// UpdateWarehouseLocationInput representa os dados para atualizar uma posição
+type UpdateWarehouseLocationInput struct {
+	Code        *string `json:"code,omitempty" validate:"omitempty,min=1,max=50"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=255"`
+	IsActive    *bool   `json:"isActive,omitempty"`
+}
+
+// TransferBatchInput representa os dados para transferir um lote de depósito/posição
+type TransferBatchInput struct {
+	WarehouseID string  `json:"warehouseId" validate:"required,uuid"`
+	LocationID  *string `json:"locationId,omitempty" validate:"omitempty,uuid"` // vazio = sem posição definida
+	Notes       *string `json:"notes,omitempty" validate:"omitempty,max=500"`
+}
+
+// PublicWarehouse representa dados seguros de um depósito para filtros na página pública
+type PublicWarehouse struct {
+	Code      string   `json:"code"`
+	Name      string   `json:"name"`
+	City      *string  `json:"city,omitempty"`
+	State     *string  `json:"state,omitempty"`
+	Locations []string `json:"locations"` // códigos das posições ativas
+}
+
+// PublicBatchFilters representa os filtros de lotes na página pública do depósito
+type PublicBatchFilters struct {
+	WarehouseCode *string `json:"warehouse,omitempty"`
+	LocationCode  *string `json:"location,omitempty"`
+	Search        *string `json:"search,omitempty"`
+}
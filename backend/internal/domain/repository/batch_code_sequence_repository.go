@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+)
+
+// BatchCodeSequenceRepository define o contrato para os contadores de códigos de lote
+type BatchCodeSequenceRepository interface {
+	// Next reserva o próximo número do prefixo (deve rodar na transação que cria o lote)
+	Next(ctx context.Context, tx *sql.Tx, industryID, prefix string) (int, error)
+
+	// Peek retorna o próximo número do prefixo sem consumi-lo
+	Peek(ctx context.Context, industryID, prefix string) (int, error)
+}
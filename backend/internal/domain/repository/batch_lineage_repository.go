@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// BatchLineageRepository define o contrato para a origem de lotes divididos/unificados
+// e para a transferência das referências (mídias, compartilhamentos e links) entre lotes
+type BatchLineageRepository interface {
+	// Create registra o vínculo entre lote de origem e lote de destino
+	Create(ctx context.Context, tx *sql.Tx, lineage *entity.BatchLineage) error
+
+	// FindByBatchID busca os vínculos em que o lote é origem ou destino
+	FindByBatchID(ctx context.Context, batchID string) (*entity.BatchLineageResponse, error)
+
+	// CopyReferences replica mídias, compartilhamentos, catálogos e itens de links de venda
+	// do lote de origem para o novo lote (divisão)
+	CopyReferences(ctx context.Context, tx *sql.Tx, fromBatchID, toBatchID string, quantity int) error
+
+	// MoveReferences transfere mídias, compartilhamentos, catálogos e links de venda
+	// do lote de origem para o lote de destino (unificação)
+	MoveReferences(ctx context.Context, tx *sql.Tx, fromBatchID, toBatchID string) error
+}
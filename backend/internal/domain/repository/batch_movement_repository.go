@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// BatchMovementRepository define o contrato para o razão de movimentações de estoque
+type BatchMovementRepository interface {
+	// Create registra uma movimentação (somente inserção)
+	Create(ctx context.Context, tx *sql.Tx, movement *entity.BatchMovement) error
+
+	// ListByBatchID lista movimentações de um lote (mais recentes primeiro)
+	ListByBatchID(ctx context.Context, batchID string, filters entity.BatchMovementFilters) ([]entity.BatchMovement, int, error)
+
+	// Balance reconstrói a distribuição de chapas do lote a partir do razão
+	Balance(ctx context.Context, batchID string) (entity.SlabCounts, error)
+}
@@ -9,8 +9,8 @@ import (
 
 // BatchRepository define o contrato para operações com lotes
 type BatchRepository interface {
-	// Create cria um novo lote
-	Create(ctx context.Context, batch *entity.Batch) error
+	// Create cria um novo lote (tx opcional)
+	Create(ctx context.Context, tx *sql.Tx, batch *entity.Batch) error
 
 	// FindByID busca lote por ID
 	FindByID(ctx context.Context, id string) (*entity.Batch, error)
@@ -33,8 +33,17 @@ type BatchRepository interface {
 	// List lista lotes com filtros e paginação
 	List(ctx context.Context, industryID string, filters entity.BatchFilters) ([]entity.Batch, int, error)
 
-	// Update atualiza os dados do lote
-	Update(ctx context.Context, batch *entity.Batch) error
+	// Stream percorre todos os lotes que atendem aos filtros (sem paginação), com dados básicos do produto
+	Stream(ctx context.Context, industryID string, filters entity.BatchFilters, fn func(*entity.Batch) error) error
+
+	// Update atualiza os dados do lote (tx opcional)
+	Update(ctx context.Context, tx *sql.Tx, batch *entity.Batch) error
+
+	// UpdateLocation atualiza o depósito e a posição do lote
+	UpdateLocation(ctx context.Context, tx *sql.Tx, id string, warehouseID, locationID *string) error
+
+	// UpdatePrice aplica um novo preço ao lote (marca como preço definido manualmente)
+	UpdatePrice(ctx context.Context, tx *sql.Tx, id string, price float64, unit entity.PriceUnit) error
 
 	// UpdateStatus atualiza apenas o status do lote
 	UpdateStatus(ctx context.Context, tx *sql.Tx, id string, status entity.BatchStatus) error
@@ -45,6 +54,9 @@ type BatchRepository interface {
 	// UpdateSlabCounts atualiza a distribuição de chapas
 	UpdateSlabCounts(ctx context.Context, tx *sql.Tx, id string, available, reserved, sold, inactive int) error
 
+	// UpdateSlabTotals atualiza a quantidade total e a distribuição de chapas (divisão/unificação)
+	UpdateSlabTotals(ctx context.Context, tx *sql.Tx, id string, quantity, available, reserved, sold, inactive int) error
+
 	// DecrementAvailableSlabs decrementa a quantidade de chapas disponíveis
 	DecrementAvailableSlabs(ctx context.Context, tx *sql.Tx, id string, quantity int) error
 
@@ -54,11 +66,11 @@ type BatchRepository interface {
 	// CountByStatus conta lotes por status
 	CountByStatus(ctx context.Context, industryID string, status entity.BatchStatus) (int, error)
 
-	// ExistsByCode verifica se o código de lote já existe na indústria
-	ExistsByCode(ctx context.Context, industryID, code string) (bool, error)
+	// ExistsByCode verifica se o código de lote já existe na indústria (tx opcional)
+	ExistsByCode(ctx context.Context, tx *sql.Tx, industryID, code string) (bool, error)
 
-	// Archive arquiva um lote (soft delete)
-	Archive(ctx context.Context, id string) error
+	// Archive arquiva um lote (soft delete, tx opcional)
+	Archive(ctx context.Context, tx *sql.Tx, id string) error
 
 	// Restore restaura um lote arquivado
 	Restore(ctx context.Context, id string) error
@@ -66,8 +78,8 @@ type BatchRepository interface {
 	// Delete remove permanentemente um lote
 	Delete(ctx context.Context, id string) error
 
-	// FindPublicBatchesByIndustrySlug busca lotes públicos de um depósito por slug
-	FindPublicBatchesByIndustrySlug(ctx context.Context, slug string) ([]entity.PublicBatch, error)
+	// FindPublicBatchesByIndustrySlug busca lotes públicos de um depósito por slug (filtros de localização opcionais)
+	FindPublicBatchesByIndustrySlug(ctx context.Context, slug string, filters entity.PublicBatchFilters) ([]entity.PublicBatch, error)
 
 	// FindPublicBatchesByProductID busca lotes públicos de um produto específico
 	FindPublicBatchesByProductID(ctx context.Context, productID string, limit int) ([]entity.PublicBatch, error)
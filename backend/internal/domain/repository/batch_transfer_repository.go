@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// BatchTransferRepository define o contrato para o histórico de transferências de lotes
+type BatchTransferRepository interface {
+	// Create registra uma transferência de lote (tx opcional)
+	Create(ctx context.Context, tx *sql.Tx, transfer *entity.BatchTransfer) error
+
+	// FindByBatchID busca o histórico de transferências do lote (mais recentes primeiro)
+	FindByBatchID(ctx context.Context, batchID string) ([]entity.BatchTransfer, error)
+}
@@ -30,6 +30,9 @@ type BIRepository interface {
 	// CountPendingApprovals conta reservas pendentes de aprovação
 	CountPendingApprovals(ctx context.Context, industryID string) (int, error)
 
+	// GetBaseCurrency retorna a moeda base da indústria usada nas métricas
+	GetBaseCurrency(ctx context.Context, industryID string) (entity.Currency, error)
+
 	// RefreshMaterializedViews atualiza as views materializadas
 	RefreshMaterializedViews(ctx context.Context) error
 
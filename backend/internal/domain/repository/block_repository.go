@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// BlockRepository define o contrato para operações com blocos de pedreira
+type BlockRepository interface {
+	// Create cria um novo bloco
+	Create(ctx context.Context, block *entity.Block) error
+
+	// FindByID busca bloco por ID com o rendimento calculado
+	FindByID(ctx context.Context, id string) (*entity.Block, error)
+
+	// List lista blocos da indústria com filtros, paginação e rendimento
+	List(ctx context.Context, industryID string, filters entity.BlockFilters) ([]entity.Block, int, error)
+
+	// Update atualiza um bloco
+	Update(ctx context.Context, block *entity.Block) error
+
+	// Delete remove um bloco (lotes vinculados ficam sem bloco)
+	Delete(ctx context.Context, id string) error
+
+	// ExistsByCode verifica se o código já existe na indústria (excludeID ignora o próprio bloco)
+	ExistsByCode(ctx context.Context, industryID, code, excludeID string) (bool, error)
+
+	// CountBatches conta os lotes vinculados ao bloco
+	CountBatches(ctx context.Context, id string) (int, error)
+}
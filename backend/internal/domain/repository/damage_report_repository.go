@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// DamageReportRepository define o contrato para operações com relatórios de avaria
+type DamageReportRepository interface {
+	// Create registra um novo relatório de avaria
+	Create(ctx context.Context, report *entity.DamageReport) error
+
+	// FindByID busca relatório por ID com suas fotos
+	FindByID(ctx context.Context, id string) (*entity.DamageReport, error)
+
+	// List lista relatórios da indústria com filtros
+	List(ctx context.Context, industryID string, filters entity.DamageReportFilters) ([]entity.DamageReport, int, error)
+
+	// AddPhotos adiciona fotos ao relatório após as já existentes
+	AddPhotos(ctx context.Context, reportID string, urls []string) error
+
+	// Review altera o status de um relatório pendente (conflito se já revisado)
+	Review(ctx context.Context, tx *sql.Tx, id string, status entity.DamageReportStatus, userID string, notes *string) error
+}
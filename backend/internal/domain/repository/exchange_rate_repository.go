@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// ExchangeRateRepository define o contrato para operações com cotações de moeda
+type ExchangeRateRepository interface {
+	// Upsert cria ou atualiza a cotação do par na data de vigência
+	Upsert(ctx context.Context, tx *sql.Tx, rate *entity.ExchangeRate) error
+
+	// FindByID busca cotação por ID
+	FindByID(ctx context.Context, id string) (*entity.ExchangeRate, error)
+
+	// List lista cotações da indústria, mais recentes primeiro
+	List(ctx context.Context, industryID string, filters entity.ExchangeRateFilters) ([]entity.ExchangeRate, error)
+
+	// Delete remove uma cotação
+	Delete(ctx context.Context, id string) error
+
+	// GetRate retorna a taxa vigente na data, usando o par inverso se necessário (nil se não houver cotação)
+	GetRate(ctx context.Context, industryID string, from, to entity.Currency, date time.Time) (*float64, error)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// InventoryCountRepository define o contrato para sessões de inventário físico
+type InventoryCountRepository interface {
+	// Create cria a sessão de inventário (tx opcional)
+	Create(ctx context.Context, tx *sql.Tx, count *entity.InventoryCount) error
+
+	// FreezeScope congela os lotes ativos do escopo como itens da sessão e retorna a quantidade
+	FreezeScope(ctx context.Context, tx *sql.Tx, count *entity.InventoryCount) (int, error)
+
+	// FindByID busca sessão por ID com o resumo da contagem
+	FindByID(ctx context.Context, id string) (*entity.InventoryCount, error)
+
+	// List lista sessões da indústria com filtros e paginação
+	List(ctx context.Context, industryID string, filters entity.InventoryCountFilters) ([]entity.InventoryCount, int, error)
+
+	// FindItems busca os itens da sessão com os contadores atuais dos lotes
+	FindItems(ctx context.Context, countID string) ([]entity.InventoryCountItem, error)
+
+	// FindItemsForUpdate busca os itens da sessão com lock (dentro de transação)
+	FindItemsForUpdate(ctx context.Context, tx *sql.Tx, countID string) ([]entity.InventoryCountItem, error)
+
+	// RecordItem grava a quantidade contada de um lote da sessão junto com o disponível no sistema
+	RecordItem(ctx context.Context, countID string, entry entity.InventoryCountEntry, userID string) error
+
+	// MarkItemAdjusted registra a divergência aplicada no item (conflito se já ajustado)
+	MarkItemAdjusted(ctx context.Context, tx *sql.Tx, itemID string, quantity int) error
+
+	// Close encerra uma sessão aberta (APROVADA ou CANCELADA; conflito se já encerrada)
+	Close(ctx context.Context, tx *sql.Tx, id string, status entity.InventoryCountStatus, userID string, reason *entity.MovementReason) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// JobRunRepository define o contrato para o histórico de execuções dos jobs
+type JobRunRepository interface {
+	// Create registra o início de uma execução
+	Create(ctx context.Context, run *entity.JobRun) error
+
+	// Finish registra o resultado de uma execução
+	Finish(ctx context.Context, run *entity.JobRun) error
+
+	// FindLast busca a execução mais recente do job
+	FindLast(ctx context.Context, jobName string) (*entity.JobRun, error)
+
+	// ListByJob lista as execuções do job (mais recentes primeiro)
+	ListByJob(ctx context.Context, jobName string, limit int) ([]entity.JobRun, error)
+
+	// FailInterrupted marca como falha as execuções do job que ficaram em andamento
+	// (réplica encerrada no meio da execução)
+	FailInterrupted(ctx context.Context, jobName string) (int, error)
+}
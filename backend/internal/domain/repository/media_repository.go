@@ -35,6 +35,18 @@ type MediaRepository interface {
 	// UpdateDisplayOrder atualiza ordem de exibição de uma mídia
 	UpdateDisplayOrder(ctx context.Context, id string, order int) error
 
+	// FindSlabMediaByID busca mídia de chapa por ID
+	FindSlabMediaByID(ctx context.Context, id string) (*entity.SlabMedia, error)
+
+	// CreateSlabMedia cria uma nova mídia de chapa
+	CreateSlabMedia(ctx context.Context, slabID string, media *entity.CreateMediaInput) error
+
+	// FindSlabMedias busca mídias de uma chapa
+	FindSlabMedias(ctx context.Context, slabID string) ([]entity.Media, error)
+
+	// DeleteSlabMedia deleta mídia de chapa
+	DeleteSlabMedia(ctx context.Context, id string) error
+
 	// SetCover define uma mídia como capa (e remove flag de outras)
 	SetCover(ctx context.Context, productID, mediaID string) error
 }
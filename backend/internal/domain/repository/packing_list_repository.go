@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// PackingListRepository define o contrato para romaneios de vendas
+type PackingListRepository interface {
+	// Upsert cria ou substitui o romaneio da venda (um por venda)
+	Upsert(ctx context.Context, packingList *entity.PackingList) error
+
+	// FindBySaleID busca o romaneio de uma venda
+	FindBySaleID(ctx context.Context, saleID string) (*entity.PackingList, error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// PriceHistoryRepository define o contrato para o histórico de preços de produtos e lotes
+type PriceHistoryRepository interface {
+	// Create registra uma alteração de preço (aplicada ou agendada)
+	Create(ctx context.Context, tx *sql.Tx, change *entity.PriceChange) error
+
+	// FindByID busca alteração por ID
+	FindByID(ctx context.Context, id string) (*entity.PriceChange, error)
+
+	// FindByProductID lista o histórico de preço base do produto (mais recentes primeiro)
+	FindByProductID(ctx context.Context, productID string) ([]entity.PriceChange, error)
+
+	// FindByBatchID lista o histórico de preço do lote (mais recentes primeiro)
+	FindByBatchID(ctx context.Context, batchID string) ([]entity.PriceChange, error)
+
+	// Cancel cancela uma alteração agendada (conflito se já aplicada/cancelada)
+	Cancel(ctx context.Context, tx *sql.Tx, id string) error
+
+	// FindDueForUpdate bloqueia alterações agendadas com vigência até o instante informado
+	FindDueForUpdate(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]entity.PriceChange, error)
+
+	// MarkApplied marca a alteração como aplicada registrando o preço substituído
+	MarkApplied(ctx context.Context, tx *sql.Tx, id string, previousPrice *float64, previousUnit *entity.PriceUnit) error
+}
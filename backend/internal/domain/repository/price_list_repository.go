@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// PriceListRepository define o contrato para operações com tabelas de preço
+type PriceListRepository interface {
+	// Create cria uma nova tabela de preço
+	Create(ctx context.Context, tx *sql.Tx, list *entity.PriceList) error
+
+	// FindByID busca tabela por ID com regras
+	FindByID(ctx context.Context, id string) (*entity.PriceList, error)
+
+	// List lista tabelas da indústria com regras e contagem de atribuições
+	List(ctx context.Context, industryID string) ([]entity.PriceList, error)
+
+	// Update atualiza nome, descrição e status da tabela
+	Update(ctx context.Context, tx *sql.Tx, list *entity.PriceList) error
+
+	// Delete remove a tabela, suas regras e atribuições
+	Delete(ctx context.Context, id string) error
+
+	// ExistsByName verifica se o nome já existe na indústria (excludeID ignora a própria tabela)
+	ExistsByName(ctx context.Context, industryID, name, excludeID string) (bool, error)
+
+	// ReplaceRules substitui todas as regras da tabela
+	ReplaceRules(ctx context.Context, tx *sql.Tx, listID string, rules []entity.PriceListRule) error
+
+	// Assign atribui a tabela a um usuário ou cliente, substituindo atribuição anterior na indústria
+	Assign(ctx context.Context, assignment *entity.PriceListAssignment) error
+
+	// FindAssignmentByID busca atribuição por ID
+	FindAssignmentByID(ctx context.Context, id string) (*entity.PriceListAssignment, error)
+
+	// ListAssignments lista as atribuições de uma tabela
+	ListAssignments(ctx context.Context, listID string) ([]entity.PriceListAssignment, error)
+
+	// DeleteAssignment remove uma atribuição
+	DeleteAssignment(ctx context.Context, id string) error
+
+	// FindActiveForUser busca a tabela ativa atribuída ao usuário na indústria (nil se não houver)
+	FindActiveForUser(ctx context.Context, industryID, userID string) (*entity.PriceList, error)
+
+	// FindActiveForCliente busca a tabela ativa atribuída ao cliente na indústria (nil se não houver)
+	FindActiveForCliente(ctx context.Context, industryID, clienteID string) (*entity.PriceList, error)
+}
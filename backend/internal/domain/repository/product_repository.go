@@ -2,14 +2,15 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
 )
 
 // ProductRepository define o contrato para operações com produtos
 type ProductRepository interface {
-	// Create cria um novo produto
-	Create(ctx context.Context, product *entity.Product) error
+	// Create cria um novo produto (tx opcional)
+	Create(ctx context.Context, tx *sql.Tx, product *entity.Product) error
 
 	// FindByID busca produto por ID
 	FindByID(ctx context.Context, id string) (*entity.Product, error)
@@ -17,8 +18,11 @@ type ProductRepository interface {
 	// FindByIndustryID busca produtos por indústria com filtros
 	FindByIndustryID(ctx context.Context, industryID string, filters entity.ProductFilters) ([]entity.Product, int, error)
 
-	// Update atualiza os dados do produto
-	Update(ctx context.Context, product *entity.Product) error
+	// Update atualiza os dados do produto (tx opcional)
+	Update(ctx context.Context, tx *sql.Tx, product *entity.Product) error
+
+	// UpdatePrice aplica um novo preço base ao produto
+	UpdatePrice(ctx context.Context, tx *sql.Tx, id string, price float64, unit entity.PriceUnit) error
 
 	// SoftDelete desativa o produto (soft delete)
 	SoftDelete(ctx context.Context, id string) error
@@ -31,4 +35,7 @@ type ProductRepository interface {
 
 	// ExistsBySKU verifica se o SKU já está em uso na indústria
 	ExistsBySKU(ctx context.Context, industryID, sku string) (bool, error)
+
+	// FindBySKU busca produto ativo da indústria pelo SKU
+	FindBySKU(ctx context.Context, industryID, sku string) (*entity.Product, error)
 }
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// RemnantRepository define o contrato para operações com retalhos
+type RemnantRepository interface {
+	// Create cadastra um retalho com o próximo sequencial do lote de origem
+	Create(ctx context.Context, tx *sql.Tx, remnant *entity.Remnant) error
+
+	// FindByID busca retalho por ID com suas fotos
+	FindByID(ctx context.Context, id string) (*entity.Remnant, error)
+
+	// FindByIDForUpdate busca retalho com lock (SELECT FOR UPDATE)
+	FindByIDForUpdate(ctx context.Context, tx *sql.Tx, id string) (*entity.Remnant, error)
+
+	// List lista retalhos da indústria com filtros
+	List(ctx context.Context, industryID string, filters entity.RemnantFilters) ([]entity.Remnant, int, error)
+
+	// Update atualiza dimensões, preço, status e observações
+	Update(ctx context.Context, remnant *entity.Remnant) error
+
+	// UpdateStatus altera o status do retalho (reserva, venda e liberação)
+	UpdateStatus(ctx context.Context, tx *sql.Tx, id string, status entity.BatchStatus) error
+
+	// Delete remove um retalho
+	Delete(ctx context.Context, id string) error
+
+	// HasSales verifica se o retalho possui vendas ou reservas registradas
+	HasSales(ctx context.Context, id string) (bool, error)
+
+	// AddPhotos adiciona fotos ao retalho após as já existentes
+	AddPhotos(ctx context.Context, remnantID string, urls []string) error
+
+	// RemovePhoto remove uma foto do retalho
+	RemovePhoto(ctx context.Context, remnantID, photoID string) error
+}
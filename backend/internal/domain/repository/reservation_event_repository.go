@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// ReservationEventRepository define o contrato para o histórico de eventos das reservas
+type ReservationEventRepository interface {
+	// Create registra um evento (na transação da mudança da reserva)
+	Create(ctx context.Context, tx *sql.Tx, event *entity.ReservationEvent) error
+
+	// FindByReservation lista os eventos de uma reserva em ordem cronológica, com o nome de quem agiu
+	FindByReservation(ctx context.Context, reservationID string) ([]entity.ReservationEvent, error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// ReservationExtensionRepository define o contrato para os pedidos de prorrogação de reservas
+type ReservationExtensionRepository interface {
+	// Create registra um pedido de prorrogação pendente
+	Create(ctx context.Context, tx *sql.Tx, extension *entity.ReservationExtension) error
+
+	// FindByID busca pedido por ID
+	FindByID(ctx context.Context, id string) (*entity.ReservationExtension, error)
+
+	// FindByReservation lista os pedidos de uma reserva (mais antigos primeiro)
+	FindByReservation(ctx context.Context, reservationID string) ([]entity.ReservationExtension, error)
+
+	// List lista pedidos com filtros (mais recentes primeiro)
+	List(ctx context.Context, filters entity.ReservationExtensionFilters) ([]entity.ReservationExtension, error)
+
+	// CountApproved conta as prorrogações aprovadas de uma reserva
+	CountApproved(ctx context.Context, tx *sql.Tx, reservationID string) (int, error)
+
+	// Approve aprova um pedido pendente registrando os prazos antigo e novo
+	Approve(ctx context.Context, tx *sql.Tx, id, approverID string, oldExpiresAt, newExpiresAt time.Time) error
+
+	// Deny nega um pedido pendente
+	Deny(ctx context.Context, tx *sql.Tx, id, approverID, reason string) error
+}
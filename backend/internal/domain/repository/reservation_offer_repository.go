@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// ReservationOfferRepository define o contrato para as propostas de preço das reservas
+type ReservationOfferRepository interface {
+	// Create registra uma proposta pendente
+	Create(ctx context.Context, tx *sql.Tx, offer *entity.ReservationOffer) error
+
+	// FindByReservation lista as propostas de uma reserva (mais antigas primeiro)
+	FindByReservation(ctx context.Context, reservationID string) ([]entity.ReservationOffer, error)
+
+	// FindPendingForUpdate busca a proposta aguardando resposta da reserva, com lock (nil se não houver)
+	FindPendingForUpdate(ctx context.Context, tx *sql.Tx, reservationID string) (*entity.ReservationOffer, error)
+
+	// Accept marca a proposta pendente como aceita
+	Accept(ctx context.Context, tx *sql.Tx, id, userID string) error
+
+	// ClosePending encerra a proposta pendente da reserva com o status informado (substituída ou encerrada)
+	ClosePending(ctx context.Context, tx *sql.Tx, reservationID string, status entity.ReservationOfferStatus) error
+}
@@ -16,6 +16,9 @@ type ReservationRepository interface {
 	// FindByID busca reserva por ID
 	FindByID(ctx context.Context, id string) (*entity.Reservation, error)
 
+	// FindByIDForUpdate busca reserva por ID com lock pessimista (SELECT FOR UPDATE)
+	FindByIDForUpdate(ctx context.Context, tx *sql.Tx, id string) (*entity.Reservation, error)
+
 	// FindByBatchID busca reservas de um lote
 	FindByBatchID(ctx context.Context, batchID string) ([]entity.Reservation, error)
 
@@ -31,10 +34,10 @@ type ReservationRepository interface {
 	// Update atualiza os dados da reserva
 	Update(ctx context.Context, reservation *entity.Reservation) error
 
-	// UpdateStatus atualiza o status da reserva
-	UpdateStatus(ctx context.Context, tx *sql.Tx, id string, status entity.ReservationStatus) error
+	// UpdateStatus atualiza o status da reserva se ela ainda estiver no status de origem (conflito caso contrário)
+	UpdateStatus(ctx context.Context, tx *sql.Tx, id string, from, to entity.ReservationStatus) error
 
-	// Cancel cancela uma reserva
+	// Cancel cancela uma reserva ativa (conflito se já mudou de status)
 	Cancel(ctx context.Context, tx *sql.Tx, id string) error
 
 	// List lista reservas com filtros
@@ -49,9 +52,30 @@ type ReservationRepository interface {
 	// FindPendingExpired busca reservas pendentes que expiraram o prazo de aprovação
 	FindPendingExpired(ctx context.Context) ([]entity.Reservation, error)
 
-	// Approve aprova uma reserva (atualiza status e campos de aprovação)
-	Approve(ctx context.Context, tx *sql.Tx, id, approverID string, expiresAt time.Time) error
+	// Approve aprova uma reserva pendente (atualiza status, campos de aprovação e o preço por m² acordado)
+	Approve(ctx context.Context, tx *sql.Tx, id, approverID string, expiresAt time.Time, agreedPrice *float64) error
 
-	// Reject rejeita uma reserva (atualiza status e motivo)
+	// Reject rejeita uma reserva pendente (atualiza status e motivo; conflito se já mudou de status)
 	Reject(ctx context.Context, tx *sql.Tx, id, approverID, reason string) error
+
+	// CountOpenByUser conta reservas em aberto (ativas, aprovadas ou pendentes) e chapas reservadas
+	// do usuário em lotes da indústria
+	CountOpenByUser(ctx context.Context, tx *sql.Tx, userID, industryID string) (reservations int, slabs int, err error)
+
+	// FindDueExpiryReminders busca reservas ativas que vencem dentro da antecedência de lembrete
+	// da política da indústria e ainda não foram lembradas
+	FindDueExpiryReminders(ctx context.Context) ([]entity.Reservation, error)
+
+	// FindDueApprovalReminders busca reservas pendentes cujo prazo de aprovação vence dentro
+	// da antecedência de lembrete e ainda não foram lembradas
+	FindDueApprovalReminders(ctx context.Context) ([]entity.Reservation, error)
+
+	// MarkExpiryReminderSent registra o envio do lembrete de vencimento
+	MarkExpiryReminderSent(ctx context.Context, id string) error
+
+	// MarkApprovalReminderSent registra o envio do lembrete do prazo de aprovação
+	MarkApprovalReminderSent(ctx context.Context, id string) error
+
+	// UpdateExpiresAt prorroga o prazo de uma reserva ativa ou aprovada
+	UpdateExpiresAt(ctx context.Context, tx *sql.Tx, id string, expiresAt time.Time) error
 }
\ No newline at end of file
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// ReservationWaitlistRepository define o contrato para a fila de espera de reservas
+type ReservationWaitlistRepository interface {
+	// Create adiciona um pedido ao fim da fila do lote
+	Create(ctx context.Context, entry *entity.WaitlistEntry) error
+
+	// FindByID busca pedido por ID (com a posição na fila, se aguardando)
+	FindByID(ctx context.Context, id string) (*entity.WaitlistEntry, error)
+
+	// WaitingDemand soma as chapas pedidas pelos pedidos aguardando de um lote (tx opcional)
+	WaitingDemand(ctx context.Context, tx *sql.Tx, batchID string) (int, error)
+
+	// FindWaitingForUpdate busca os pedidos aguardando de um lote por ordem de chegada, com lock
+	FindWaitingForUpdate(ctx context.Context, tx *sql.Tx, batchID string) ([]entity.WaitlistEntry, error)
+
+	// List lista pedidos com filtros (mais antigos primeiro)
+	List(ctx context.Context, filters entity.WaitlistFilters) ([]entity.WaitlistEntry, error)
+
+	// MarkConverted marca o pedido como atendido pela reserva informada
+	MarkConverted(ctx context.Context, tx *sql.Tx, id, reservationID string) error
+
+	// Cancel retira um pedido aguardando da fila (tx opcional)
+	Cancel(ctx context.Context, tx *sql.Tx, id string) error
+}
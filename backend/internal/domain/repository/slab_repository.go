@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// SlabRepository define o contrato para operações com chapas individuais de lotes
+type SlabRepository interface {
+	// CreateMany cria várias chapas de uma vez
+	CreateMany(ctx context.Context, tx *sql.Tx, slabs []entity.Slab) error
+
+	// FindByID busca chapa por ID
+	FindByID(ctx context.Context, id string) (*entity.Slab, error)
+
+	// FindByBatchID busca todas as chapas de um lote ordenadas por número
+	FindByBatchID(ctx context.Context, batchID string) ([]entity.Slab, error)
+
+	// FindByBatchIDForUpdate busca todas as chapas de um lote ordenadas por número, com lock pessimista
+	FindByBatchIDForUpdate(ctx context.Context, tx *sql.Tx, batchID string) ([]entity.Slab, error)
+
+	// FindByReservationID busca chapas mantidas por uma reserva
+	FindByReservationID(ctx context.Context, reservationID string) ([]entity.Slab, error)
+
+	// FindBySaleID busca chapas vendidas em uma venda
+	FindBySaleID(ctx context.Context, saleID string) ([]entity.Slab, error)
+
+	// FindByNumbersForUpdate busca chapas específicas de um lote com lock pessimista
+	FindByNumbersForUpdate(ctx context.Context, tx *sql.Tx, batchID string, numbers []int) ([]entity.Slab, error)
+
+	// FindByStatusForUpdate busca chapas de um lote em um status com lock pessimista,
+	// priorizando as vinculadas à reserva/venda informada e, depois, as de menor número
+	FindByStatusForUpdate(ctx context.Context, tx *sql.Tx, batchID string, status entity.BatchStatus, reservationID, saleID *string, limit int) ([]entity.Slab, error)
+
+	// UpdateStatus atualiza status e vínculos de um conjunto de chapas
+	UpdateStatus(ctx context.Context, tx *sql.Tx, ids []string, status entity.BatchStatus, reservationID, saleID *string) error
+
+	// Update atualiza dimensões e defeitos de uma chapa
+	Update(ctx context.Context, slab *entity.Slab) error
+
+	// Reassign transfere chapas para outro lote, renumerando-as a partir de startNumber
+	// na ordem informada, e retorna os novos números
+	Reassign(ctx context.Context, tx *sql.Tx, ids []string, batchID string, startNumber int) ([]int, error)
+
+	// DeleteByIDs remove chapas
+	DeleteByIDs(ctx context.Context, tx *sql.Tx, ids []string) error
+
+	// CountByBatchID conta as chapas rastreadas de um lote
+	CountByBatchID(ctx context.Context, tx *sql.Tx, batchID string) (int, error)
+
+	// CountByStatus retorna a distribuição das chapas de um lote por status
+	CountByStatus(ctx context.Context, tx *sql.Tx, batchID string) (entity.SlabCounts, error)
+
+	// MaxSlabNumber retorna o maior número de chapa do lote (0 se não houver)
+	MaxSlabNumber(ctx context.Context, tx *sql.Tx, batchID string) (int, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// StockAlertRepository define o contrato para alertas de estoque baixo/parado
+type StockAlertRepository interface {
+	// Sync abre alertas para lotes que cruzaram um limite e resolve os que saíram da condição.
+	// Retorna a quantidade de alertas abertos e resolvidos nesta execução.
+	Sync(ctx context.Context) (opened int, resolved int, err error)
+
+	// FindPendingNotification busca alertas abertos ainda não notificados
+	FindPendingNotification(ctx context.Context) ([]entity.StockAlert, error)
+
+	// MarkNotified registra o envio da notificação dos alertas
+	MarkNotified(ctx context.Context, ids []string) error
+
+	// List lista alertas da indústria com filtros
+	List(ctx context.Context, industryID string, filters entity.StockAlertFilters) ([]entity.StockAlert, int, error)
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// WarehouseRepository define o contrato para operações com depósitos físicos e suas posições
+type WarehouseRepository interface {
+	// Create cria um novo depósito
+	Create(ctx context.Context, warehouse *entity.Warehouse) error
+
+	// FindByID busca depósito por ID com posições e contagem de lotes
+	FindByID(ctx context.Context, id string) (*entity.Warehouse, error)
+
+	// List lista depósitos da indústria com posições e contagem de lotes
+	List(ctx context.Context, industryID string, includeInactive bool) ([]entity.Warehouse, error)
+
+	// Update atualiza um depósito
+	Update(ctx context.Context, warehouse *entity.Warehouse) error
+
+	// Delete remove um depósito e suas posições
+	Delete(ctx context.Context, id string) error
+
+	// ExistsByCode verifica se o código já existe na indústria (excludeID ignora o próprio depósito)
+	ExistsByCode(ctx context.Context, industryID, code, excludeID string) (bool, error)
+
+	// CountBatches conta os lotes não excluídos alocados no depósito
+	CountBatches(ctx context.Context, id string) (int, error)
+
+	// CreateLocation cria uma posição no depósito
+	CreateLocation(ctx context.Context, location *entity.WarehouseLocation) error
+
+	// FindLocationByID busca posição por ID
+	FindLocationByID(ctx context.Context, id string) (*entity.WarehouseLocation, error)
+
+	// UpdateLocation atualiza uma posição
+	UpdateLocation(ctx context.Context, location *entity.WarehouseLocation) error
+
+	// DeleteLocation remove uma posição
+	DeleteLocation(ctx context.Context, id string) error
+
+	// ExistsLocationCode verifica se o código da posição já existe no depósito (excludeID ignora a própria posição)
+	ExistsLocationCode(ctx context.Context, warehouseID, code, excludeID string) (bool, error)
+
+	// CountLocationBatches conta os lotes não excluídos alocados na posição
+	CountLocationBatches(ctx context.Context, id string) (int, error)
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// BatchLabelService define o contrato para impressão de etiquetas de lotes
+type BatchLabelService interface {
+	// Layouts lista os formatos de folha de etiquetas disponíveis
+	Layouts() []entity.LabelLayout
+
+	// Generate gera o PDF das etiquetas (código, produto, dimensões, chapas e QR code do lote)
+	Generate(ctx context.Context, industryID string, input entity.GenerateBatchLabelsInput) ([]byte, error)
+}
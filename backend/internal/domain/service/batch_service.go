@@ -27,7 +27,7 @@ type BatchService interface {
 	UpdateStatus(ctx context.Context, id string, status entity.BatchStatus) (*entity.Batch, error)
 
 	// UpdateAvailability ajusta disponibilidade/estado por quantidade
-	UpdateAvailability(ctx context.Context, id string, status entity.BatchStatus, fromStatus *entity.BatchStatus, quantity int) (*entity.Batch, error)
+	UpdateAvailability(ctx context.Context, id, userID string, input entity.UpdateBatchAvailabilityInput) (*entity.Batch, error)
 
 	// CheckAvailability verifica se lote está disponível (tem chapas disponíveis)
 	CheckAvailability(ctx context.Context, id string) (bool, error)
@@ -55,4 +55,47 @@ type BatchService interface {
 
 	// Sell registra uma venda manual de itens do lote
 	Sell(ctx context.Context, userID string, input entity.CreateSaleInput) (*entity.Batch, error)
+
+	// ListSlabs lista as chapas individuais do lote com suas mídias
+	ListSlabs(ctx context.Context, batchID string) ([]entity.Slab, error)
+
+	// InitializeSlabs gera as chapas individuais de um lote legado a partir dos contadores atuais
+	InitializeSlabs(ctx context.Context, batchID string) ([]entity.Slab, error)
+
+	// GetSlab busca uma chapa por ID
+	GetSlab(ctx context.Context, slabID string) (*entity.Slab, error)
+
+	// UpdateSlab atualiza dimensões e defeitos de uma chapa
+	UpdateSlab(ctx context.Context, batchID, slabID string, input entity.UpdateSlabInput) (*entity.Slab, error)
+
+	// AddSlabMedias adiciona mídias a uma chapa
+	AddSlabMedias(ctx context.Context, slabID string, medias []entity.CreateMediaInput) error
+
+	// ListMovements lista o razão de movimentações de estoque do lote
+	ListMovements(ctx context.Context, batchID string, filters entity.BatchMovementFilters) (*entity.BatchMovementListResponse, error)
+
+	// PreviewNextCode retorna o próximo código de lote automático sem consumi-lo
+	PreviewNextCode(ctx context.Context, industryID string, input entity.NextBatchCodeInput) (*entity.NextBatchCodeResponse, error)
+
+	// Export percorre todos os lotes filtrados (sem paginação) para exportação em planilha
+	Export(ctx context.Context, industryID string, filters entity.BatchFilters, fn func(*entity.Batch) error) error
+
+	// Import valida e cria lotes em massa a partir de linhas de planilha (dryRun apenas valida)
+	Import(ctx context.Context, industryID string, rows []entity.BatchImportRow, dryRun bool) (*entity.BatchImportResult, error)
+
+	// Split move chapas disponíveis do lote para um novo lote (filho), mantendo mídias e referências
+	Split(ctx context.Context, industryID, id, userID string, input entity.SplitBatchInput) (*entity.BatchSplitResponse, error)
+
+	// Merge incorpora as chapas disponíveis/inativas de lotes compatíveis ao lote de destino
+	// e arquiva os lotes de origem
+	Merge(ctx context.Context, industryID, userID string, input entity.MergeBatchesInput) (*entity.Batch, error)
+
+	// GetLineage retorna os lotes de origem e os lotes derivados do lote
+	GetLineage(ctx context.Context, id string) (*entity.BatchLineageResponse, error)
+
+	// Transfer move o lote para outro depósito/posição e registra no histórico
+	Transfer(ctx context.Context, id, userID string, input entity.TransferBatchInput) (*entity.Batch, error)
+
+	// ListTransfers retorna o histórico de transferências do lote
+	ListTransfers(ctx context.Context, id string) ([]entity.BatchTransfer, error)
 }
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// BlockService define o contrato para operações com blocos de pedreira
+type BlockService interface {
+	// Create cadastra um bloco (volume calculado pelas dimensões quando não informado)
+	Create(ctx context.Context, industryID string, input entity.CreateBlockInput) (*entity.Block, error)
+
+	// GetByID busca bloco com rendimento e lotes serrados
+	GetByID(ctx context.Context, industryID, id string) (*entity.Block, error)
+
+	// List lista blocos da indústria com rendimento
+	List(ctx context.Context, industryID string, filters entity.BlockFilters) (*entity.BlockListResponse, error)
+
+	// Update atualiza bloco
+	Update(ctx context.Context, industryID, id string, input entity.UpdateBlockInput) (*entity.Block, error)
+
+	// Delete remove bloco sem lotes vinculados
+	Delete(ctx context.Context, industryID, id string) error
+
+	// TraceSlab rastreia uma chapa até o bloco de origem (percorrendo divisões/unificações)
+	TraceSlab(ctx context.Context, industryID, slabID string) (*entity.SlabTrace, error)
+}
@@ -17,8 +17,8 @@ type CatalogLinkService interface {
 	// GetBySlug busca um link por slug
 	GetBySlug(ctx context.Context, slug string) (*entity.CatalogLink, error)
 
-	// GetPublicBySlug busca dados públicos de um link por slug
-	GetPublicBySlug(ctx context.Context, slug string) (*entity.PublicCatalogLink, error)
+	// GetPublicBySlug busca dados públicos de um link por slug (currency converte os preços exibidos)
+	GetPublicBySlug(ctx context.Context, slug string, currency *entity.Currency) (*entity.PublicCatalogLink, error)
 
 	// List lista links de catálogo de uma indústria ou de um usuário específico
 	// Se userID for fornecido, filtra por created_by_user_id (para brokers)
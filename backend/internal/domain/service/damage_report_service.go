@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// DamageReportService define o contrato para o fluxo de relatórios de avaria
+type DamageReportService interface {
+	// Create registra uma avaria pendente de aprovação
+	Create(ctx context.Context, industryID, userID string, input entity.CreateDamageReportInput) (*entity.DamageReport, error)
+
+	// GetByID busca relatório com fotos
+	GetByID(ctx context.Context, industryID, id string) (*entity.DamageReport, error)
+
+	// List lista relatórios da indústria
+	List(ctx context.Context, industryID string, filters entity.DamageReportFilters) (*entity.DamageReportListResponse, error)
+
+	// AddPhotos anexa fotos já enviadas ao storage a um relatório pendente
+	AddPhotos(ctx context.Context, industryID, id string, urls []string) (*entity.DamageReport, error)
+
+	// Approve aprova a avaria e move as chapas de DISPONIVEL para INATIVO com motivo AVARIA
+	Approve(ctx context.Context, industryID, id, userID string, input entity.ApproveDamageReportInput) (*entity.DamageReport, error)
+
+	// Reject rejeita a avaria sem alterar o estoque
+	Reject(ctx context.Context, industryID, id, userID string, input entity.RejectDamageReportInput) (*entity.DamageReport, error)
+}
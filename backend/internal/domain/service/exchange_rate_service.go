@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// ExchangeRateService define o contrato para cotações e conversão de moedas
+type ExchangeRateService interface {
+	// List lista cotações da indústria
+	List(ctx context.Context, industryID string, filters entity.ExchangeRateFilters) ([]entity.ExchangeRate, error)
+
+	// Upsert cadastra ou atualiza a cotação do par na data de vigência
+	Upsert(ctx context.Context, industryID, userID string, input entity.UpsertExchangeRateInput) (*entity.ExchangeRate, error)
+
+	// Import grava as linhas válidas de um arquivo de cotações em uma única transação
+	Import(ctx context.Context, industryID, userID string, rows []entity.ExchangeRateImportRow) (*entity.ExchangeRateImportResult, error)
+
+	// Delete remove uma cotação
+	Delete(ctx context.Context, industryID, id string) error
+
+	// Convert converte um valor entre moedas pela cotação vigente na data
+	Convert(ctx context.Context, industryID string, amount float64, from, to entity.Currency, date time.Time) (float64, error)
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// InventoryCountService define o contrato para sessões de inventário físico (contagem cíclica)
+type InventoryCountService interface {
+	// Create abre uma sessão congelando os lotes do escopo (produto, material ou todos)
+	Create(ctx context.Context, industryID, userID string, input entity.CreateInventoryCountInput) (*entity.InventoryCount, error)
+
+	// GetByID busca sessão com itens e divergências em relação aos contadores atuais
+	GetByID(ctx context.Context, industryID, id string) (*entity.InventoryCount, error)
+
+	// List lista sessões da indústria
+	List(ctx context.Context, industryID string, filters entity.InventoryCountFilters) (*entity.InventoryCountListResponse, error)
+
+	// RecordCounts grava as quantidades contadas por lote
+	RecordCounts(ctx context.Context, industryID, id, userID string, input entity.RecordInventoryCountInput) (*entity.InventoryCount, error)
+
+	// Approve aplica as divergências pelo ajuste de disponibilidade e encerra a sessão
+	Approve(ctx context.Context, industryID, id, userID string, input entity.ApproveInventoryCountInput) (*entity.InventoryCount, error)
+
+	// Cancel encerra a sessão sem ajustes
+	Cancel(ctx context.Context, industryID, id, userID string) (*entity.InventoryCount, error)
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// JobScheduler define o contrato do agendador de jobs em segundo plano
+type JobScheduler interface {
+	// ListJobs lista os jobs registrados com a última execução e a próxima prevista
+	ListJobs(ctx context.Context) ([]entity.ScheduledJob, error)
+
+	// ListRuns lista o histórico de execuções de um job
+	ListRuns(ctx context.Context, name string, limit int) ([]entity.JobRun, error)
+
+	// Trigger dispara a execução manual de um job em segundo plano
+	// (conflito se o job já estiver em execução em alguma réplica)
+	Trigger(ctx context.Context, name, userID string) (*entity.JobRun, error)
+}
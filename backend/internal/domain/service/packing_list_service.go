@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// PackingListService define o contrato para romaneios de carga das vendas
+type PackingListService interface {
+	// Generate gera (ou regenera) o romaneio da venda e armazena o PDF e o JSON no storage
+	Generate(ctx context.Context, industryID, saleID string, userID *string, input entity.GeneratePackingListInput) (*entity.PackingList, error)
+
+	// GetBySaleID busca o romaneio de uma venda
+	GetBySaleID(ctx context.Context, industryID, saleID string) (*entity.PackingList, error)
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// PriceListService define o contrato para tabelas de preço e resolução de preços por usuário/cliente
+type PriceListService interface {
+	// Create cadastra uma tabela de preço com regras
+	Create(ctx context.Context, industryID string, input entity.CreatePriceListInput) (*entity.PriceList, error)
+
+	// GetByID busca tabela com regras
+	GetByID(ctx context.Context, industryID, id string) (*entity.PriceList, error)
+
+	// List lista tabelas da indústria
+	List(ctx context.Context, industryID string) ([]entity.PriceList, error)
+
+	// Update atualiza tabela e, se informadas, substitui as regras
+	Update(ctx context.Context, industryID, id string, input entity.UpdatePriceListInput) (*entity.PriceList, error)
+
+	// Delete remove tabela e suas atribuições
+	Delete(ctx context.Context, industryID, id string) error
+
+	// ListAssignments lista usuários e clientes atribuídos à tabela
+	ListAssignments(ctx context.Context, industryID, id string) ([]entity.PriceListAssignment, error)
+
+	// Assign atribui a tabela a um broker, vendedor interno ou cliente
+	Assign(ctx context.Context, industryID, id string, input entity.AssignPriceListInput) (*entity.PriceListAssignment, error)
+
+	// Unassign remove uma atribuição
+	Unassign(ctx context.Context, industryID, assignmentID string) error
+
+	// ResolveBatchPrice resolve o preço do lote pela tabela do cliente ou, na falta, do usuário
+	ResolveBatchPrice(ctx context.Context, batch *entity.Batch, userID string, clienteID *string) (*entity.ResolvedPrice, error)
+
+	// ResolveProductPrice resolve o preço base do produto pela tabela do usuário (nil se o produto não tiver preço)
+	ResolveProductPrice(ctx context.Context, product *entity.Product, userID string) (*entity.ResolvedPrice, error)
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// PriceService define o contrato para histórico e agendamento de preços de produtos e lotes
+type PriceService interface {
+	// GetProductHistory lista o histórico de preço base do produto, incluindo agendamentos
+	GetProductHistory(ctx context.Context, industryID, productID string) ([]entity.PriceChange, error)
+
+	// GetBatchHistory lista o histórico de preço do lote, incluindo agendamentos
+	GetBatchHistory(ctx context.Context, industryID, batchID string) ([]entity.PriceChange, error)
+
+	// ScheduleProductPrice agenda um novo preço base para o produto
+	ScheduleProductPrice(ctx context.Context, industryID, productID, userID string, input entity.SchedulePriceChangeInput) (*entity.PriceChange, error)
+
+	// ScheduleBatchPrice agenda um novo preço para o lote
+	ScheduleBatchPrice(ctx context.Context, industryID, batchID, userID string, input entity.SchedulePriceChangeInput) (*entity.PriceChange, error)
+
+	// CancelScheduled cancela uma alteração ainda não aplicada
+	CancelScheduled(ctx context.Context, industryID, id string) error
+
+	// ApplyScheduled aplica as alterações com vigência vencida (executado por job)
+	ApplyScheduled(ctx context.Context) (int, error)
+}
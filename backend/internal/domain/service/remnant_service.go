@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// RemnantService define o contrato para o estoque de retalhos
+type RemnantService interface {
+	// Create cadastra um retalho a partir do lote de origem
+	Create(ctx context.Context, industryID, userID string, input entity.CreateRemnantInput) (*entity.Remnant, error)
+
+	// GetByID busca retalho com fotos
+	GetByID(ctx context.Context, industryID, id string) (*entity.Remnant, error)
+
+	// List lista retalhos da indústria com filtros de busca
+	List(ctx context.Context, industryID string, filters entity.RemnantFilters) (*entity.RemnantListResponse, error)
+
+	// Update atualiza dimensões, preço e disponibilidade do retalho
+	Update(ctx context.Context, industryID, id string, input entity.UpdateRemnantInput) (*entity.Remnant, error)
+
+	// Delete remove um retalho sem reservas ou vendas
+	Delete(ctx context.Context, industryID, id string) error
+
+	// AddPhotos anexa fotos já enviadas ao storage
+	AddPhotos(ctx context.Context, industryID, id string, urls []string) (*entity.Remnant, error)
+
+	// RemovePhoto remove uma foto do retalho
+	RemovePhoto(ctx context.Context, industryID, id, photoID string) error
+}
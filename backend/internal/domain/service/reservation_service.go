@@ -8,14 +8,15 @@ import (
 // ReservationService define o contrato para operações com reservas
 type ReservationService interface {
 	// Create cria reserva (verifica disponibilidade, atualiza status lote - TRANSAÇÃO)
-	// Para brokers, a reserva vai para PENDENTE_APROVACAO; para indústria, vai para ATIVA
+	// Prazo e limites seguem a política da indústria; reservas de brokers vão para PENDENTE_APROVACAO
+	// quando a política exige aprovação e nenhuma regra de aprovação automática se aplica
 	Create(ctx context.Context, userID string, userRole entity.UserRole, input entity.CreateReservationInput) (*entity.Reservation, error)
 
 	// GetByID busca reserva por ID
 	GetByID(ctx context.Context, id string) (*entity.Reservation, error)
 
 	// Cancel cancela reserva (volta status do lote para DISPONIVEL)
-	Cancel(ctx context.Context, id string) error
+	Cancel(ctx context.Context, id, userID string) error
 
 	// ConfirmSale confirma venda (cria SalesHistory, atualiza status lote - TRANSAÇÃO)
 	ConfirmSale(ctx context.Context, reservationID, userID string, input entity.ConfirmSaleInput) (*entity.Sale, error)
@@ -32,7 +33,7 @@ type ReservationService interface {
 	// ListPending lista reservas pendentes de aprovação
 	ListPending(ctx context.Context, industryID string) ([]entity.Reservation, error)
 
-	// Approve aprova uma reserva pendente (admin)
+	// Approve aprova uma reserva pendente (admin); o prazo padrão da política conta a partir da aprovação
 	Approve(ctx context.Context, reservationID, approverID string) (*entity.Reservation, error)
 
 	// Reject rejeita uma reserva pendente (admin)
@@ -43,4 +44,43 @@ type ReservationService interface {
 
 	// ExpirePendingApprovals job para expirar reservas pendentes de aprovação
 	ExpirePendingApprovals(ctx context.Context) (int, error)
+
+	// SendReservationReminders job que avisa por email reservas e prazos de aprovação prestes a vencer
+	SendReservationReminders(ctx context.Context) (int, error)
+
+	// JoinWaitlist entra na fila de espera de um lote sem chapas suficientes (ou com fila já formada); quando chapas
+	// voltam ao estoque, o pedido vira reserva por ordem de chegada, seguindo a política de reservas da indústria
+	JoinWaitlist(ctx context.Context, userID string, input entity.JoinWaitlistInput) (*entity.WaitlistEntry, error)
+
+	// ListWaitlist lista pedidos da fila de espera
+	ListWaitlist(ctx context.Context, filters entity.WaitlistFilters) ([]entity.WaitlistEntry, error)
+
+	// LeaveWaitlist retira um pedido da fila (quem pediu ou admin da indústria)
+	LeaveWaitlist(ctx context.Context, id, userID string) error
+
+	// RequestExtension pede a prorrogação do prazo de uma reserva ativa ou aprovada,
+	// respeitando a política de prorrogações da indústria
+	RequestExtension(ctx context.Context, reservationID, userID string, input entity.RequestReservationExtensionInput) (*entity.ReservationExtension, error)
+
+	// ApproveExtension aprova um pedido de prorrogação e aplica o novo prazo à reserva (admin)
+	ApproveExtension(ctx context.Context, industryID, extensionID, approverID string) (*entity.ReservationExtension, error)
+
+	// DenyExtension nega um pedido de prorrogação (admin)
+	DenyExtension(ctx context.Context, industryID, extensionID, approverID, reason string) (*entity.ReservationExtension, error)
+
+	// ListExtensions lista pedidos de prorrogação
+	ListExtensions(ctx context.Context, filters entity.ReservationExtensionFilters) ([]entity.ReservationExtension, error)
+
+	// GetTimeline retorna a linha do tempo da reserva (quem reservou ou admin da indústria)
+	GetTimeline(ctx context.Context, reservationID, userID string) ([]entity.ReservationEvent, error)
+
+	// ListOffers lista as propostas de preço da reserva (quem reservou ou admin da indústria)
+	ListOffers(ctx context.Context, reservationID, userID string) ([]entity.ReservationOffer, error)
+
+	// MakeOffer registra uma proposta de preço por m² numa reserva pendente: contraproposta do admin
+	// ou nova proposta de quem reservou (substitui a que aguardava resposta)
+	MakeOffer(ctx context.Context, reservationID, userID string, input entity.MakeReservationOfferInput) (*entity.ReservationOffer, error)
+
+	// AcceptOffer aceita a proposta do outro lado e aprova a reserva pelo preço acordado
+	AcceptOffer(ctx context.Context, reservationID, userID string) (*entity.Reservation, error)
 }
\ No newline at end of file
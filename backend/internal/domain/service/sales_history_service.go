@@ -23,5 +23,5 @@ type SalesHistoryService interface {
 	GetBrokerSales(ctx context.Context, brokerID string, limit int) ([]entity.Sale, error)
 
 	// Delete remove um registro de venda (undo)
-	Delete(ctx context.Context, id string) error
+	Delete(ctx context.Context, id, userID string) error
 }
\ No newline at end of file
@@ -17,7 +17,7 @@ type SalesLinkService interface {
 	GetBySlug(ctx context.Context, slug string) (*entity.SalesLink, error)
 
 	// GetPublicBySlug busca link por slug com dados sanitizados para exibição pública
-	GetPublicBySlug(ctx context.Context, slug string) (*entity.PublicSalesLink, error)
+	GetPublicBySlug(ctx context.Context, slug string, currency *entity.Currency) (*entity.PublicSalesLink, error)
 
 	// List lista links com filtros
 	List(ctx context.Context, filters entity.SalesLinkFilters) (*entity.SalesLinkListResponse, error)
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// StockAlertService define o contrato para alertas de estoque baixo/parado
+type StockAlertService interface {
+	// CheckAlerts detecta cruzamentos de limite e notifica os administradores (job periódico).
+	// Retorna a quantidade de alertas notificados.
+	CheckAlerts(ctx context.Context) (int, error)
+
+	// List lista alertas de estoque da indústria
+	List(ctx context.Context, industryID string, filters entity.StockAlertFilters) (*entity.StockAlertListResponse, error)
+}
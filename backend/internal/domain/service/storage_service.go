@@ -16,6 +16,15 @@ type StorageService interface {
 	// UploadBatchMedia faz upload de mídia de lote
 	UploadBatchMedia(ctx context.Context, batchID string, reader io.Reader, filename, contentType string, size int64) (string, error)
 
+	// UploadDamagePhoto faz upload de foto de relatório de avaria
+	UploadDamagePhoto(ctx context.Context, reportID string, reader io.Reader, filename, contentType string, size int64) (string, error)
+
+	// UploadRemnantPhoto faz upload de foto de retalho
+	UploadRemnantPhoto(ctx context.Context, remnantID string, reader io.Reader, filename, contentType string, size int64) (string, error)
+
+	// UploadPackingList faz upload do romaneio de uma venda (PDF ou JSON), substituindo a versão anterior
+	UploadPackingList(ctx context.Context, saleID string, reader io.Reader, filename, contentType string, size int64) (string, error)
+
 	// UploadIndustryLogo faz upload da logo da indústria
 	UploadIndustryLogo(ctx context.Context, industryID string, reader io.Reader, filename, contentType string, size int64) (string, error)
 
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// WarehouseService define o contrato para operações com depósitos físicos e posições
+type WarehouseService interface {
+	// Create cadastra um depósito
+	Create(ctx context.Context, industryID string, input entity.CreateWarehouseInput) (*entity.Warehouse, error)
+
+	// GetByID busca depósito com posições
+	GetByID(ctx context.Context, industryID, id string) (*entity.Warehouse, error)
+
+	// List lista depósitos da indústria com posições
+	List(ctx context.Context, industryID string, includeInactive bool) ([]entity.Warehouse, error)
+
+	// Update atualiza depósito
+	Update(ctx context.Context, industryID, id string, input entity.UpdateWarehouseInput) (*entity.Warehouse, error)
+
+	// Delete remove depósito sem lotes alocados
+	Delete(ctx context.Context, industryID, id string) error
+
+	// CreateLocation cadastra uma posição no depósito
+	CreateLocation(ctx context.Context, industryID, warehouseID string, input entity.CreateWarehouseLocationInput) (*entity.WarehouseLocation, error)
+
+	// UpdateLocation atualiza uma posição do depósito
+	UpdateLocation(ctx context.Context, industryID, warehouseID, locationID string, input entity.UpdateWarehouseLocationInput) (*entity.WarehouseLocation, error)
+
+	// DeleteLocation remove posição sem lotes alocados
+	DeleteLocation(ctx context.Context, industryID, warehouseID, locationID string) error
+}
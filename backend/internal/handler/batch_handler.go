@@ -1,15 +1,19 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
 	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
 	"github.com/thiagomes07/CAVA/backend/internal/middleware"
 	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/spreadsheet"
 	"github.com/thiagomes07/CAVA/backend/pkg/validator"
 	"go.uber.org/zap"
 )
@@ -43,8 +47,12 @@ func NewBatchHandler(
 // @Tags batches
 // @Produce json
 // @Param productId query string false "Filtrar por produto"
+// @Param blockId query string false "Filtrar por bloco de origem"
+// @Param warehouseId query string false "Filtrar por depósito físico"
+// @Param locationId query string false "Filtrar por posição no depósito"
 // @Param status query string false "Filtrar por status"
 // @Param code query string false "Buscar por código"
+// @Param search query string false "Busca textual sem acentos (código, produto, SKU, material, acabamento, pedreira, descrição), ordenada por relevância"
 // @Param onlyWithAvailable query bool false "Apenas lotes com chapas disponíveis"
 // @Param page query int false "Número da página"
 // @Param limit query int false "Itens por página"
@@ -141,6 +149,183 @@ func (h *BatchHandler) List(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Extrair filtros da query string
+	filters := parseBatchFilters(r)
+
+	// Validar filtros
+	if err := h.validator.Validate(filters); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	// Buscar lotes
+	result, err := h.batchService.List(r.Context(), industryID, filters)
+	if err != nil {
+		h.logger.Error("erro ao listar lotes",
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// PreviewNextCode godoc
+// @Summary Prévia do próximo código de lote
+// @Description Retorna o próximo código automático (prefixo do produto/material + sequência da indústria) sem consumi-lo
+// @Tags batches
+// @Produce json
+// @Param productId query string false "Produto existente"
+// @Param productName query string false "Nome do novo produto"
+// @Param material query string false "Material do novo produto"
+// @Success 200 {object} entity.NextBatchCodeResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/batches/next-code [get]
+func (h *BatchHandler) PreviewNextCode(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.NextBatchCodeInput
+	if productID := r.URL.Query().Get("productId"); productID != "" {
+		input.ProductID = &productID
+	}
+	if productName := r.URL.Query().Get("productName"); productName != "" {
+		input.ProductName = &productName
+	}
+	if material := r.URL.Query().Get("material"); material != "" {
+		m := entity.MaterialType(strings.ToUpper(material))
+		input.Material = &m
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	result, err := h.batchService.PreviewNextCode(r.Context(), industryID, input)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// Prazo de escrita da exportação, renovado a cada exportDeadlineEvery linhas
+const (
+	exportWriteWindow   = 30 * time.Second
+	exportDeadlineEvery = 200
+)
+
+// Export godoc
+// @Summary Exporta lotes em planilha
+// @Description Exporta todos os lotes que atendem aos filtros (sem paginação) em CSV ou XLSX, com área e preços calculados
+// @Tags batches
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "Formato do arquivo (csv ou xlsx)" default(csv)
+// @Param productId query string false "Filtrar por produto"
+// @Param blockId query string false "Filtrar por bloco de origem"
+// @Param warehouseId query string false "Filtrar por depósito físico"
+// @Param locationId query string false "Filtrar por posição no depósito"
+// @Param status query string false "Filtrar por status"
+// @Param code query string false "Buscar por código"
+// @Param search query string false "Busca textual sem acentos (código, produto, SKU, material, acabamento, pedreira, descrição), ordenada por relevância"
+// @Param onlyWithAvailable query bool false "Apenas lotes com chapas disponíveis"
+// @Param lowStock query bool false "Apenas lotes com estoque baixo"
+// @Param stale query bool false "Apenas lotes parados (sem movimento além do limite configurado)"
+// @Param noStock query bool false "Apenas lotes sem estoque"
+// @Param includeArchived query bool false "Incluir lotes arquivados"
+// @Param onlyArchived query bool false "Apenas lotes arquivados"
+// @Param sortBy query string false "Campo de ordenação"
+// @Param sortDir query string false "Direção da ordenação (asc ou desc)"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/batches/export [get]
+func (h *BatchHandler) Export(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	format := spreadsheet.FormatCSV
+	if f := r.URL.Query().Get("format"); f != "" {
+		format = spreadsheet.Format(strings.ToLower(f))
+		if format != spreadsheet.FormatCSV && format != spreadsheet.FormatXLSX {
+			response.BadRequest(w, "Formato inválido. Use csv ou xlsx", nil)
+			return
+		}
+	}
+
+	// Paginação não se aplica à exportação
+	filters := parseBatchFilters(r)
+	if err := h.validator.Validate(filters); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("lotes-%s.%s", time.Now().Format("20060102-150405"), format)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	writer, err := spreadsheet.NewWriter(w, format, "Lotes")
+	if err != nil {
+		h.logger.Error("erro ao iniciar exportação de lotes", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	// O catálogo completo pode levar mais que o WriteTimeout do servidor: o prazo de escrita
+	// é renovado enquanto houver linhas sendo enviadas
+	controller := http.NewResponseController(w)
+	extendDeadline := func() {
+		if err := controller.SetWriteDeadline(time.Now().Add(exportWriteWindow)); err != nil {
+			h.logger.Warn("não foi possível estender o prazo de escrita da exportação", zap.Error(err))
+		}
+	}
+	extendDeadline()
+
+	if err := writer.WriteRow(entity.BatchExportColumns); err != nil {
+		h.logger.Error("erro ao escrever cabeçalho da exportação", zap.Error(err))
+		return
+	}
+
+	count := 0
+	err = h.batchService.Export(r.Context(), industryID, filters, func(batch *entity.Batch) error {
+		count++
+		if count%exportDeadlineEvery == 0 {
+			extendDeadline()
+		}
+		return writer.WriteRow(entity.BatchExportRow(batch))
+	})
+	if err != nil {
+		// Resposta já iniciada: apenas registrar a falha
+		h.logger.Error("erro ao exportar lotes",
+			zap.String("industryId", industryID),
+			zap.Int("rows", count),
+			zap.Error(err),
+		)
+		return
+	}
+
+	if err := writer.Close(); err != nil {
+		h.logger.Error("erro ao finalizar exportação de lotes", zap.Error(err))
+		return
+	}
+
+	h.logger.Info("lotes exportados",
+		zap.String("industryId", industryID),
+		zap.String("format", string(format)),
+		zap.Int("rows", count),
+	)
+}
+
+// parseBatchFilters extrai os filtros de lotes da query string
+func parseBatchFilters(r *http.Request) entity.BatchFilters {
 	filters := entity.BatchFilters{
 		Page:  1,
 		Limit: 50,
@@ -150,6 +335,18 @@ func (h *BatchHandler) List(w http.ResponseWriter, r *http.Request) {
 		filters.ProductID = &productID
 	}
 
+	if blockID := r.URL.Query().Get("blockId"); blockID != "" {
+		filters.BlockID = &blockID
+	}
+
+	if warehouseID := r.URL.Query().Get("warehouseId"); warehouseID != "" {
+		filters.WarehouseID = &warehouseID
+	}
+
+	if locationID := r.URL.Query().Get("locationId"); locationID != "" {
+		filters.LocationID = &locationID
+	}
+
 	if status := r.URL.Query().Get("status"); status != "" {
 		s := entity.BatchStatus(status)
 		if s.IsValid() {
@@ -161,6 +358,10 @@ func (h *BatchHandler) List(w http.ResponseWriter, r *http.Request) {
 		filters.Code = &code
 	}
 
+	if search := r.URL.Query().Get("search"); search != "" {
+		filters.Search = &search
+	}
+
 	if onlyWithAvailable := r.URL.Query().Get("onlyWithAvailable"); onlyWithAvailable == "true" {
 		filters.OnlyWithAvailable = true
 	}
@@ -169,6 +370,10 @@ func (h *BatchHandler) List(w http.ResponseWriter, r *http.Request) {
 		filters.LowStock = true
 	}
 
+	if stale := r.URL.Query().Get("stale"); stale == "true" {
+		filters.Stale = true
+	}
+
 	if noStock := r.URL.Query().Get("noStock"); noStock == "true" {
 		filters.NoStock = true
 	}
@@ -202,23 +407,7 @@ func (h *BatchHandler) List(w http.ResponseWriter, r *http.Request) {
 		filters.SortDir = sortDir
 	}
 
-	// Validar filtros
-	if err := h.validator.Validate(filters); err != nil {
-		response.HandleError(w, err)
-		return
-	}
-
-	// Buscar lotes
-	result, err := h.batchService.List(r.Context(), industryID, filters)
-	if err != nil {
-		h.logger.Error("erro ao listar lotes",
-			zap.Error(err),
-		)
-		response.HandleError(w, err)
-		return
-	}
-
-	response.OK(w, result)
+	return filters
 }
 
 // GetByID godoc
@@ -495,7 +684,9 @@ func (h *BatchHandler) UpdateAvailability(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	batch, err := h.batchService.UpdateAvailability(r.Context(), id, input.Status, input.FromStatus, input.Quantity)
+	userID := middleware.GetUserID(r.Context())
+
+	batch, err := h.batchService.UpdateAvailability(r.Context(), id, userID, input)
 	if err != nil {
 		h.logger.Error("erro ao ajustar disponibilidade do lote",
 			zap.String("id", id),
@@ -564,7 +755,7 @@ func (h *BatchHandler) CheckAvailability(w http.ResponseWriter, r *http.Request)
 
 // Sell godoc
 // @Summary Vende itens do lote (venda manual)
-// @Description Registra uma venda manual de itens de um lote
+// @Description Registra uma venda manual de itens de um lote e gera o romaneio de carga
 // @Tags batches
 // @Accept json
 // @Produce json
@@ -701,3 +892,468 @@ func (h *BatchHandler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	response.OK(w, map[string]bool{"deleted": true})
 }
+
+// ListSlabs godoc
+// @Summary Lista chapas do lote
+// @Description Retorna as chapas individuais do lote com número, dimensões, status e mídias
+// @Tags batches
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Success 200 {array} entity.Slab
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/batches/{id}/slabs [get]
+func (h *BatchHandler) ListSlabs(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do lote é obrigatório", nil)
+		return
+	}
+
+	userRole := entity.UserRole(middleware.GetUserRole(r.Context()))
+	userID := middleware.GetUserID(r.Context())
+
+	// Se for BROKER ou VENDEDOR_INTERNO, verificar se o lote foi compartilhado
+	if userRole == entity.RoleBroker || userRole == entity.RoleVendedorInterno {
+		exists, err := h.sharedInventoryService.ExistsForUser(r.Context(), id, userID)
+		if err != nil {
+			response.HandleError(w, err)
+			return
+		}
+		if !exists {
+			response.Forbidden(w, "Você não tem acesso a este lote")
+			return
+		}
+	}
+
+	slabs, err := h.batchService.ListSlabs(r.Context(), id)
+	if err != nil {
+		h.logger.Error("erro ao listar chapas do lote",
+			zap.String("batchId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, slabs)
+}
+
+// InitializeSlabs godoc
+// @Summary Inicializa chapas de lote legado
+// @Description Gera as chapas individuais de um lote sem rastreamento a partir dos contadores atuais
+// @Tags batches
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Success 201 {array} entity.Slab
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/batches/{id}/slabs/initialize [post]
+func (h *BatchHandler) InitializeSlabs(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do lote é obrigatório", nil)
+		return
+	}
+
+	slabs, err := h.batchService.InitializeSlabs(r.Context(), id)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, slabs)
+}
+
+// UpdateSlab godoc
+// @Summary Atualiza chapa do lote
+// @Description Atualiza dimensões e defeitos de uma chapa individual
+// @Tags batches
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Param slabId path string true "ID da chapa"
+// @Param body body entity.UpdateSlabInput true "Dados da chapa"
+// @Success 200 {object} entity.Slab
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/batches/{id}/slabs/{slabId} [put]
+func (h *BatchHandler) UpdateSlab(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	slabID := chi.URLParam(r, "slabId")
+	if id == "" || slabID == "" {
+		response.BadRequest(w, "ID do lote e da chapa são obrigatórios", nil)
+		return
+	}
+
+	var input entity.UpdateSlabInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	slab, err := h.batchService.UpdateSlab(r.Context(), id, slabID, input)
+	if err != nil {
+		h.logger.Error("erro ao atualizar chapa",
+			zap.String("batchId", id),
+			zap.String("slabId", slabID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, slab)
+}
+
+// Split godoc
+// @Summary Divide o lote
+// @Description Move chapas disponíveis para um novo lote, copiando mídias, compartilhamentos e links
+// @Tags batches
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Param body body entity.SplitBatchInput true "Chapas a dividir"
+// @Success 201 {object} entity.BatchSplitResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/batches/{id}/split [post]
+func (h *BatchHandler) Split(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do lote é obrigatório", nil)
+		return
+	}
+
+	var input entity.SplitBatchInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	result, err := h.batchService.Split(r.Context(), industryID, id, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao dividir lote",
+			zap.String("batchId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, result)
+}
+
+// Merge godoc
+// @Summary Unifica lotes
+// @Description Incorpora as chapas disponíveis/inativas de lotes compatíveis ao lote de destino e arquiva as origens
+// @Tags batches
+// @Accept json
+// @Produce json
+// @Param body body entity.MergeBatchesInput true "Lote de destino e lotes de origem"
+// @Success 200 {object} entity.Batch
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/batches/merge [post]
+func (h *BatchHandler) Merge(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.MergeBatchesInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	batch, err := h.batchService.Merge(r.Context(), industryID, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao unificar lotes",
+			zap.String("targetBatchId", input.TargetBatchID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, batch)
+}
+
+// GetLineage godoc
+// @Summary Origem do lote
+// @Description Retorna os lotes de origem e os lotes gerados por divisão/unificação
+// @Tags batches
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Success 200 {object} entity.BatchLineageResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/batches/{id}/lineage [get]
+func (h *BatchHandler) GetLineage(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do lote é obrigatório", nil)
+		return
+	}
+
+	result, err := h.batchService.GetLineage(r.Context(), id)
+	if err != nil {
+		h.logger.Error("erro ao buscar origem do lote",
+			zap.String("batchId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// Transfer godoc
+// @Summary Transfere o lote de localização
+// @Description Move o lote para outro depósito/posição e registra a transferência no histórico
+// @Tags batches
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Param body body entity.TransferBatchInput true "Depósito e posição de destino"
+// @Success 200 {object} entity.Batch
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/batches/{id}/transfer [post]
+func (h *BatchHandler) Transfer(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do lote é obrigatório", nil)
+		return
+	}
+
+	var input entity.TransferBatchInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	batch, err := h.batchService.Transfer(r.Context(), id, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao transferir lote",
+			zap.String("batchId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, batch)
+}
+
+// ListTransfers godoc
+// @Summary Histórico de transferências do lote
+// @Description Retorna as movimentações do lote entre depósitos/posições (mais recentes primeiro)
+// @Tags batches
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Success 200 {array} entity.BatchTransfer
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/batches/{id}/transfers [get]
+func (h *BatchHandler) ListTransfers(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do lote é obrigatório", nil)
+		return
+	}
+
+	transfers, err := h.batchService.ListTransfers(r.Context(), id)
+	if err != nil {
+		h.logger.Error("erro ao buscar transferências do lote",
+			zap.String("batchId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, transfers)
+}
+
+// ListMovements godoc
+// @Summary Lista movimentações de estoque do lote
+// @Description Retorna o razão de movimentações do lote e o saldo reconstruído comparado aos contadores
+// @Tags batches
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Param reason query string false "Filtrar por motivo"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Itens por página"
+// @Success 200 {object} entity.BatchMovementListResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/batches/{id}/movements [get]
+func (h *BatchHandler) ListMovements(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do lote é obrigatório", nil)
+		return
+	}
+
+	filters := entity.BatchMovementFilters{
+		Page:  1,
+		Limit: 50,
+	}
+
+	if reason := r.URL.Query().Get("reason"); reason != "" {
+		movementReason := entity.MovementReason(strings.ToUpper(reason))
+		filters.Reason = &movementReason
+	}
+
+	if page := r.URL.Query().Get("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+
+	if err := h.validator.Validate(filters); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	result, err := h.batchService.ListMovements(r.Context(), id, filters)
+	if err != nil {
+		h.logger.Error("erro ao listar movimentações do lote",
+			zap.String("batchId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+const maxImportFileSize = 10 << 20 // 10MB
+
+// Import godoc
+// @Summary Importa lotes em massa
+// @Description Importa lotes a partir de planilha CSV ou XLSX. Com dryRun=true apenas valida e retorna os erros por linha
+// @Tags batches
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Planilha (.csv ou .xlsx)"
+// @Param dryRun query bool false "Apenas validar, sem gravar"
+// @Success 200 {object} entity.BatchImportResult
+// @Success 201 {object} entity.BatchImportResult
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/batches/import [post]
+func (h *BatchHandler) Import(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportFileSize)
+
+	if err := r.ParseMultipartForm(maxImportFileSize); err != nil {
+		h.logger.Error("erro ao parsear multipart form", zap.Error(err))
+		response.BadRequest(w, "Arquivo muito grande. Máximo 10MB", nil)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		response.BadRequest(w, "Arquivo é obrigatório", nil)
+		return
+	}
+	defer file.Close()
+
+	dryRun := r.URL.Query().Get("dryRun") == "true" || r.FormValue("dryRun") == "true"
+
+	format, err := spreadsheet.FormatFromFilename(header.Filename)
+	if err != nil {
+		response.BadRequest(w, err.Error(), nil)
+		return
+	}
+
+	records, err := spreadsheet.ReadRecords(file, format)
+	if err != nil {
+		response.BadRequest(w, err.Error(), nil)
+		return
+	}
+
+	rows, err := entity.ParseBatchImportRecords(records)
+	if err != nil {
+		response.BadRequest(w, err.Error(), nil)
+		return
+	}
+
+	// Validação estrutural de cada linha (mesmas regras do cadastro individual)
+	for i := range rows {
+		if err := h.validator.Validate(rows[i].Input); err != nil {
+			if appErr, ok := err.(*errors.AppError); ok && len(appErr.Details) > 0 {
+				for field, message := range appErr.Details {
+					rows[i].AddError(fmt.Sprintf("%s: %v", field, message))
+				}
+			} else {
+				rows[i].AddError(err.Error())
+			}
+		}
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	result, err := h.batchService.Import(r.Context(), industryID, rows, dryRun)
+	if err != nil {
+		h.logger.Error("erro ao importar lotes",
+			zap.String("industryId", industryID),
+			zap.Bool("dryRun", dryRun),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	if result.Committed {
+		response.Created(w, result)
+		return
+	}
+
+	response.OK(w, result)
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// BatchLabelHandler gerencia a impressão de etiquetas de lotes
+type BatchLabelHandler struct {
+	labelService service.BatchLabelService
+	validator    *validator.Validator
+	logger       *zap.Logger
+}
+
+// NewBatchLabelHandler cria uma nova instância de BatchLabelHandler
+func NewBatchLabelHandler(labelService service.BatchLabelService, validator *validator.Validator, logger *zap.Logger) *BatchLabelHandler {
+	return &BatchLabelHandler{
+		labelService: labelService,
+		validator:    validator,
+		logger:       logger,
+	}
+}
+
+// ListLayouts godoc
+// @Summary Lista layouts de etiquetas
+// @Description Lista os formatos de folha de etiquetas suportados (A4, Carta e rolos térmicos)
+// @Tags batches
+// @Produce json
+// @Success 200 {array} entity.LabelLayout
+// @Router /api/batches/labels/layouts [get]
+func (h *BatchLabelHandler) ListLayouts(w http.ResponseWriter, r *http.Request) {
+	response.OK(w, h.labelService.Layouts())
+}
+
+// Generate godoc
+// @Summary Gera etiquetas de lotes
+// @Description Gera um PDF com etiquetas (código, produto, dimensões, chapas e QR code para a página do lote) de um ou mais lotes
+// @Tags batches
+// @Accept json
+// @Produce application/pdf
+// @Param body body entity.GenerateBatchLabelsInput true "Lotes e layout da folha"
+// @Success 200 {file} file
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/batches/labels [post]
+func (h *BatchLabelHandler) Generate(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.GenerateBatchLabelsInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	pdf, err := h.labelService.Generate(r.Context(), industryID, input)
+	if err != nil {
+		h.logger.Error("erro ao gerar etiquetas de lotes",
+			zap.String("industryId", industryID),
+			zap.Int("batches", len(input.BatchIDs)),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("etiquetas-%s.pdf", time.Now().Format("20060102-150405"))
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(pdf)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(pdf); err != nil {
+		h.logger.Warn("erro ao enviar PDF de etiquetas", zap.Error(err))
+	}
+}
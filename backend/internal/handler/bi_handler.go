@@ -172,7 +172,7 @@ func (h *BIHandler) GetConversionMetrics(w http.ResponseWriter, r *http.Request)
 
 // GetInventoryMetrics godoc
 // @Summary Retorna métricas de inventário
-// @Description Retorna métricas de estoque: disponível, reservado, valor, rotatividade
+// @Description Retorna métricas de estoque: disponível, reservado, valor, rotatividade e perdas por avaria (por produto e causa)
 // @Tags bi
 // @Produce json
 // @Success 200 {object} entity.InventoryMetrics
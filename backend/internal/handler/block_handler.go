@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// BlockHandler gerencia requisições de blocos de pedreira
+type BlockHandler struct {
+	blockService service.BlockService
+	validator    *validator.Validator
+	logger       *zap.Logger
+}
+
+// NewBlockHandler cria uma nova instância de BlockHandler
+func NewBlockHandler(
+	blockService service.BlockService,
+	validator *validator.Validator,
+	logger *zap.Logger,
+) *BlockHandler {
+	return &BlockHandler{
+		blockService: blockService,
+		validator:    validator,
+		logger:       logger,
+	}
+}
+
+// List godoc
+// @Summary Lista blocos
+// @Description Lista blocos de pedreira da indústria com rendimento (m² de chapa por m³)
+// @Tags blocks
+// @Produce json
+// @Param search query string false "Buscar por código, pedreira ou fornecedor"
+// @Param includeInactive query bool false "Incluir blocos inativos"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Itens por página"
+// @Success 200 {object} entity.BlockListResponse
+// @Router /api/blocks [get]
+func (h *BlockHandler) List(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	filters := entity.BlockFilters{
+		Page:  1,
+		Limit: 50,
+	}
+
+	if search := r.URL.Query().Get("search"); search != "" {
+		filters.Search = &search
+	}
+
+	if includeInactive := r.URL.Query().Get("includeInactive"); includeInactive == "true" {
+		filters.IncludeInactive = true
+	}
+
+	if page := r.URL.Query().Get("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+
+	if err := h.validator.Validate(filters); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	result, err := h.blockService.List(r.Context(), industryID, filters)
+	if err != nil {
+		h.logger.Error("erro ao listar blocos", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetByID godoc
+// @Summary Busca bloco por ID
+// @Description Retorna o bloco com rendimento e os lotes serrados a partir dele
+// @Tags blocks
+// @Produce json
+// @Param id path string true "ID do bloco"
+// @Success 200 {object} entity.Block
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/blocks/{id} [get]
+func (h *BlockHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do bloco é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	block, err := h.blockService.GetByID(r.Context(), industryID, id)
+	if err != nil {
+		h.logger.Error("erro ao buscar bloco",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, block)
+}
+
+// Create godoc
+// @Summary Cadastra bloco
+// @Description Cadastra um bloco de pedreira (volume calculado pelas dimensões quando não informado)
+// @Tags blocks
+// @Accept json
+// @Produce json
+// @Param body body entity.CreateBlockInput true "Dados do bloco"
+// @Success 201 {object} entity.Block
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/blocks [post]
+func (h *BlockHandler) Create(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.CreateBlockInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	block, err := h.blockService.Create(r.Context(), industryID, input)
+	if err != nil {
+		h.logger.Error("erro ao criar bloco", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, block)
+}
+
+// Update godoc
+// @Summary Atualiza bloco
+// @Description Atualiza dados de um bloco de pedreira
+// @Tags blocks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do bloco"
+// @Param body body entity.UpdateBlockInput true "Dados a atualizar"
+// @Success 200 {object} entity.Block
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/blocks/{id} [put]
+func (h *BlockHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do bloco é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.UpdateBlockInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	block, err := h.blockService.Update(r.Context(), industryID, id, input)
+	if err != nil {
+		h.logger.Error("erro ao atualizar bloco",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, block)
+}
+
+// Delete godoc
+// @Summary Exclui bloco
+// @Description Exclui um bloco sem lotes vinculados
+// @Tags blocks
+// @Produce json
+// @Param id path string true "ID do bloco"
+// @Success 200 {object} map[string]bool
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/blocks/{id} [delete]
+func (h *BlockHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do bloco é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	if err := h.blockService.Delete(r.Context(), industryID, id); err != nil {
+		h.logger.Error("erro ao excluir bloco",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
+
+// TraceSlab godoc
+// @Summary Rastreia chapa até o bloco
+// @Description Retorna chapa, lote, divisões/unificações percorridas e o bloco de pedreira de origem
+// @Tags blocks
+// @Produce json
+// @Param slabId path string true "ID da chapa"
+// @Success 200 {object} entity.SlabTrace
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/slabs/{slabId}/trace [get]
+func (h *BlockHandler) TraceSlab(w http.ResponseWriter, r *http.Request) {
+	slabID := chi.URLParam(r, "slabId")
+	if slabID == "" {
+		response.BadRequest(w, "ID da chapa é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	trace, err := h.blockService.TraceSlab(r.Context(), industryID, slabID)
+	if err != nil {
+		h.logger.Error("erro ao rastrear chapa",
+			zap.String("slabId", slabID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, trace)
+}
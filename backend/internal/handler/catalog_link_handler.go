@@ -261,6 +261,7 @@ func (h *CatalogLinkHandler) Delete(w http.ResponseWriter, r *http.Request) {
 // @Tags public
 // @Produce json
 // @Param slug path string true "Slug do catálogo"
+// @Param currency query string false "Moeda para exibição dos preços (BRL, USD, EUR)"
 // @Success 200 {object} entity.PublicCatalogLink
 // @Router /api/public/catalogo/{slug} [get]
 func (h *CatalogLinkHandler) GetPublicBySlug(w http.ResponseWriter, r *http.Request) {
@@ -270,7 +271,13 @@ func (h *CatalogLinkHandler) GetPublicBySlug(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	publicLink, err := h.catalogLinkService.GetPublicBySlug(r.Context(), slug)
+	currency, ok := parseCurrencyParam(r)
+	if !ok {
+		response.BadRequest(w, "Moeda inválida. Use BRL, USD ou EUR", nil)
+		return
+	}
+
+	publicLink, err := h.catalogLinkService.GetPublicBySlug(r.Context(), slug, currency)
 	if err != nil {
 		h.logger.Warn("catálogo não encontrado",
 			zap.String("slug", slug),
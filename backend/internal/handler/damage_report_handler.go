@@ -0,0 +1,397 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// DamageReportHandler gerencia requisições de relatórios de avaria
+type DamageReportHandler struct {
+	reportService  service.DamageReportService
+	storageService service.StorageService
+	validator      *validator.Validator
+	logger         *zap.Logger
+}
+
+// NewDamageReportHandler cria uma nova instância de DamageReportHandler
+func NewDamageReportHandler(
+	reportService service.DamageReportService,
+	storageService service.StorageService,
+	validator *validator.Validator,
+	logger *zap.Logger,
+) *DamageReportHandler {
+	return &DamageReportHandler{
+		reportService:  reportService,
+		storageService: storageService,
+		validator:      validator,
+		logger:         logger,
+	}
+}
+
+// List godoc
+// @Summary Lista avarias
+// @Description Lista relatórios de avaria da indústria
+// @Tags damage-reports
+// @Produce json
+// @Param batchId query string false "Filtrar por lote"
+// @Param status query string false "Filtrar por status (PENDENTE, APROVADO, REJEITADO)"
+// @Param cause query string false "Filtrar por causa (QUEBRA_TRANSPORTE, TRINCA, MANCHA, OUTRO)"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Itens por página"
+// @Success 200 {object} entity.DamageReportListResponse
+// @Router /api/damage-reports [get]
+func (h *DamageReportHandler) List(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	filters := entity.DamageReportFilters{
+		Page:  1,
+		Limit: 20,
+	}
+
+	if batchID := r.URL.Query().Get("batchId"); batchID != "" {
+		filters.BatchID = &batchID
+	}
+
+	if status := r.URL.Query().Get("status"); status != "" {
+		s := entity.DamageReportStatus(status)
+		if s.IsValid() {
+			filters.Status = &s
+		}
+	}
+
+	if cause := r.URL.Query().Get("cause"); cause != "" {
+		c := entity.DamageCause(cause)
+		if c.IsValid() {
+			filters.Cause = &c
+		}
+	}
+
+	if page := r.URL.Query().Get("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+
+	result, err := h.reportService.List(r.Context(), industryID, filters)
+	if err != nil {
+		h.logger.Error("erro ao listar avarias", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetByID godoc
+// @Summary Busca avaria por ID
+// @Description Retorna o relatório de avaria com as fotos
+// @Tags damage-reports
+// @Produce json
+// @Param id path string true "ID do relatório"
+// @Success 200 {object} entity.DamageReport
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/damage-reports/{id} [get]
+func (h *DamageReportHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do relatório é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	report, err := h.reportService.GetByID(r.Context(), industryID, id)
+	if err != nil {
+		h.logger.Error("erro ao buscar avaria",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, report)
+}
+
+// Create godoc
+// @Summary Registra avaria
+// @Description Registra chapas avariadas de um lote; o estoque só é alterado após aprovação
+// @Tags damage-reports
+// @Accept json
+// @Produce json
+// @Param body body entity.CreateDamageReportInput true "Dados da avaria"
+// @Success 201 {object} entity.DamageReport
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/damage-reports [post]
+func (h *DamageReportHandler) Create(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.CreateDamageReportInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	report, err := h.reportService.Create(r.Context(), industryID, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao registrar avaria", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, report)
+}
+
+// UploadPhotos godoc
+// @Summary Envia fotos da avaria
+// @Description Faz upload de fotos para um relatório de avaria pendente
+// @Tags damage-reports
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID do relatório"
+// @Param photos formData file true "Fotos da avaria"
+// @Success 201 {object} entity.DamageReport
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/damage-reports/{id}/photos [post]
+func (h *DamageReportHandler) UploadPhotos(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do relatório é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	// Limitar tamanho do request
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize*maxFilesPerBatch)
+
+	if err := r.ParseMultipartForm(maxUploadSize * maxFilesPerBatch); err != nil {
+		response.BadRequest(w, "Arquivo muito grande. Máximo 5MB por arquivo", nil)
+		return
+	}
+
+	// Verificar se o relatório pertence à indústria e ainda aceita fotos antes do upload
+	report, err := h.reportService.GetByID(r.Context(), industryID, id)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+	if !report.IsPending() {
+		response.BadRequest(w, "Relatório de avaria já foi revisado", nil)
+		return
+	}
+
+	files := r.MultipartForm.File["photos"]
+	if len(files) == 0 {
+		response.BadRequest(w, "Nenhum arquivo enviado", nil)
+		return
+	}
+
+	if len(files) > maxFilesPerBatch {
+		response.BadRequest(w, "Máximo 10 arquivos por upload", nil)
+		return
+	}
+
+	var urls []string
+	for _, fileHeader := range files {
+		// Sanitizar nome do arquivo para prevenir path traversal
+		safeFilename := sanitizeUploadFilename(fileHeader.Filename)
+
+		if !isAllowedExtension(safeFilename) {
+			response.BadRequest(w, "Extensão de arquivo inválida. Use .jpg, .jpeg, .png ou .webp", nil)
+			return
+		}
+
+		if fileHeader.Size > maxUploadSize {
+			response.BadRequest(w, "Arquivo muito grande. Máximo 5MB por arquivo", nil)
+			return
+		}
+
+		contentType := fileHeader.Header.Get("Content-Type")
+		if !h.storageService.ValidateFileType(contentType, allowedContentTypes) {
+			response.BadRequest(w, "Formato inválido. Use JPEG, PNG ou WebP", nil)
+			return
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			h.logger.Error("erro ao abrir arquivo",
+				zap.String("filename", safeFilename),
+				zap.Error(err),
+			)
+			response.BadRequest(w, "Erro ao processar arquivo", nil)
+			return
+		}
+
+		url, err := h.storageService.UploadDamagePhoto(
+			r.Context(),
+			id,
+			file,
+			safeFilename,
+			contentType,
+			fileHeader.Size,
+		)
+		file.Close()
+		if err != nil {
+			h.logger.Error("erro ao fazer upload da foto de avaria",
+				zap.String("filename", safeFilename),
+				zap.Error(err),
+			)
+			response.HandleError(w, err)
+			return
+		}
+
+		urls = append(urls, url)
+	}
+
+	report, err = h.reportService.AddPhotos(r.Context(), industryID, id, urls)
+	if err != nil {
+		h.logger.Error("erro ao anexar fotos da avaria",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, report)
+}
+
+// Approve godoc
+// @Summary Aprova avaria
+// @Description Aprova a avaria e move as chapas de DISPONIVEL para INATIVO com motivo AVARIA no razão
+// @Tags damage-reports
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do relatório"
+// @Param body body entity.ApproveDamageReportInput true "Observações da aprovação"
+// @Success 200 {object} entity.DamageReport
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/damage-reports/{id}/approve [post]
+func (h *DamageReportHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do relatório é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.ApproveDamageReportInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	report, err := h.reportService.Approve(r.Context(), industryID, id, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao aprovar avaria",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, report)
+}
+
+// Reject godoc
+// @Summary Rejeita avaria
+// @Description Rejeita a avaria sem alterar o estoque do lote
+// @Tags damage-reports
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do relatório"
+// @Param body body entity.RejectDamageReportInput true "Motivo da rejeição"
+// @Success 200 {object} entity.DamageReport
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/damage-reports/{id}/reject [post]
+func (h *DamageReportHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do relatório é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.RejectDamageReportInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	report, err := h.reportService.Reject(r.Context(), industryID, id, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao rejeitar avaria",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, report)
+}
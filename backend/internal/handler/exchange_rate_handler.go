@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/spreadsheet"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// ExchangeRateHandler gerencia requisições de cotações de moeda
+type ExchangeRateHandler struct {
+	exchangeRateService service.ExchangeRateService
+	validator           *validator.Validator
+	logger              *zap.Logger
+}
+
+// NewExchangeRateHandler cria uma nova instância de ExchangeRateHandler
+func NewExchangeRateHandler(
+	exchangeRateService service.ExchangeRateService,
+	validator *validator.Validator,
+	logger *zap.Logger,
+) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		exchangeRateService: exchangeRateService,
+		validator:           validator,
+		logger:              logger,
+	}
+}
+
+// List godoc
+// @Summary Lista cotações
+// @Description Lista as cotações de moeda da indústria, mais recentes primeiro
+// @Tags exchange-rates
+// @Produce json
+// @Param fromCurrency query string false "Moeda de origem (BRL, USD, EUR)"
+// @Param toCurrency query string false "Moeda de destino (BRL, USD, EUR)"
+// @Param startDate query string false "Vigência inicial (YYYY-MM-DD)"
+// @Param endDate query string false "Vigência final (YYYY-MM-DD)"
+// @Success 200 {array} entity.ExchangeRate
+// @Router /api/exchange-rates [get]
+func (h *ExchangeRateHandler) List(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var filters entity.ExchangeRateFilters
+	query := r.URL.Query()
+	if v := query.Get("fromCurrency"); v != "" {
+		currency := entity.Currency(v)
+		filters.FromCurrency = &currency
+	}
+	if v := query.Get("toCurrency"); v != "" {
+		currency := entity.Currency(v)
+		filters.ToCurrency = &currency
+	}
+	if v := query.Get("startDate"); v != "" {
+		filters.StartDate = &v
+	}
+	if v := query.Get("endDate"); v != "" {
+		filters.EndDate = &v
+	}
+
+	rates, err := h.exchangeRateService.List(r.Context(), industryID, filters)
+	if err != nil {
+		h.logger.Error("erro ao listar cotações", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, rates)
+}
+
+// Upsert godoc
+// @Summary Cadastra cotação
+// @Description Cadastra a cotação de um par de moedas (1 fromCurrency = rate toCurrency); substitui a cotação existente na mesma data
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param body body entity.UpsertExchangeRateInput true "Par, taxa e data de vigência"
+// @Success 201 {object} entity.ExchangeRate
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/exchange-rates [post]
+func (h *ExchangeRateHandler) Upsert(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.UpsertExchangeRateInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	rate, err := h.exchangeRateService.Upsert(r.Context(), industryID, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao salvar cotação", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, rate)
+}
+
+// Import godoc
+// @Summary Importa cotações
+// @Description Importa cotações de arquivo CSV ou XLSX com colunas fromCurrency, toCurrency, rate e effectiveDate. Linhas inválidas são ignoradas e retornadas com os erros
+// @Tags exchange-rates
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Arquivo (.csv ou .xlsx)"
+// @Success 200 {object} entity.ExchangeRateImportResult
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/exchange-rates/import [post]
+func (h *ExchangeRateHandler) Import(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportFileSize)
+
+	if err := r.ParseMultipartForm(maxImportFileSize); err != nil {
+		h.logger.Error("erro ao parsear multipart form", zap.Error(err))
+		response.BadRequest(w, "Arquivo muito grande. Máximo 10MB", nil)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		response.BadRequest(w, "Arquivo é obrigatório", nil)
+		return
+	}
+	defer file.Close()
+
+	format, err := spreadsheet.FormatFromFilename(header.Filename)
+	if err != nil {
+		response.BadRequest(w, err.Error(), nil)
+		return
+	}
+
+	records, err := spreadsheet.ReadRecords(file, format)
+	if err != nil {
+		response.BadRequest(w, err.Error(), nil)
+		return
+	}
+
+	rows, err := entity.ParseExchangeRateRecords(records)
+	if err != nil {
+		response.BadRequest(w, err.Error(), nil)
+		return
+	}
+
+	// Validação estrutural de cada linha (mesmas regras do cadastro individual)
+	for i := range rows {
+		if !rows[i].IsValid() {
+			continue
+		}
+		if err := h.validator.Validate(rows[i].Input); err != nil {
+			if appErr, ok := err.(*errors.AppError); ok && len(appErr.Details) > 0 {
+				for field, message := range appErr.Details {
+					rows[i].AddError(fmt.Sprintf("%s: %v", field, message))
+				}
+			} else {
+				rows[i].AddError(err.Error())
+			}
+		}
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	result, err := h.exchangeRateService.Import(r.Context(), industryID, userID, rows)
+	if err != nil {
+		h.logger.Error("erro ao importar cotações",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// Delete godoc
+// @Summary Exclui cotação
+// @Description Remove uma cotação cadastrada
+// @Tags exchange-rates
+// @Produce json
+// @Param id path string true "ID da cotação"
+// @Success 200 {object} map[string]bool
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/exchange-rates/{id} [delete]
+func (h *ExchangeRateHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID da cotação é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	if err := h.exchangeRateService.Delete(r.Context(), industryID, id); err != nil {
+		h.logger.Error("erro ao excluir cotação",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
+
+// parseCurrencyParam lê o parâmetro opcional ?currency= usado para converter preços exibidos
+func parseCurrencyParam(r *http.Request) (*entity.Currency, bool) {
+	value := r.URL.Query().Get("currency")
+	if value == "" {
+		return nil, true
+	}
+
+	currency := entity.Currency(strings.ToUpper(value))
+	if !currency.IsValid() {
+		return nil, false
+	}
+
+	return &currency, true
+}
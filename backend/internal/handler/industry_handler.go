@@ -90,6 +90,10 @@ type UpdateConfigInput struct {
 	AddressZipCode           *string                        `json:"addressZipCode" validate:"omitempty,max=20"`
 	SocialLinks              *entity.SocialLinkList         `json:"socialLinks" validate:"omitempty,dive"`
 	PortfolioDisplaySettings *entity.PortfolioDisplaySettings `json:"portfolioDisplaySettings"`
+	BatchCodeSettings        *entity.BatchCodeSettings        `json:"batchCodeSettings"`
+	BaseCurrency             *entity.Currency                 `json:"baseCurrency" validate:"omitempty,oneof=BRL USD EUR"`
+	StockAlertSettings       *entity.StockAlertSettings       `json:"stockAlertSettings"`
+	ReservationPolicy        *entity.ReservationPolicy        `json:"reservationPolicy"`
 	IsPublic                 *bool                          `json:"isPublic"`
 }
 
@@ -336,6 +340,46 @@ func (h *IndustryHandler) UpdateConfig(w http.ResponseWriter, r *http.Request) {
 	if input.PortfolioDisplaySettings != nil {
 		industry.PortfolioDisplaySettings = *input.PortfolioDisplaySettings
 	}
+	if input.BatchCodeSettings != nil {
+		settings := *input.BatchCodeSettings
+		if settings.PrefixSource == "" {
+			settings.PrefixSource = entity.BatchCodePrefixMaterial
+		}
+		if settings.PrefixSource == entity.BatchCodePrefixFixo {
+			if settings.FixedPrefix == nil {
+				response.BadRequest(w, "Prefixo fixo é obrigatório quando a origem do prefixo é FIXO", nil)
+				return
+			}
+			prefix := strings.ToUpper(*settings.FixedPrefix)
+			settings.FixedPrefix = &prefix
+		}
+		industry.BatchCodeSettings = settings
+	}
+	if input.BaseCurrency != nil {
+		industry.BaseCurrency = *input.BaseCurrency
+	}
+	if input.StockAlertSettings != nil {
+		seen := make(map[string]bool, len(input.StockAlertSettings.ProductOverrides))
+		for _, override := range input.StockAlertSettings.ProductOverrides {
+			if seen[override.ProductID] {
+				response.BadRequest(w, "Produto informado mais de uma vez nos limites de estoque", nil)
+				return
+			}
+			seen[override.ProductID] = true
+		}
+		industry.StockAlertSettings = *input.StockAlertSettings
+	}
+	if input.ReservationPolicy != nil {
+		policy := *input.ReservationPolicy
+		if policy.MaxExpiryDays > 0 && policy.MaxExpiryDays < policy.DefaultExpiryDays {
+			response.BadRequest(w, "Prazo máximo da reserva deve ser maior ou igual ao prazo padrão", nil)
+			return
+		}
+		if policy.AutoApprove.TrustedBrokerIDs == nil {
+			policy.AutoApprove.TrustedBrokerIDs = []string{}
+		}
+		industry.ReservationPolicy = policy
+	}
 
 	// Salvar
 	if err := h.industryRepo.Update(ctx, industry); err != nil {
@@ -0,0 +1,299 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// InventoryCountHandler gerencia requisições de inventário físico (contagem cíclica)
+type InventoryCountHandler struct {
+	countService service.InventoryCountService
+	validator    *validator.Validator
+	logger       *zap.Logger
+}
+
+// NewInventoryCountHandler cria uma nova instância de InventoryCountHandler
+func NewInventoryCountHandler(
+	countService service.InventoryCountService,
+	validator *validator.Validator,
+	logger *zap.Logger,
+) *InventoryCountHandler {
+	return &InventoryCountHandler{
+		countService: countService,
+		validator:    validator,
+		logger:       logger,
+	}
+}
+
+// List godoc
+// @Summary Lista inventários
+// @Description Lista sessões de inventário físico com o resumo da contagem
+// @Tags inventory-counts
+// @Produce json
+// @Param status query string false "Filtrar por status (ABERTA, APROVADA, CANCELADA)"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Itens por página"
+// @Success 200 {object} entity.InventoryCountListResponse
+// @Router /api/inventory-counts [get]
+func (h *InventoryCountHandler) List(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	filters := entity.InventoryCountFilters{
+		Page:  1,
+		Limit: 20,
+	}
+
+	if status := r.URL.Query().Get("status"); status != "" {
+		s := entity.InventoryCountStatus(status)
+		if s.IsValid() {
+			filters.Status = &s
+		}
+	}
+
+	if page := r.URL.Query().Get("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+
+	result, err := h.countService.List(r.Context(), industryID, filters)
+	if err != nil {
+		h.logger.Error("erro ao listar inventários", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetByID godoc
+// @Summary Busca inventário por ID
+// @Description Retorna a sessão com os lotes congelados, quantidades contadas e divergências
+// @Tags inventory-counts
+// @Produce json
+// @Param id path string true "ID do inventário"
+// @Success 200 {object} entity.InventoryCount
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/inventory-counts/{id} [get]
+func (h *InventoryCountHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do inventário é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	count, err := h.countService.GetByID(r.Context(), industryID, id)
+	if err != nil {
+		h.logger.Error("erro ao buscar inventário",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, count)
+}
+
+// Create godoc
+// @Summary Abre inventário
+// @Description Abre uma sessão de inventário congelando os lotes ativos do escopo (produto, material ou todos)
+// @Tags inventory-counts
+// @Accept json
+// @Produce json
+// @Param body body entity.CreateInventoryCountInput true "Escopo do inventário"
+// @Success 201 {object} entity.InventoryCount
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/inventory-counts [post]
+func (h *InventoryCountHandler) Create(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.CreateInventoryCountInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	count, err := h.countService.Create(r.Context(), industryID, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao abrir inventário", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, count)
+}
+
+// RecordCounts godoc
+// @Summary Registra contagens
+// @Description Grava as quantidades de chapas contadas por lote na sessão aberta
+// @Tags inventory-counts
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do inventário"
+// @Param body body entity.RecordInventoryCountInput true "Quantidades contadas"
+// @Success 200 {object} entity.InventoryCount
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/inventory-counts/{id}/items [put]
+func (h *InventoryCountHandler) RecordCounts(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do inventário é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.RecordInventoryCountInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	count, err := h.countService.RecordCounts(r.Context(), industryID, id, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao registrar contagens",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, count)
+}
+
+// Approve godoc
+// @Summary Aprova inventário
+// @Description Aplica as divergências contadas como ajustes de disponibilidade (com motivo no razão) e encerra a sessão
+// @Tags inventory-counts
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do inventário"
+// @Param body body entity.ApproveInventoryCountInput true "Motivo e observações do ajuste"
+// @Success 200 {object} entity.InventoryCount
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/inventory-counts/{id}/approve [post]
+func (h *InventoryCountHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do inventário é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.ApproveInventoryCountInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	count, err := h.countService.Approve(r.Context(), industryID, id, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao aprovar inventário",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, count)
+}
+
+// Cancel godoc
+// @Summary Cancela inventário
+// @Description Encerra a sessão de inventário sem aplicar ajustes
+// @Tags inventory-counts
+// @Produce json
+// @Param id path string true "ID do inventário"
+// @Success 200 {object} entity.InventoryCount
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/inventory-counts/{id}/cancel [post]
+func (h *InventoryCountHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do inventário é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	count, err := h.countService.Cancel(r.Context(), industryID, id, userID)
+	if err != nil {
+		h.logger.Error("erro ao cancelar inventário",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, count)
+}
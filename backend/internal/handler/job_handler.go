@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"go.uber.org/zap"
+)
+
+// JobHandler gerencia os jobs agendados em segundo plano
+type JobHandler struct {
+	scheduler service.JobScheduler
+	logger    *zap.Logger
+}
+
+// NewJobHandler cria uma nova instância de JobHandler
+func NewJobHandler(scheduler service.JobScheduler, logger *zap.Logger) *JobHandler {
+	return &JobHandler{
+		scheduler: scheduler,
+		logger:    logger,
+	}
+}
+
+// List godoc
+// @Summary Lista os jobs agendados
+// @Description Lista os jobs em segundo plano com intervalo, última execução e próxima execução prevista
+// @Tags jobs
+// @Produce json
+// @Success 200 {array} entity.ScheduledJob
+// @Router /api/jobs [get]
+func (h *JobHandler) List(w http.ResponseWriter, r *http.Request) {
+	jobs, err := h.scheduler.ListJobs(r.Context())
+	if err != nil {
+		h.logger.Error("erro ao listar jobs", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, jobs)
+}
+
+// ListRuns godoc
+// @Summary Lista execuções de um job
+// @Description Histórico de execuções do job (mais recentes primeiro)
+// @Tags jobs
+// @Produce json
+// @Param name path string true "Nome do job"
+// @Param limit query int false "Quantidade de execuções (padrão 20, máximo 100)"
+// @Success 200 {array} entity.JobRun
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/jobs/{name}/runs [get]
+func (h *JobHandler) ListRuns(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	limit := 20
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if v, err := strconv.Atoi(l); err == nil && v > 0 && v <= 100 {
+			limit = v
+		}
+	}
+
+	runs, err := h.scheduler.ListRuns(r.Context(), name, limit)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, runs)
+}
+
+// Trigger godoc
+// @Summary Executa um job manualmente
+// @Description Dispara a execução do job em segundo plano; acompanhe o resultado em /api/jobs/{name}/runs
+// @Tags jobs
+// @Produce json
+// @Param name path string true "Nome do job"
+// @Success 202 {object} entity.JobRun
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/jobs/{name}/run [post]
+func (h *JobHandler) Trigger(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+	userID := middleware.GetUserID(r.Context())
+
+	run, err := h.scheduler.Trigger(r.Context(), name, userID)
+	if err != nil {
+		h.logger.Error("erro ao disparar job",
+			zap.String("job", name),
+			zap.String("userId", userID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusAccepted, run)
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// PackingListHandler gerencia os romaneios de carga das vendas
+type PackingListHandler struct {
+	packingListService service.PackingListService
+	validator          *validator.Validator
+	logger             *zap.Logger
+}
+
+// NewPackingListHandler cria uma nova instância de PackingListHandler
+func NewPackingListHandler(packingListService service.PackingListService, validator *validator.Validator, logger *zap.Logger) *PackingListHandler {
+	return &PackingListHandler{
+		packingListService: packingListService,
+		validator:          validator,
+		logger:             logger,
+	}
+}
+
+// GetBySaleID godoc
+// @Summary Busca romaneio da venda
+// @Description Retorna o romaneio (itens, totais, transportadora) e as URLs do PDF e do JSON armazenados
+// @Tags sales-history
+// @Produce json
+// @Param id path string true "ID da venda"
+// @Success 200 {object} entity.PackingList
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/sales-history/{id}/packing-list [get]
+func (h *PackingListHandler) GetBySaleID(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	saleID := chi.URLParam(r, "id")
+	packingList, err := h.packingListService.GetBySaleID(r.Context(), industryID, saleID)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, packingList)
+}
+
+// DownloadPDF godoc
+// @Summary Baixa o PDF do romaneio
+// @Description Redireciona para o PDF do romaneio da venda no storage
+// @Tags sales-history
+// @Param id path string true "ID da venda"
+// @Success 302
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/sales-history/{id}/packing-list/pdf [get]
+func (h *PackingListHandler) DownloadPDF(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	saleID := chi.URLParam(r, "id")
+	packingList, err := h.packingListService.GetBySaleID(r.Context(), industryID, saleID)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, packingList.PDFURL, http.StatusFound)
+}
+
+// Generate godoc
+// @Summary Gera novamente o romaneio da venda
+// @Description Regera o PDF e o JSON do romaneio; sem transportadora/observações, mantém as do romaneio anterior
+// @Tags sales-history
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da venda"
+// @Param body body entity.GeneratePackingListInput true "Transportadora e observações"
+// @Success 200 {object} entity.PackingList
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/sales-history/{id}/packing-list [post]
+func (h *PackingListHandler) Generate(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.GeneratePackingListInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	saleID := chi.URLParam(r, "id")
+	userID := middleware.GetUserID(r.Context())
+	packingList, err := h.packingListService.Generate(r.Context(), industryID, saleID, &userID, input)
+	if err != nil {
+		h.logger.Error("erro ao gerar romaneio",
+			zap.String("saleId", saleID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, packingList)
+}
@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// PriceHandler gerencia requisições de histórico e agendamento de preços
+type PriceHandler struct {
+	priceService service.PriceService
+	validator    *validator.Validator
+	logger       *zap.Logger
+}
+
+// NewPriceHandler cria uma nova instância de PriceHandler
+func NewPriceHandler(
+	priceService service.PriceService,
+	validator *validator.Validator,
+	logger *zap.Logger,
+) *PriceHandler {
+	return &PriceHandler{
+		priceService: priceService,
+		validator:    validator,
+		logger:       logger,
+	}
+}
+
+// GetProductHistory godoc
+// @Summary Histórico de preços do produto
+// @Description Lista as alterações de preço base do produto, incluindo agendamentos
+// @Tags prices
+// @Produce json
+// @Param id path string true "ID do produto"
+// @Success 200 {array} entity.PriceChange
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/products/{id}/price-history [get]
+func (h *PriceHandler) GetProductHistory(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do produto é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	history, err := h.priceService.GetProductHistory(r.Context(), industryID, id)
+	if err != nil {
+		h.logger.Error("erro ao buscar histórico de preços do produto",
+			zap.String("productId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, history)
+}
+
+// GetBatchHistory godoc
+// @Summary Histórico de preços do lote
+// @Description Lista as alterações de preço do lote, incluindo agendamentos
+// @Tags prices
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Success 200 {array} entity.PriceChange
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/batches/{id}/price-history [get]
+func (h *PriceHandler) GetBatchHistory(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do lote é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	history, err := h.priceService.GetBatchHistory(r.Context(), industryID, id)
+	if err != nil {
+		h.logger.Error("erro ao buscar histórico de preços do lote",
+			zap.String("batchId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, history)
+}
+
+// ScheduleProductPrice godoc
+// @Summary Agenda preço do produto
+// @Description Agenda uma alteração futura do preço base do produto
+// @Tags prices
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do produto"
+// @Param body body entity.SchedulePriceChangeInput true "Novo preço e data de vigência"
+// @Success 201 {object} entity.PriceChange
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/products/{id}/price-schedules [post]
+func (h *PriceHandler) ScheduleProductPrice(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do produto é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.SchedulePriceChangeInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	change, err := h.priceService.ScheduleProductPrice(r.Context(), industryID, id, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao agendar preço do produto",
+			zap.String("productId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, change)
+}
+
+// ScheduleBatchPrice godoc
+// @Summary Agenda preço do lote
+// @Description Agenda uma alteração futura do preço do lote
+// @Tags prices
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do lote"
+// @Param body body entity.SchedulePriceChangeInput true "Novo preço e data de vigência"
+// @Success 201 {object} entity.PriceChange
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/batches/{id}/price-schedules [post]
+func (h *PriceHandler) ScheduleBatchPrice(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do lote é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.SchedulePriceChangeInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	change, err := h.priceService.ScheduleBatchPrice(r.Context(), industryID, id, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao agendar preço do lote",
+			zap.String("batchId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, change)
+}
+
+// CancelSchedule godoc
+// @Summary Cancela preço agendado
+// @Description Cancela uma alteração de preço que ainda não foi aplicada
+// @Tags prices
+// @Param id path string true "ID da alteração de preço"
+// @Success 200 {object} map[string]bool
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/price-schedules/{id} [delete]
+func (h *PriceHandler) CancelSchedule(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID da alteração de preço é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	if err := h.priceService.CancelScheduled(r.Context(), industryID, id); err != nil {
+		h.logger.Error("erro ao cancelar preço agendado",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
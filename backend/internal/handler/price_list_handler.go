@@ -0,0 +1,333 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// PriceListHandler gerencia requisições de tabelas de preço
+type PriceListHandler struct {
+	priceListService service.PriceListService
+	validator        *validator.Validator
+	logger           *zap.Logger
+}
+
+// NewPriceListHandler cria uma nova instância de PriceListHandler
+func NewPriceListHandler(
+	priceListService service.PriceListService,
+	validator *validator.Validator,
+	logger *zap.Logger,
+) *PriceListHandler {
+	return &PriceListHandler{
+		priceListService: priceListService,
+		validator:        validator,
+		logger:           logger,
+	}
+}
+
+// List godoc
+// @Summary Lista tabelas de preço
+// @Description Lista tabelas de preço da indústria com regras e quantidade de atribuições
+// @Tags price-lists
+// @Produce json
+// @Success 200 {array} entity.PriceList
+// @Router /api/price-lists [get]
+func (h *PriceListHandler) List(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	lists, err := h.priceListService.List(r.Context(), industryID)
+	if err != nil {
+		h.logger.Error("erro ao listar tabelas de preço", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, lists)
+}
+
+// GetByID godoc
+// @Summary Busca tabela de preço por ID
+// @Description Retorna a tabela de preço com suas regras
+// @Tags price-lists
+// @Produce json
+// @Param id path string true "ID da tabela"
+// @Success 200 {object} entity.PriceList
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/price-lists/{id} [get]
+func (h *PriceListHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID da tabela é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	list, err := h.priceListService.GetByID(r.Context(), industryID, id)
+	if err != nil {
+		h.logger.Error("erro ao buscar tabela de preço",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, list)
+}
+
+// Create godoc
+// @Summary Cria tabela de preço
+// @Description Cria uma tabela de preço com regras percentuais ou de preço fixo por produto, material ou geral
+// @Tags price-lists
+// @Accept json
+// @Produce json
+// @Param body body entity.CreatePriceListInput true "Dados da tabela"
+// @Success 201 {object} entity.PriceList
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/price-lists [post]
+func (h *PriceListHandler) Create(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.CreatePriceListInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	list, err := h.priceListService.Create(r.Context(), industryID, input)
+	if err != nil {
+		h.logger.Error("erro ao criar tabela de preço", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, list)
+}
+
+// Update godoc
+// @Summary Atualiza tabela de preço
+// @Description Atualiza a tabela; quando rules é informado, substitui todas as regras
+// @Tags price-lists
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da tabela"
+// @Param body body entity.UpdatePriceListInput true "Dados a atualizar"
+// @Success 200 {object} entity.PriceList
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/price-lists/{id} [put]
+func (h *PriceListHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID da tabela é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.UpdatePriceListInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	list, err := h.priceListService.Update(r.Context(), industryID, id, input)
+	if err != nil {
+		h.logger.Error("erro ao atualizar tabela de preço",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, list)
+}
+
+// Delete godoc
+// @Summary Exclui tabela de preço
+// @Description Exclui a tabela de preço, suas regras e atribuições
+// @Tags price-lists
+// @Produce json
+// @Param id path string true "ID da tabela"
+// @Success 200 {object} map[string]bool
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/price-lists/{id} [delete]
+func (h *PriceListHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID da tabela é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	if err := h.priceListService.Delete(r.Context(), industryID, id); err != nil {
+		h.logger.Error("erro ao excluir tabela de preço",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
+
+// ListAssignments godoc
+// @Summary Lista atribuições da tabela
+// @Description Lista brokers, vendedores internos e clientes que usam a tabela de preço
+// @Tags price-lists
+// @Produce json
+// @Param id path string true "ID da tabela"
+// @Success 200 {array} entity.PriceListAssignment
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/price-lists/{id}/assignments [get]
+func (h *PriceListHandler) ListAssignments(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID da tabela é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	assignments, err := h.priceListService.ListAssignments(r.Context(), industryID, id)
+	if err != nil {
+		h.logger.Error("erro ao listar atribuições da tabela de preço",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, assignments)
+}
+
+// Assign godoc
+// @Summary Atribui tabela de preço
+// @Description Atribui a tabela a um broker, vendedor interno ou cliente, substituindo a tabela anterior
+// @Tags price-lists
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da tabela"
+// @Param body body entity.AssignPriceListInput true "Usuário ou cliente"
+// @Success 201 {object} entity.PriceListAssignment
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/price-lists/{id}/assignments [post]
+func (h *PriceListHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID da tabela é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.AssignPriceListInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	assignment, err := h.priceListService.Assign(r.Context(), industryID, id, input)
+	if err != nil {
+		h.logger.Error("erro ao atribuir tabela de preço",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, assignment)
+}
+
+// Unassign godoc
+// @Summary Remove atribuição de tabela
+// @Description Remove a atribuição; o usuário ou cliente volta a usar o preço do lote
+// @Tags price-lists
+// @Produce json
+// @Param id path string true "ID da tabela"
+// @Param assignmentId path string true "ID da atribuição"
+// @Success 200 {object} map[string]bool
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/price-lists/{id}/assignments/{assignmentId} [delete]
+func (h *PriceListHandler) Unassign(w http.ResponseWriter, r *http.Request) {
+	assignmentID := chi.URLParam(r, "assignmentId")
+	if assignmentID == "" {
+		response.BadRequest(w, "ID da atribuição é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	if err := h.priceListService.Unassign(r.Context(), industryID, assignmentID); err != nil {
+		h.logger.Error("erro ao remover atribuição de tabela de preço",
+			zap.String("assignmentId", assignmentID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
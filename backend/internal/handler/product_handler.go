@@ -38,7 +38,7 @@ func NewProductHandler(
 // @Description Lista produtos com filtros e paginação
 // @Tags products
 // @Produce json
-// @Param search query string false "Buscar por nome"
+// @Param search query string false "Busca textual sem acentos (nome, SKU, material, acabamento, descrição), ordenada por relevância"
 // @Param material query string false "Filtrar por material"
 // @Param page query int false "Número da página"
 // @Param limit query int false "Itens por página"
@@ -49,6 +49,7 @@ func NewPublicHandler(
 // @Tags public
 // @Produce json
 // @Param slug path string true "Slug do link"
+// @Param currency query string false "Moeda para exibição dos preços (BRL, USD, EUR)"
 // @Success 200 {object} entity.SalesLink
 // @Failure 404 {object} response.ErrorResponse
 // @Router /api/public/links/{slug} [get]
@@ -59,8 +60,14 @@ func (h *PublicHandler) GetLinkBySlug(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	currency, ok := parseCurrencyParam(r)
+	if !ok {
+		response.BadRequest(w, "Moeda inválida. Use BRL, USD ou EUR", nil)
+		return
+	}
+
 	// Buscar link com dados públicos sanitizados
-	publicLink, err := h.salesLinkService.GetPublicBySlug(r.Context(), slug)
+	publicLink, err := h.salesLinkService.GetPublicBySlug(r.Context(), slug, currency)
 	if err != nil {
 		h.logger.Warn("link não encontrado",
 			zap.String("slug", slug),
@@ -193,10 +200,13 @@ func (h *PublicHandler) GetPublicDepositBySlug(w http.ResponseWriter, r *http.Re
 
 // GetPublicDepositBatches godoc
 // @Summary Lista lotes públicos de um depósito
-// @Description Retorna lotes públicos de um depósito por slug
+// @Description Retorna lotes públicos de um depósito por slug, com filtro opcional por depósito físico/posição
 // @Tags public
 // @Produce json
 // @Param slug path string true "Slug do depósito"
+// @Param warehouse query string false "Código do depósito físico"
+// @Param location query string false "Código da posição no depósito"
+// @Param search query string false "Busca textual sem acentos (código, produto, material, acabamento, pedreira)"
 // @Success 200 {array} entity.PublicBatch
 // @Failure 404 {object} response.ErrorResponse
 // @Router /api/public/deposits/{slug}/batches [get]
@@ -207,7 +217,18 @@ func (h *PublicHandler) GetPublicDepositBatches(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	batches, err := h.batchRepo.FindPublicBatchesByIndustrySlug(r.Context(), slug)
+	var filters entity.PublicBatchFilters
+	if warehouse := r.URL.Query().Get("warehouse"); warehouse != "" {
+		filters.WarehouseCode = &warehouse
+	}
+	if location := r.URL.Query().Get("location"); location != "" {
+		filters.LocationCode = &location
+	}
+	if search := r.URL.Query().Get("search"); search != "" {
+		filters.Search = &search
+	}
+
+	batches, err := h.batchRepo.FindPublicBatchesByIndustrySlug(r.Context(), slug, filters)
 	if err != nil {
 		h.logger.Error("erro ao buscar lotes públicos",
 			zap.String("slug", slug),
@@ -0,0 +1,444 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// RemnantHandler gerencia requisições do estoque de retalhos
+type RemnantHandler struct {
+	remnantService service.RemnantService
+	storageService service.StorageService
+	validator      *validator.Validator
+	logger         *zap.Logger
+}
+
+// NewRemnantHandler cria uma nova instância de RemnantHandler
+func NewRemnantHandler(
+	remnantService service.RemnantService,
+	storageService service.StorageService,
+	validator *validator.Validator,
+	logger *zap.Logger,
+) *RemnantHandler {
+	return &RemnantHandler{
+		remnantService: remnantService,
+		storageService: storageService,
+		validator:      validator,
+		logger:         logger,
+	}
+}
+
+// List godoc
+// @Summary Lista retalhos
+// @Description Busca retalhos da indústria por lote, produto, material e dimensões mínimas
+// @Tags remnants
+// @Produce json
+// @Param sourceBatchId query string false "Filtrar por lote de origem"
+// @Param productId query string false "Filtrar por produto"
+// @Param material query string false "Filtrar por material"
+// @Param status query string false "Filtrar por status (DISPONIVEL, RESERVADO, VENDIDO, INATIVO)"
+// @Param search query string false "Buscar por código do retalho, do lote ou nome do produto"
+// @Param minHeight query number false "Altura mínima em cm (aceita a peça em qualquer orientação)"
+// @Param minWidth query number false "Largura mínima em cm (aceita a peça em qualquer orientação)"
+// @Param minArea query number false "Área mínima em m²"
+// @Param maxArea query number false "Área máxima em m²"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Itens por página"
+// @Success 200 {object} entity.RemnantListResponse
+// @Router /api/remnants [get]
+func (h *RemnantHandler) List(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	query := r.URL.Query()
+	filters := entity.RemnantFilters{
+		Page:  1,
+		Limit: 20,
+	}
+
+	if sourceBatchID := query.Get("sourceBatchId"); sourceBatchID != "" {
+		filters.SourceBatchID = &sourceBatchID
+	}
+
+	if productID := query.Get("productId"); productID != "" {
+		filters.ProductID = &productID
+	}
+
+	if material := query.Get("material"); material != "" {
+		m := entity.MaterialType(strings.ToUpper(material))
+		if m.IsValid() {
+			filters.Material = &m
+		}
+	}
+
+	if status := query.Get("status"); status != "" {
+		s := entity.BatchStatus(status)
+		if s.IsValid() {
+			filters.Status = &s
+		}
+	}
+
+	if search := query.Get("search"); search != "" {
+		filters.Search = &search
+	}
+
+	filters.MinHeight = parsePositiveFloat(query.Get("minHeight"))
+	filters.MinWidth = parsePositiveFloat(query.Get("minWidth"))
+	filters.MinArea = parsePositiveFloat(query.Get("minArea"))
+	filters.MaxArea = parsePositiveFloat(query.Get("maxArea"))
+
+	if page := query.Get("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+
+	result, err := h.remnantService.List(r.Context(), industryID, filters)
+	if err != nil {
+		h.logger.Error("erro ao listar retalhos", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
+
+// GetByID godoc
+// @Summary Busca retalho por ID
+// @Description Retorna o retalho com as fotos e o lote de origem
+// @Tags remnants
+// @Produce json
+// @Param id path string true "ID do retalho"
+// @Success 200 {object} entity.Remnant
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/remnants/{id} [get]
+func (h *RemnantHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do retalho é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	remnant, err := h.remnantService.GetByID(r.Context(), industryID, id)
+	if err != nil {
+		h.logger.Error("erro ao buscar retalho",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, remnant)
+}
+
+// Create godoc
+// @Summary Cadastra retalho
+// @Description Cadastra um retalho vinculado ao lote de origem; espessura, preço e moeda seguem o lote quando omitidos
+// @Tags remnants
+// @Accept json
+// @Produce json
+// @Param body body entity.CreateRemnantInput true "Dados do retalho"
+// @Success 201 {object} entity.Remnant
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/remnants [post]
+func (h *RemnantHandler) Create(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.CreateRemnantInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+
+	remnant, err := h.remnantService.Create(r.Context(), industryID, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao cadastrar retalho", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, remnant)
+}
+
+// Update godoc
+// @Summary Atualiza retalho
+// @Description Atualiza dimensões, preço ou disponibilidade de um retalho não reservado
+// @Tags remnants
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do retalho"
+// @Param body body entity.UpdateRemnantInput true "Dados a atualizar"
+// @Success 200 {object} entity.Remnant
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/remnants/{id} [put]
+func (h *RemnantHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do retalho é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.UpdateRemnantInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	remnant, err := h.remnantService.Update(r.Context(), industryID, id, input)
+	if err != nil {
+		h.logger.Error("erro ao atualizar retalho",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, remnant)
+}
+
+// Delete godoc
+// @Summary Exclui retalho
+// @Description Exclui um retalho sem reservas ou vendas registradas
+// @Tags remnants
+// @Produce json
+// @Param id path string true "ID do retalho"
+// @Success 200 {object} map[string]bool
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/remnants/{id} [delete]
+func (h *RemnantHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do retalho é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	if err := h.remnantService.Delete(r.Context(), industryID, id); err != nil {
+		h.logger.Error("erro ao excluir retalho",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
+
+// UploadPhotos godoc
+// @Summary Envia fotos do retalho
+// @Description Faz upload de fotos para um retalho
+// @Tags remnants
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID do retalho"
+// @Param photos formData file true "Fotos do retalho"
+// @Success 201 {object} entity.Remnant
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/remnants/{id}/photos [post]
+func (h *RemnantHandler) UploadPhotos(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do retalho é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	// Limitar tamanho do request
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize*maxFilesPerBatch)
+
+	if err := r.ParseMultipartForm(maxUploadSize * maxFilesPerBatch); err != nil {
+		response.BadRequest(w, "Arquivo muito grande. Máximo 5MB por arquivo", nil)
+		return
+	}
+
+	// Verificar se o retalho pertence à indústria antes do upload
+	if _, err := h.remnantService.GetByID(r.Context(), industryID, id); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	files := r.MultipartForm.File["photos"]
+	if len(files) == 0 {
+		response.BadRequest(w, "Nenhum arquivo enviado", nil)
+		return
+	}
+
+	if len(files) > maxFilesPerBatch {
+		response.BadRequest(w, "Máximo 10 arquivos por upload", nil)
+		return
+	}
+
+	var urls []string
+	for _, fileHeader := range files {
+		// Sanitizar nome do arquivo para prevenir path traversal
+		safeFilename := sanitizeUploadFilename(fileHeader.Filename)
+
+		if !isAllowedExtension(safeFilename) {
+			response.BadRequest(w, "Extensão de arquivo inválida. Use .jpg, .jpeg, .png ou .webp", nil)
+			return
+		}
+
+		if fileHeader.Size > maxUploadSize {
+			response.BadRequest(w, "Arquivo muito grande. Máximo 5MB por arquivo", nil)
+			return
+		}
+
+		contentType := fileHeader.Header.Get("Content-Type")
+		if !h.storageService.ValidateFileType(contentType, allowedContentTypes) {
+			response.BadRequest(w, "Formato inválido. Use JPEG, PNG ou WebP", nil)
+			return
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			h.logger.Error("erro ao abrir arquivo",
+				zap.String("filename", safeFilename),
+				zap.Error(err),
+			)
+			response.BadRequest(w, "Erro ao processar arquivo", nil)
+			return
+		}
+
+		url, err := h.storageService.UploadRemnantPhoto(
+			r.Context(),
+			id,
+			file,
+			safeFilename,
+			contentType,
+			fileHeader.Size,
+		)
+		file.Close()
+		if err != nil {
+			h.logger.Error("erro ao fazer upload da foto do retalho",
+				zap.String("filename", safeFilename),
+				zap.Error(err),
+			)
+			response.HandleError(w, err)
+			return
+		}
+
+		urls = append(urls, url)
+	}
+
+	remnant, err := h.remnantService.AddPhotos(r.Context(), industryID, id, urls)
+	if err != nil {
+		h.logger.Error("erro ao anexar fotos do retalho",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, remnant)
+}
+
+// DeletePhoto godoc
+// @Summary Remove foto do retalho
+// @Description Remove uma foto do retalho
+// @Tags remnants
+// @Produce json
+// @Param id path string true "ID do retalho"
+// @Param photoId path string true "ID da foto"
+// @Success 200 {object} map[string]bool
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/remnants/{id}/photos/{photoId} [delete]
+func (h *RemnantHandler) DeletePhoto(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	photoID := chi.URLParam(r, "photoId")
+	if id == "" || photoID == "" {
+		response.BadRequest(w, "ID do retalho e da foto são obrigatórios", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	if err := h.remnantService.RemovePhoto(r.Context(), industryID, id, photoID); err != nil {
+		h.logger.Error("erro ao remover foto do retalho",
+			zap.String("id", id),
+			zap.String("photoId", photoID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
+
+// parsePositiveFloat converte um parâmetro de query numérico, ignorando valores inválidos
+func parsePositiveFloat(value string) *float64 {
+	if value == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f <= 0 {
+		return nil
+	}
+	return &f
+}
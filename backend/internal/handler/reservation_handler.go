@@ -34,7 +34,7 @@ func NewReservationHandler(
 
 // Create godoc
 // @Summary Cria uma nova reserva
-// @Description Cria uma reserva de chapas de um lote (reserva quantidade específica de chapas)
+// @Description Cria uma reserva de chapas de um lote (reserva quantidade específica de chapas). Prazo padrão/máximo, limites por broker e aprovação de reservas de brokers seguem a política de reservas da indústria (/api/industry-config). Sem chapas suficientes, o pedido pode entrar na fila de espera (POST /api/reservations/waitlist)
 // @Tags reservations
 // @Accept json
 // @Produce json
@@ -93,7 +93,7 @@ func (h *ReservationHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 // ConfirmSale godoc
 // @Summary Confirma venda de uma reserva
-// @Description Confirma venda de chapas reservadas (pode ser venda parcial ou total)
+// @Description Confirma venda de chapas reservadas (pode ser venda parcial ou total) e gera o romaneio de carga
 // @Tags reservations
 // @Accept json
 // @Produce json
@@ -172,8 +172,10 @@ func (h *ReservationHandler) Cancel(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID := middleware.GetUserID(r.Context())
+
 	// Cancelar reserva
-	if err := h.reservationService.Cancel(r.Context(), id); err != nil {
+	if err := h.reservationService.Cancel(r.Context(), id, userID); err != nil {
 		h.logger.Error("erro ao cancelar reserva",
 			zap.String("reservationId", id),
 			zap.Error(err),
@@ -394,3 +396,455 @@ func (h *ReservationHandler) Reject(w http.ResponseWriter, r *http.Request) {
 
 	response.OK(w, reservation)
 }
+
+// JoinWaitlist godoc
+// @Summary Entra na fila de espera de um lote
+// @Description Registra pedido de reserva para lote sem chapas suficientes. Quando chapas voltam ao estoque (cancelamento, expiração ou rejeição), os pedidos são atendidos por ordem de chegada e viram reservas pendentes de aprovação; quem pediu é avisado por email
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param body body entity.JoinWaitlistInput true "Lote e quantidade de chapas"
+// @Success 201 {object} entity.WaitlistEntry
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/reservations/waitlist [post]
+func (h *ReservationHandler) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	var input entity.JoinWaitlistInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		response.Unauthorized(w, "Usuário não autenticado")
+		return
+	}
+
+	entry, err := h.reservationService.JoinWaitlist(r.Context(), userID, input)
+	if err != nil {
+		h.logger.Error("erro ao entrar na fila de espera",
+			zap.String("batchId", input.BatchID),
+			zap.String("userId", userID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, entry)
+}
+
+// ListWaitlist godoc
+// @Summary Lista a fila de espera
+// @Description Admin vê a fila de espera da indústria; vendedores e brokers veem os próprios pedidos
+// @Tags reservations
+// @Produce json
+// @Param batchId query string false "Filtrar por lote"
+// @Param status query string false "Status (AGUARDANDO, CONVERTIDA, CANCELADA)"
+// @Success 200 {array} entity.WaitlistEntry
+// @Router /api/reservations/waitlist [get]
+func (h *ReservationHandler) ListWaitlist(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		response.Unauthorized(w, "Usuário não autenticado")
+		return
+	}
+
+	filters := entity.WaitlistFilters{}
+	isAdmin := entity.UserRole(middleware.GetUserRole(r.Context())) == entity.RoleAdminIndustria
+	if isAdmin {
+		industryID := middleware.GetIndustryID(r.Context())
+		if industryID == "" {
+			response.Forbidden(w, "Industry ID não encontrado")
+			return
+		}
+		filters.IndustryID = &industryID
+	} else {
+		filters.UserID = &userID
+	}
+
+	if batchID := r.URL.Query().Get("batchId"); batchID != "" {
+		filters.BatchID = &batchID
+	}
+	if status := r.URL.Query().Get("status"); status != "" {
+		s := entity.WaitlistStatus(status)
+		if !s.IsValid() {
+			response.BadRequest(w, "Status inválido", nil)
+			return
+		}
+		filters.Status = &s
+	}
+
+	entries, err := h.reservationService.ListWaitlist(r.Context(), filters)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	// Ocultar brokerSoldPrice para admin (só visível para o broker)
+	if isAdmin {
+		for i := range entries {
+			entries[i].BrokerSoldPrice = nil
+		}
+	}
+
+	response.OK(w, entries)
+}
+
+// LeaveWaitlist godoc
+// @Summary Sai da fila de espera
+// @Description Retira um pedido aguardando da fila (quem pediu ou admin da indústria)
+// @Tags reservations
+// @Param id path string true "ID do pedido na fila"
+// @Success 200 {object} map[string]bool
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/reservations/waitlist/{id} [delete]
+func (h *ReservationHandler) LeaveWaitlist(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		response.Unauthorized(w, "Usuário não autenticado")
+		return
+	}
+
+	if err := h.reservationService.LeaveWaitlist(r.Context(), id, userID); err != nil {
+		h.logger.Error("erro ao sair da fila de espera",
+			zap.String("waitlistId", id),
+			zap.String("userId", userID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
+
+// RequestExtension godoc
+// @Summary Pede prorrogação de uma reserva
+// @Description Quem reservou pede um novo prazo para a reserva com justificativa; o admin aprova ou nega. O número de prorrogações e os dias por pedido seguem a política de reservas da indústria
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da reserva"
+// @Param body body entity.RequestReservationExtensionInput true "Novo prazo e justificativa"
+// @Success 201 {object} entity.ReservationExtension
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/reservations/{id}/extensions [post]
+func (h *ReservationHandler) RequestExtension(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var input entity.RequestReservationExtensionInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		response.Unauthorized(w, "Usuário não autenticado")
+		return
+	}
+
+	extension, err := h.reservationService.RequestExtension(r.Context(), id, userID, input)
+	if err != nil {
+		h.logger.Error("erro ao pedir prorrogação de reserva",
+			zap.String("reservationId", id),
+			zap.String("userId", userID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, extension)
+}
+
+// ListExtensions godoc
+// @Summary Lista pedidos de prorrogação
+// @Description Admin vê os pedidos da indústria; vendedores e brokers veem os próprios pedidos
+// @Tags reservations
+// @Produce json
+// @Param reservationId query string false "Filtrar por reserva"
+// @Param status query string false "Status (PENDENTE, APROVADA, NEGADA)"
+// @Success 200 {array} entity.ReservationExtension
+// @Router /api/reservations/extensions [get]
+func (h *ReservationHandler) ListExtensions(w http.ResponseWriter, r *http.Request) {
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		response.Unauthorized(w, "Usuário não autenticado")
+		return
+	}
+
+	filters := entity.ReservationExtensionFilters{}
+	if entity.UserRole(middleware.GetUserRole(r.Context())) == entity.RoleAdminIndustria {
+		industryID := middleware.GetIndustryID(r.Context())
+		if industryID == "" {
+			response.Forbidden(w, "Industry ID não encontrado")
+			return
+		}
+		filters.IndustryID = &industryID
+	} else {
+		filters.UserID = &userID
+	}
+
+	if reservationID := r.URL.Query().Get("reservationId"); reservationID != "" {
+		filters.ReservationID = &reservationID
+	}
+	if status := r.URL.Query().Get("status"); status != "" {
+		s := entity.ReservationExtensionStatus(status)
+		if !s.IsValid() {
+			response.BadRequest(w, "Status inválido", nil)
+			return
+		}
+		filters.Status = &s
+	}
+
+	extensions, err := h.reservationService.ListExtensions(r.Context(), filters)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, extensions)
+}
+
+// ApproveExtension godoc
+// @Summary Aprova pedido de prorrogação
+// @Description Admin aprova o pedido e aplica o novo prazo à reserva
+// @Tags reservations
+// @Produce json
+// @Param id path string true "ID do pedido de prorrogação"
+// @Success 200 {object} entity.ReservationExtension
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/reservations/extensions/{id}/approve [post]
+func (h *ReservationHandler) ApproveExtension(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+	extension, err := h.reservationService.ApproveExtension(r.Context(), industryID, id, userID)
+	if err != nil {
+		h.logger.Error("erro ao aprovar prorrogação de reserva",
+			zap.String("extensionId", id),
+			zap.String("approverId", userID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, extension)
+}
+
+// DenyExtensionInput representa os dados para negar um pedido de prorrogação
+type DenyExtensionInput struct {
+	Reason string `json:"reason" validate:"required,min=5,max=500"`
+}
+
+// DenyExtension godoc
+// @Summary Nega pedido de prorrogação
+// @Description Admin nega o pedido informando o motivo; o prazo da reserva não muda
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do pedido de prorrogação"
+// @Param body body DenyExtensionInput true "Motivo da negativa"
+// @Success 200 {object} entity.ReservationExtension
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/reservations/extensions/{id}/deny [post]
+func (h *ReservationHandler) DenyExtension(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var input DenyExtensionInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+	extension, err := h.reservationService.DenyExtension(r.Context(), industryID, id, userID, input.Reason)
+	if err != nil {
+		h.logger.Error("erro ao negar prorrogação de reserva",
+			zap.String("extensionId", id),
+			zap.String("approverId", userID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, extension)
+}
+
+// Timeline godoc
+// @Summary Linha do tempo da reserva
+// @Description Eventos da reserva em ordem cronológica (criação, aprovação, prorrogações, cancelamento, expiração, venda), com quem executou cada ação e as mudanças de preço e prazo
+// @Tags reservations
+// @Produce json
+// @Param id path string true "ID da reserva"
+// @Success 200 {array} entity.ReservationEvent
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/reservations/{id}/timeline [get]
+func (h *ReservationHandler) Timeline(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		response.Unauthorized(w, "Usuário não autenticado")
+		return
+	}
+
+	events, err := h.reservationService.GetTimeline(r.Context(), id, userID)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, events)
+}
+
+// ListOffers godoc
+// @Summary Lista propostas de preço da reserva
+// @Description Histórico da negociação de preço por m² (propostas de quem reservou e contrapropostas da indústria)
+// @Tags reservations
+// @Produce json
+// @Param id path string true "ID da reserva"
+// @Success 200 {array} entity.ReservationOffer
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/reservations/{id}/offers [get]
+func (h *ReservationHandler) ListOffers(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		response.Unauthorized(w, "Usuário não autenticado")
+		return
+	}
+
+	offers, err := h.reservationService.ListOffers(r.Context(), id, userID)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, offers)
+}
+
+// MakeOffer godoc
+// @Summary Faz uma proposta de preço
+// @Description Admin faz uma contraproposta de preço por m² ou quem reservou envia nova proposta; a reserva continua pendente até os dois lados concordarem
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da reserva"
+// @Param body body entity.MakeReservationOfferInput true "Preço por m² e observações"
+// @Success 201 {object} entity.ReservationOffer
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/reservations/{id}/offers [post]
+func (h *ReservationHandler) MakeOffer(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var input entity.MakeReservationOfferInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		response.Unauthorized(w, "Usuário não autenticado")
+		return
+	}
+
+	offer, err := h.reservationService.MakeOffer(r.Context(), id, userID, input)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, offer)
+}
+
+// AcceptOffer godoc
+// @Summary Aceita a proposta de preço
+// @Description Aceita a proposta do outro lado da negociação e aprova a reserva pelo preço acordado (usado na confirmação da venda)
+// @Tags reservations
+// @Produce json
+// @Param id path string true "ID da reserva"
+// @Success 200 {object} entity.Reservation
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 403 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/reservations/{id}/offers/accept [post]
+func (h *ReservationHandler) AcceptOffer(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	userID := middleware.GetUserID(r.Context())
+	if userID == "" {
+		response.Unauthorized(w, "Usuário não autenticado")
+		return
+	}
+
+	reservation, err := h.reservationService.AcceptOffer(r.Context(), id, userID)
+	if err != nil {
+		h.logger.Error("erro ao aceitar proposta de preço",
+			zap.String("reservationId", id),
+			zap.String("userId", userID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	// Ocultar brokerSoldPrice para quem não fez a reserva
+	if reservation.ReservedByUserID != userID {
+		reservation.BrokerSoldPrice = nil
+	}
+
+	response.OK(w, reservation)
+}
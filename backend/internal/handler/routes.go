@@ -19,6 +19,17 @@ type Handler struct {
 	User            *UserHandler
 	Product         *ProductHandler
 	Batch           *BatchHandler
+	BatchLabel      *BatchLabelHandler
+	Block           *BlockHandler
+	Warehouse       *WarehouseHandler
+	InventoryCount  *InventoryCountHandler
+	DamageReport    *DamageReportHandler
+	Remnant         *RemnantHandler
+	StockAlert      *StockAlertHandler
+	Job             *JobHandler
+	Price           *PriceHandler
+	PriceList       *PriceListHandler
+	ExchangeRate    *ExchangeRateHandler
 	Reservation     *ReservationHandler
 	Dashboard       *DashboardHandler
 	BI              *BIHandler
@@ -26,6 +37,7 @@ type Handler struct {
 	CatalogLink     *CatalogLinkHandler
 	Cliente         *ClienteHandler
 	SalesHistory    *SalesHistoryHandler
+	PackingList     *PackingListHandler
 	SharedInventory *SharedInventoryHandler
 	Upload          *UploadHandler
 	Public          *PublicHandler
@@ -52,6 +64,17 @@ type Services struct {
 	User                  service.UserService
 	Product               service.ProductService
 	Batch                 service.BatchService
+	BatchLabel            service.BatchLabelService
+	Block                 service.BlockService
+	Warehouse             service.WarehouseService
+	InventoryCount        service.InventoryCountService
+	DamageReport          service.DamageReportService
+	Remnant               service.RemnantService
+	StockAlert            service.StockAlertService
+	Jobs                  service.JobScheduler
+	Price                 service.PriceService
+	PriceList             service.PriceListService
+	ExchangeRate          service.ExchangeRateService
 	Reservation           service.ReservationService
 	Dashboard             service.DashboardService
 	BI                    service.BIService
@@ -59,6 +82,7 @@ type Services struct {
 	CatalogLink           service.CatalogLinkService
 	Cliente               service.ClienteService
 	SalesHistory          service.SalesHistoryService
+	PackingList           service.PackingListService
 	SharedInventory       service.SharedInventoryService
 	Storage               service.StorageService
 	Email                 service.EmailSender
@@ -77,6 +101,17 @@ func NewHandler(cfg Config, services Services, healthHandler *HealthHandler) *Ha
 		User:            NewUserHandler(services.User, cfg.Validator, cfg.Logger),
 		Product:         NewProductHandler(services.Product, cfg.Validator, cfg.Logger),
 		Batch:           NewBatchHandler(services.Batch, services.SharedInventory, cfg.Validator, cfg.Logger),
+		BatchLabel:      NewBatchLabelHandler(services.BatchLabel, cfg.Validator, cfg.Logger),
+		Block:           NewBlockHandler(services.Block, cfg.Validator, cfg.Logger),
+		Warehouse:       NewWarehouseHandler(services.Warehouse, cfg.Validator, cfg.Logger),
+		InventoryCount:  NewInventoryCountHandler(services.InventoryCount, cfg.Validator, cfg.Logger),
+		DamageReport:    NewDamageReportHandler(services.DamageReport, services.Storage, cfg.Validator, cfg.Logger),
+		Remnant:         NewRemnantHandler(services.Remnant, services.Storage, cfg.Validator, cfg.Logger),
+		StockAlert:      NewStockAlertHandler(services.StockAlert, cfg.Logger),
+		Job:             NewJobHandler(services.Jobs, cfg.Logger),
+		Price:           NewPriceHandler(services.Price, cfg.Validator, cfg.Logger),
+		PriceList:       NewPriceListHandler(services.PriceList, cfg.Validator, cfg.Logger),
+		ExchangeRate:    NewExchangeRateHandler(services.ExchangeRate, cfg.Validator, cfg.Logger),
 		Reservation:     NewReservationHandler(services.Reservation, cfg.Validator, cfg.Logger),
 		Dashboard:       NewDashboardHandler(services.Dashboard, cfg.Logger),
 		BI:              NewBIHandler(services.BI, cfg.Logger),
@@ -84,6 +119,7 @@ func NewHandler(cfg Config, services Services, healthHandler *HealthHandler) *Ha
 		CatalogLink:     NewCatalogLinkHandler(services.CatalogLink, cfg.Validator, cfg.Logger),
 		Cliente:         NewClienteHandler(services.Cliente, cfg.Validator, cfg.Logger),
 		SalesHistory:    NewSalesHistoryHandler(services.SalesHistory, cfg.Validator, cfg.Logger),
+		PackingList:     NewPackingListHandler(services.PackingList, cfg.Validator, cfg.Logger),
 		SharedInventory: NewSharedInventoryHandler(services.SharedInventory, cfg.Validator, cfg.Logger),
 		Upload:          NewUploadHandler(services.Storage, services.Product, services.Batch, services.MediaRepo, cfg.Logger),
 		Public:          NewPublicHandler(services.SalesLink, services.Cliente, services.IndustryRepo, services.BatchRepo, cfg.Validator, cfg.Logger),
@@ -219,6 +255,15 @@ func SetupRouter(h *Handler, m Middlewares, cfg Config) *chi.Mux {
 				r.With(m.RBAC.RequireAdmin).Post("/refresh", h.BI.RefreshViews)
 			})
 
+			// ----------------------------------------
+			// JOBS (agendador em segundo plano)
+			// ----------------------------------------
+			r.Route("/jobs", func(r chi.Router) {
+				r.With(m.RBAC.RequireAdmin).Get("/", h.Job.List)
+				r.With(m.RBAC.RequireAdmin).Get("/{name}/runs", h.Job.ListRuns)
+				r.With(m.RBAC.RequireAdmin).Post("/{name}/run", h.Job.Trigger)
+			})
+
 			// ----------------------------------------
 			// PRODUCTS
 			// ----------------------------------------
@@ -230,14 +275,115 @@ func SetupRouter(h *Handler, m Middlewares, cfg Config) *chi.Mux {
 				r.With(m.RBAC.RequireAdmin).Post("/", h.Product.Create)
 				r.With(m.RBAC.RequireAdmin).Put("/{id}", h.Product.Update)
 				r.With(m.RBAC.RequireAdmin).Delete("/{id}", h.Product.Delete)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}/price-history", h.Price.GetProductHistory)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/price-schedules", h.Price.ScheduleProductPrice)
+			})
+			r.With(m.RBAC.RequireAdmin).Delete("/price-schedules/{id}", h.Price.CancelSchedule)
+
+			// ----------------------------------------
+			// PRICE LISTS (tabelas de preço)
+			// ----------------------------------------
+			r.Route("/price-lists", func(r chi.Router) {
+				r.With(m.RBAC.RequireIndustryUser).Get("/", h.PriceList.List)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}", h.PriceList.GetByID)
+				r.With(m.RBAC.RequireAdmin).Post("/", h.PriceList.Create)
+				r.With(m.RBAC.RequireAdmin).Put("/{id}", h.PriceList.Update)
+				r.With(m.RBAC.RequireAdmin).Delete("/{id}", h.PriceList.Delete)
+				r.With(m.RBAC.RequireAdmin).Get("/{id}/assignments", h.PriceList.ListAssignments)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/assignments", h.PriceList.Assign)
+				r.With(m.RBAC.RequireAdmin).Delete("/{id}/assignments/{assignmentId}", h.PriceList.Unassign)
+			})
+
+			// ----------------------------------------
+			// EXCHANGE RATES (cotações de moeda)
+			// ----------------------------------------
+			r.Route("/exchange-rates", func(r chi.Router) {
+				r.With(m.RBAC.RequireIndustryUser).Get("/", h.ExchangeRate.List)
+				r.With(m.RBAC.RequireAdmin).Post("/", h.ExchangeRate.Upsert)
+				r.With(m.RBAC.RequireAdmin).Post("/import", h.ExchangeRate.Import)
+				r.With(m.RBAC.RequireAdmin).Delete("/{id}", h.ExchangeRate.Delete)
+			})
+
+			// ----------------------------------------
+			// BLOCKS (blocos de pedreira)
+			// ----------------------------------------
+			r.Route("/blocks", func(r chi.Router) {
+				r.With(m.RBAC.RequireIndustryUser).Get("/", h.Block.List)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}", h.Block.GetByID)
+				r.With(m.RBAC.RequireAdmin).Post("/", h.Block.Create)
+				r.With(m.RBAC.RequireAdmin).Put("/{id}", h.Block.Update)
+				r.With(m.RBAC.RequireAdmin).Delete("/{id}", h.Block.Delete)
+			})
+			r.With(m.RBAC.RequireIndustryUser).Get("/slabs/{slabId}/trace", h.Block.TraceSlab)
+
+			// ----------------------------------------
+			// WAREHOUSES (depósitos físicos e posições)
+			// ----------------------------------------
+			r.Route("/warehouses", func(r chi.Router) {
+				r.With(m.RBAC.RequireIndustryUser).Get("/", h.Warehouse.List)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}", h.Warehouse.GetByID)
+				r.With(m.RBAC.RequireAdmin).Post("/", h.Warehouse.Create)
+				r.With(m.RBAC.RequireAdmin).Put("/{id}", h.Warehouse.Update)
+				r.With(m.RBAC.RequireAdmin).Delete("/{id}", h.Warehouse.Delete)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/locations", h.Warehouse.CreateLocation)
+				r.With(m.RBAC.RequireAdmin).Put("/{id}/locations/{locationId}", h.Warehouse.UpdateLocation)
+				r.With(m.RBAC.RequireAdmin).Delete("/{id}/locations/{locationId}", h.Warehouse.DeleteLocation)
+			})
+
+			// ----------------------------------------
+			// INVENTORY COUNTS (inventário físico)
+			// ----------------------------------------
+			r.Route("/inventory-counts", func(r chi.Router) {
+				r.With(m.RBAC.RequireIndustryUser).Get("/", h.InventoryCount.List)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}", h.InventoryCount.GetByID)
+				r.With(m.RBAC.RequireAdmin).Post("/", h.InventoryCount.Create)
+				r.With(m.RBAC.RequireIndustryUser).Put("/{id}/items", h.InventoryCount.RecordCounts)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/approve", h.InventoryCount.Approve)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/cancel", h.InventoryCount.Cancel)
+			})
+
+			// ----------------------------------------
+			// DAMAGE REPORTS (avarias)
+			// ----------------------------------------
+			r.Route("/damage-reports", func(r chi.Router) {
+				r.With(m.RBAC.RequireIndustryUser).Get("/", h.DamageReport.List)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}", h.DamageReport.GetByID)
+				r.With(m.RBAC.RequireIndustryUser).Post("/", h.DamageReport.Create)
+				r.With(m.RBAC.RequireIndustryUser, appMiddleware.UploadBodyLimit).Post("/{id}/photos", h.DamageReport.UploadPhotos)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/approve", h.DamageReport.Approve)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/reject", h.DamageReport.Reject)
+			})
+
+			// ----------------------------------------
+			// REMNANTS (retalhos)
+			// ----------------------------------------
+			r.Route("/remnants", func(r chi.Router) {
+				r.With(m.RBAC.RequireIndustryUser).Get("/", h.Remnant.List)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}", h.Remnant.GetByID)
+				r.With(m.RBAC.RequireAdmin).Post("/", h.Remnant.Create)
+				r.With(m.RBAC.RequireAdmin).Put("/{id}", h.Remnant.Update)
+				r.With(m.RBAC.RequireAdmin).Delete("/{id}", h.Remnant.Delete)
+				r.With(m.RBAC.RequireAdmin, appMiddleware.UploadBodyLimit).Post("/{id}/photos", h.Remnant.UploadPhotos)
+				r.With(m.RBAC.RequireAdmin).Delete("/{id}/photos/{photoId}", h.Remnant.DeletePhoto)
 			})
 
+			// ----------------------------------------
+			// STOCK ALERTS (estoque baixo/parado)
+			// ----------------------------------------
+			r.With(m.RBAC.RequireIndustryUser).Get("/stock-alerts", h.StockAlert.List)
+
 			// ----------------------------------------
 			// BATCHES
 			// ----------------------------------------
 			r.Route("/batches", func(r chi.Router) {
 				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Get("/", h.Batch.List)
 				r.With(m.RBAC.RequireAdmin).Post("/", h.Batch.Create)
+				r.With(m.RBAC.RequireAdmin, appMiddleware.UploadBodyLimit).Post("/import", h.Batch.Import)
+				r.With(m.RBAC.RequireAdmin).Get("/export", h.Batch.Export)
+				r.With(m.RBAC.RequireAdmin).Get("/next-code", h.Batch.PreviewNextCode)
+				r.With(m.RBAC.RequireIndustryUser).Get("/labels/layouts", h.BatchLabel.ListLayouts)
+				r.With(m.RBAC.RequireIndustryUser).Post("/labels", h.BatchLabel.Generate)
+				r.With(m.RBAC.RequireAdmin).Post("/merge", h.Batch.Merge)
 				r.With(m.RBAC.RequireAdmin).Post("/{id}/sell", h.Batch.Sell)
 				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Get("/{id}", h.Batch.GetByID)
 				r.With(m.RBAC.RequireAdmin).Get("/{batchId}/shared", h.SharedInventory.GetSharedBatchesByBatchID)
@@ -246,6 +392,16 @@ func SetupRouter(h *Handler, m Middlewares, cfg Config) *chi.Mux {
 				r.With(m.RBAC.RequireAdmin).Put("/{id}", h.Batch.Update)
 				r.With(m.RBAC.RequireAdmin).Patch("/{id}/status", h.Batch.UpdateStatus)
 				r.With(m.RBAC.RequireAdmin).Patch("/{id}/availability", h.Batch.UpdateAvailability)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Get("/{id}/slabs", h.Batch.ListSlabs)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/slabs/initialize", h.Batch.InitializeSlabs)
+				r.With(m.RBAC.RequireAdmin).Put("/{id}/slabs/{slabId}", h.Batch.UpdateSlab)
+				r.With(m.RBAC.RequireAdmin).Get("/{id}/movements", h.Batch.ListMovements)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/split", h.Batch.Split)
+				r.With(m.RBAC.RequireAdmin).Get("/{id}/lineage", h.Batch.GetLineage)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/transfer", h.Batch.Transfer)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}/transfers", h.Batch.ListTransfers)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}/price-history", h.Price.GetBatchHistory)
+				r.With(m.RBAC.RequireAdmin).Post("/{id}/price-schedules", h.Price.ScheduleBatchPrice)
 				r.With(m.RBAC.RequireAdmin).Post("/{id}/archive", h.Batch.Archive)
 				r.With(m.RBAC.RequireAdmin).Post("/{id}/restore", h.Batch.Restore)
 				r.With(m.RBAC.RequireAdmin).Delete("/{id}", h.Batch.Delete)
@@ -259,6 +415,17 @@ func SetupRouter(h *Handler, m Middlewares, cfg Config) *chi.Mux {
 				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Get("/my", h.Reservation.ListMy)
 				r.With(m.RBAC.RequireAdmin).Get("/", h.Reservation.ListAll)
 				r.With(m.RBAC.RequireAdmin).Get("/pending", h.Reservation.ListPending)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Post("/waitlist", h.Reservation.JoinWaitlist)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Get("/waitlist", h.Reservation.ListWaitlist)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Delete("/waitlist/{id}", h.Reservation.LeaveWaitlist)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Get("/extensions", h.Reservation.ListExtensions)
+				r.With(m.RBAC.RequireAdmin).Post("/extensions/{id}/approve", h.Reservation.ApproveExtension)
+				r.With(m.RBAC.RequireAdmin).Post("/extensions/{id}/deny", h.Reservation.DenyExtension)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Post("/{id}/extensions", h.Reservation.RequestExtension)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Get("/{id}/timeline", h.Reservation.Timeline)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Get("/{id}/offers", h.Reservation.ListOffers)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Post("/{id}/offers", h.Reservation.MakeOffer)
+				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Post("/{id}/offers/accept", h.Reservation.AcceptOffer)
 				r.With(m.RBAC.RequireAdmin).Post("/{id}/approve", h.Reservation.Approve)
 				r.With(m.RBAC.RequireAdmin).Post("/{id}/reject", h.Reservation.Reject)
 				r.With(m.RBAC.RequireRoles(entity.RoleAdminIndustria, entity.RoleVendedorInterno, entity.RoleBroker)).Post("/{id}/confirm-sale", h.Reservation.ConfirmSale)
@@ -361,6 +528,11 @@ func SetupRouter(h *Handler, m Middlewares, cfg Config) *chi.Mux {
 				r.With(m.RBAC.RequireIndustryUser).Get("/summary", h.SalesHistory.GetSummary)
 				r.With(m.RBAC.RequireIndustryUser).Get("/{id}", h.SalesHistory.GetByID)
 				r.With(m.RBAC.RequireAdmin).Delete("/{id}", h.SalesHistory.Delete)
+
+				// Romaneio de carga
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}/packing-list", h.PackingList.GetBySaleID)
+				r.With(m.RBAC.RequireIndustryUser).Get("/{id}/packing-list/pdf", h.PackingList.DownloadPDF)
+				r.With(m.RBAC.RequireIndustryUser).Post("/{id}/packing-list", h.PackingList.Generate)
 			})
 
 			// Broker sales
@@ -374,12 +546,14 @@ func SetupRouter(h *Handler, m Middlewares, cfg Config) *chi.Mux {
 				r.Use(appMiddleware.UploadBodyLimit)
 				r.With(m.RBAC.RequireAdmin).Post("/product-medias", h.Upload.UploadProductMedias)
 				r.With(m.RBAC.RequireAdmin).Post("/batch-medias", h.Upload.UploadBatchMedias)
+				r.With(m.RBAC.RequireAdmin).Post("/slab-medias", h.Upload.UploadSlabMedias)
 				r.With(m.RBAC.RequireAdmin).Post("/industry-logo", h.Upload.UploadIndustryLogo)
 			})
 
 			// Delete medias
 			r.With(m.RBAC.RequireAdmin).Delete("/product-medias/{id}", h.Upload.DeleteProductMedia)
 			r.With(m.RBAC.RequireAdmin).Delete("/batch-medias/{id}", h.Upload.DeleteBatchMedia)
+			r.With(m.RBAC.RequireAdmin).Delete("/slab-medias/{id}", h.Upload.DeleteSlabMedia)
 
 			// Update media order
 			r.With(m.RBAC.RequireAdmin).Patch("/product-medias/order", h.Upload.UpdateProductMediasOrder)
@@ -218,7 +218,9 @@ func (h *SalesHistoryHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.salesHistoryService.Delete(r.Context(), id); err != nil {
+	userID := middleware.GetUserID(r.Context())
+
+	if err := h.salesHistoryService.Delete(r.Context(), id, userID); err != nil {
 		h.logger.Error("erro ao remover venda",
 			zap.String("saleId", id),
 			zap.Error(err),
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"go.uber.org/zap"
+)
+
+// StockAlertHandler gerencia requisições de alertas de estoque
+type StockAlertHandler struct {
+	alertService service.StockAlertService
+	logger       *zap.Logger
+}
+
+// NewStockAlertHandler cria uma nova instância de StockAlertHandler
+func NewStockAlertHandler(alertService service.StockAlertService, logger *zap.Logger) *StockAlertHandler {
+	return &StockAlertHandler{
+		alertService: alertService,
+		logger:       logger,
+	}
+}
+
+// List godoc
+// @Summary Lista alertas de estoque
+// @Description Lista os cruzamentos de limite de estoque baixo/parado da indústria (limites em /api/industry-config)
+// @Tags stock-alerts
+// @Produce json
+// @Param type query string false "Filtrar por tipo (ESTOQUE_BAIXO, ESTOQUE_PARADO)"
+// @Param batchId query string false "Filtrar por lote"
+// @Param onlyOpen query bool false "Apenas alertas de lotes ainda na condição"
+// @Param page query int false "Número da página"
+// @Param limit query int false "Itens por página"
+// @Success 200 {object} entity.StockAlertListResponse
+// @Router /api/stock-alerts [get]
+func (h *StockAlertHandler) List(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	filters := entity.StockAlertFilters{
+		Page:  1,
+		Limit: 20,
+	}
+
+	if alertType := r.URL.Query().Get("type"); alertType != "" {
+		t := entity.StockAlertType(alertType)
+		if t.IsValid() {
+			filters.Type = &t
+		}
+	}
+
+	if batchID := r.URL.Query().Get("batchId"); batchID != "" {
+		filters.BatchID = &batchID
+	}
+
+	if onlyOpen := r.URL.Query().Get("onlyOpen"); onlyOpen == "true" {
+		filters.OnlyOpen = true
+	}
+
+	if page := r.URL.Query().Get("page"); page != "" {
+		if p, err := strconv.Atoi(page); err == nil && p > 0 {
+			filters.Page = p
+		}
+	}
+
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 && l <= 100 {
+			filters.Limit = l
+		}
+	}
+
+	result, err := h.alertService.List(r.Context(), industryID, filters)
+	if err != nil {
+		h.logger.Error("erro ao listar alertas de estoque", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, result)
+}
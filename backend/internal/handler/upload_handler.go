@@ -530,6 +530,232 @@ func (h *UploadHandler) DeleteBatchMedia(w http.ResponseWriter, r *http.Request)
 	response.OK(w, map[string]bool{"success": true})
 }
 
+// UploadSlabMedias godoc
+// @Summary Faz upload de mídias de chapa
+// @Description Faz upload de imagens para uma chapa individual de um lote
+// @Tags uploads
+// @Accept multipart/form-data
+// @Produce json
+// @Param batchId formData string true "ID do lote"
+// @Param slabId formData string true "ID da chapa"
+// @Param medias formData file true "Arquivos de mídia"
+// @Success 201 {object} entity.UploadMediaResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Router /api/upload/slab-medias [post]
+func (h *UploadHandler) UploadSlabMedias(w http.ResponseWriter, r *http.Request) {
+	// Limitar tamanho do request
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize*maxFilesPerBatch)
+
+	// Parse multipart form
+	if err := r.ParseMultipartForm(maxUploadSize * maxFilesPerBatch); err != nil {
+		h.logger.Error("erro ao parsear multipart form",
+			zap.Error(err),
+		)
+		response.BadRequest(w, "Arquivo muito grande. Máximo 5MB por arquivo", nil)
+		return
+	}
+
+	batchID := r.FormValue("batchId")
+	slabID := r.FormValue("slabId")
+	if batchID == "" || slabID == "" {
+		response.BadRequest(w, "Batch ID e Slab ID são obrigatórios", nil)
+		return
+	}
+
+	// Verificar se o lote pertence à indústria do solicitante (prevenir IDOR)
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+	batch, err := h.batchService.CheckStatus(r.Context(), batchID)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+	if batch.IndustryID != industryID {
+		h.logger.Warn("tentativa de upload para chapa de outra indústria",
+			zap.String("batchId", batchID),
+			zap.String("requesterIndustryId", industryID),
+		)
+		response.NotFound(w, "Lote não encontrado")
+		return
+	}
+
+	slab, err := h.batchService.GetSlab(r.Context(), slabID)
+	if err != nil {
+		response.HandleError(w, err)
+		return
+	}
+	if slab.BatchID != batchID {
+		response.NotFound(w, "Chapa não encontrada")
+		return
+	}
+
+	// Obter arquivos
+	files := r.MultipartForm.File["medias"]
+	if len(files) == 0 {
+		response.BadRequest(w, "Nenhum arquivo enviado", nil)
+		return
+	}
+
+	if len(files) > maxFilesPerBatch {
+		response.BadRequest(w, "Máximo 10 arquivos por upload", nil)
+		return
+	}
+
+	// Buscar mídias existentes para obter o próximo displayOrder
+	existingMedias, err := h.mediaRepo.FindSlabMedias(r.Context(), slabID)
+	if err != nil {
+		h.logger.Error("erro ao buscar mídias existentes da chapa",
+			zap.String("slabId", slabID),
+			zap.Error(err),
+		)
+	}
+	startDisplayOrder := len(existingMedias)
+
+	// Processar cada arquivo
+	var urls []string
+	for _, fileHeader := range files {
+		// Sanitizar nome do arquivo para prevenir path traversal
+		safeFilename := sanitizeUploadFilename(fileHeader.Filename)
+
+		if !isAllowedExtension(safeFilename) {
+			response.BadRequest(w, "Extensão de arquivo inválida. Use .jpg, .jpeg, .png ou .webp", nil)
+			return
+		}
+
+		if fileHeader.Size > maxUploadSize {
+			response.BadRequest(w, "Arquivo muito grande. Máximo 5MB por arquivo", nil)
+			return
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			h.logger.Error("erro ao abrir arquivo",
+				zap.String("filename", safeFilename),
+				zap.Error(err),
+			)
+			response.BadRequest(w, "Erro ao processar arquivo", nil)
+			return
+		}
+		defer file.Close()
+
+		contentType := fileHeader.Header.Get("Content-Type")
+		if !h.storageService.ValidateFileType(contentType, allowedContentTypes) {
+			response.BadRequest(w, "Formato inválido. Use JPEG, PNG ou WebP", nil)
+			return
+		}
+
+		// Mídias de chapa ficam armazenadas junto às do lote
+		url, err := h.storageService.UploadBatchMedia(
+			r.Context(),
+			batchID,
+			file,
+			safeFilename,
+			contentType,
+			fileHeader.Size,
+		)
+		if err != nil {
+			h.logger.Error("erro ao fazer upload",
+				zap.String("filename", fileHeader.Filename),
+				zap.Error(err),
+			)
+			response.HandleError(w, err)
+			return
+		}
+
+		urls = append(urls, url)
+	}
+
+	// Persistir URLs no banco de dados
+	var mediasToCreate []entity.CreateMediaInput
+	for i, url := range urls {
+		mediasToCreate = append(mediasToCreate, entity.CreateMediaInput{
+			URL:          url,
+			DisplayOrder: startDisplayOrder + i,
+		})
+	}
+
+	if err := h.batchService.AddSlabMedias(r.Context(), slabID, mediasToCreate); err != nil {
+		h.logger.Error("erro ao persistir mídias da chapa no banco",
+			zap.String("slabId", slabID),
+			zap.Error(err),
+		)
+	}
+
+	h.logger.Info("mídias de chapa enviadas e persistidas",
+		zap.String("batchId", batchID),
+		zap.String("slabId", slabID),
+		zap.Int("count", len(urls)),
+	)
+
+	response.Created(w, entity.UploadMediaResponse{URLs: urls})
+}
+
+// DeleteSlabMedia godoc
+// @Summary Remove mídia de chapa
+// @Description Remove uma mídia de chapa do storage e banco de dados
+// @Tags uploads
+// @Produce json
+// @Param id path string true "ID da mídia"
+// @Success 200 {object} map[string]bool
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/slab-medias/{id} [delete]
+func (h *UploadHandler) DeleteSlabMedia(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID da mídia é obrigatório", nil)
+		return
+	}
+
+	ctx := r.Context()
+
+	media, err := h.mediaRepo.FindSlabMediaByID(ctx, id)
+	if err != nil {
+		h.logger.Error("erro ao buscar mídia de chapa",
+			zap.String("mediaId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	key, err := h.storageService.ExtractKeyFromURL(media.URL)
+	if err != nil {
+		h.logger.Error("erro ao extrair key da URL",
+			zap.String("url", media.URL),
+			zap.Error(err),
+		)
+		response.InternalServerError(w, err)
+		return
+	}
+
+	if err := h.storageService.DeleteFile(ctx, "", key); err != nil {
+		h.logger.Error("erro ao deletar arquivo do storage",
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		// Continua para deletar do banco mesmo se falhar no storage
+	}
+
+	if err := h.mediaRepo.DeleteSlabMedia(ctx, id); err != nil {
+		h.logger.Error("erro ao deletar mídia do banco",
+			zap.String("mediaId", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	h.logger.Info("mídia de chapa removida",
+		zap.String("mediaId", id),
+		zap.String("slabId", media.SlabID),
+	)
+
+	response.OK(w, map[string]bool{"success": true})
+}
+
 // UpdateBatchMediasOrder godoc
 // @Summary Atualiza ordem das mídias de um lote
 // @Description Atualiza a ordem de exibição das mídias de um lote
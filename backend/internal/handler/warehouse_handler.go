@@ -0,0 +1,355 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/middleware"
+	"github.com/thiagomes07/CAVA/backend/pkg/response"
+	"github.com/thiagomes07/CAVA/backend/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// WarehouseHandler gerencia requisições de depósitos físicos e posições
+type WarehouseHandler struct {
+	warehouseService service.WarehouseService
+	validator        *validator.Validator
+	logger           *zap.Logger
+}
+
+// NewWarehouseHandler cria uma nova instância de WarehouseHandler
+func NewWarehouseHandler(
+	warehouseService service.WarehouseService,
+	validator *validator.Validator,
+	logger *zap.Logger,
+) *WarehouseHandler {
+	return &WarehouseHandler{
+		warehouseService: warehouseService,
+		validator:        validator,
+		logger:           logger,
+	}
+}
+
+// List godoc
+// @Summary Lista depósitos
+// @Description Lista depósitos físicos da indústria com posições e quantidade de lotes alocados
+// @Tags warehouses
+// @Produce json
+// @Param includeInactive query bool false "Incluir depósitos inativos"
+// @Success 200 {array} entity.Warehouse
+// @Router /api/warehouses [get]
+func (h *WarehouseHandler) List(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	includeInactive := r.URL.Query().Get("includeInactive") == "true"
+
+	warehouses, err := h.warehouseService.List(r.Context(), industryID, includeInactive)
+	if err != nil {
+		h.logger.Error("erro ao listar depósitos", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, warehouses)
+}
+
+// GetByID godoc
+// @Summary Busca depósito por ID
+// @Description Retorna o depósito com suas posições
+// @Tags warehouses
+// @Produce json
+// @Param id path string true "ID do depósito"
+// @Success 200 {object} entity.Warehouse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/warehouses/{id} [get]
+func (h *WarehouseHandler) GetByID(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do depósito é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	warehouse, err := h.warehouseService.GetByID(r.Context(), industryID, id)
+	if err != nil {
+		h.logger.Error("erro ao buscar depósito",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, warehouse)
+}
+
+// Create godoc
+// @Summary Cadastra depósito
+// @Description Cadastra um depósito físico (pátio, galpão, filial)
+// @Tags warehouses
+// @Accept json
+// @Produce json
+// @Param body body entity.CreateWarehouseInput true "Dados do depósito"
+// @Success 201 {object} entity.Warehouse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/warehouses [post]
+func (h *WarehouseHandler) Create(w http.ResponseWriter, r *http.Request) {
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.CreateWarehouseInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	warehouse, err := h.warehouseService.Create(r.Context(), industryID, input)
+	if err != nil {
+		h.logger.Error("erro ao criar depósito", zap.Error(err))
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, warehouse)
+}
+
+// Update godoc
+// @Summary Atualiza depósito
+// @Description Atualiza dados de um depósito físico
+// @Tags warehouses
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do depósito"
+// @Param body body entity.UpdateWarehouseInput true "Dados a atualizar"
+// @Success 200 {object} entity.Warehouse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/warehouses/{id} [put]
+func (h *WarehouseHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do depósito é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.UpdateWarehouseInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	warehouse, err := h.warehouseService.Update(r.Context(), industryID, id, input)
+	if err != nil {
+		h.logger.Error("erro ao atualizar depósito",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, warehouse)
+}
+
+// Delete godoc
+// @Summary Exclui depósito
+// @Description Exclui um depósito sem lotes alocados (posições são removidas junto)
+// @Tags warehouses
+// @Produce json
+// @Param id path string true "ID do depósito"
+// @Success 200 {object} map[string]bool
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/warehouses/{id} [delete]
+func (h *WarehouseHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.BadRequest(w, "ID do depósito é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	if err := h.warehouseService.Delete(r.Context(), industryID, id); err != nil {
+		h.logger.Error("erro ao excluir depósito",
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
+
+// CreateLocation godoc
+// @Summary Cadastra posição
+// @Description Cadastra uma posição (pátio, rack, cavalete) no depósito
+// @Tags warehouses
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do depósito"
+// @Param body body entity.CreateWarehouseLocationInput true "Dados da posição"
+// @Success 201 {object} entity.WarehouseLocation
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/warehouses/{id}/locations [post]
+func (h *WarehouseHandler) CreateLocation(w http.ResponseWriter, r *http.Request) {
+	warehouseID := chi.URLParam(r, "id")
+	if warehouseID == "" {
+		response.BadRequest(w, "ID do depósito é obrigatório", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.CreateWarehouseLocationInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	location, err := h.warehouseService.CreateLocation(r.Context(), industryID, warehouseID, input)
+	if err != nil {
+		h.logger.Error("erro ao criar posição",
+			zap.String("warehouseId", warehouseID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.Created(w, location)
+}
+
+// UpdateLocation godoc
+// @Summary Atualiza posição
+// @Description Atualiza uma posição do depósito
+// @Tags warehouses
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do depósito"
+// @Param locationId path string true "ID da posição"
+// @Param body body entity.UpdateWarehouseLocationInput true "Dados a atualizar"
+// @Success 200 {object} entity.WarehouseLocation
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /api/warehouses/{id}/locations/{locationId} [put]
+func (h *WarehouseHandler) UpdateLocation(w http.ResponseWriter, r *http.Request) {
+	warehouseID := chi.URLParam(r, "id")
+	locationID := chi.URLParam(r, "locationId")
+	if warehouseID == "" || locationID == "" {
+		response.BadRequest(w, "ID do depósito e da posição são obrigatórios", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	var input entity.UpdateWarehouseLocationInput
+	if err := response.ParseJSON(r, &input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	if err := h.validator.Validate(input); err != nil {
+		response.HandleError(w, err)
+		return
+	}
+
+	location, err := h.warehouseService.UpdateLocation(r.Context(), industryID, warehouseID, locationID, input)
+	if err != nil {
+		h.logger.Error("erro ao atualizar posição",
+			zap.String("locationId", locationID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, location)
+}
+
+// DeleteLocation godoc
+// @Summary Exclui posição
+// @Description Exclui uma posição sem lotes alocados
+// @Tags warehouses
+// @Produce json
+// @Param id path string true "ID do depósito"
+// @Param locationId path string true "ID da posição"
+// @Success 200 {object} map[string]bool
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /api/warehouses/{id}/locations/{locationId} [delete]
+func (h *WarehouseHandler) DeleteLocation(w http.ResponseWriter, r *http.Request) {
+	warehouseID := chi.URLParam(r, "id")
+	locationID := chi.URLParam(r, "locationId")
+	if warehouseID == "" || locationID == "" {
+		response.BadRequest(w, "ID do depósito e da posição são obrigatórios", nil)
+		return
+	}
+
+	industryID := middleware.GetIndustryID(r.Context())
+	if industryID == "" {
+		response.Forbidden(w, "Industry ID não encontrado")
+		return
+	}
+
+	if err := h.warehouseService.DeleteLocation(r.Context(), industryID, warehouseID, locationID); err != nil {
+		h.logger.Error("erro ao excluir posição",
+			zap.String("locationId", locationID),
+			zap.Error(err),
+		)
+		response.HandleError(w, err)
+		return
+	}
+
+	response.OK(w, map[string]bool{"success": true})
+}
@@ -0,0 +1,258 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+)
+
+// packingListColumn define uma coluna da tabela de itens do romaneio
+type packingListColumn struct {
+	title string
+	width float64
+	align string
+}
+
+var packingListColumns = []packingListColumn{
+	{"Lote", 26, "L"},
+	{"Chapa/Peça", 20, "C"},
+	{"Produto", 42, "L"},
+	{"Material / Acab.", 30, "L"},
+	{"Dimensões (cm)", 28, "C"},
+	{"Qtd", 10, "R"},
+	{"Área (m²)", 16, "R"},
+	{"Peso (kg)", 18, "R"},
+}
+
+const (
+	packingListMargin    = 10.0
+	packingListRowHeight = 6.0
+	packingListBottom    = 280.0 // limite útil da página A4 antes do rodapé
+)
+
+// RenderPackingListPDF gera o PDF (A4) do romaneio
+func RenderPackingListPDF(doc entity.PackingListDocument) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(packingListMargin, packingListMargin, packingListMargin)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetCreator("CAVA Stone Platform", true)
+	pdf.SetTitle("Romaneio "+doc.Number, true)
+	pdf.AliasNbPages("")
+
+	// Fontes padrão do PDF usam cp1252, que cobre a acentuação em português
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(120, 120, 120)
+		pdf.CellFormat(150, 5, tr(fmt.Sprintf("Romaneio %s - gerado em %s", doc.Number, doc.GeneratedAt.Format("02/01/2006 15:04"))), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 5, fmt.Sprintf("%d/{nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+
+	pdf.AddPage()
+
+	// Cabeçalho
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(120, 9, tr("ROMANEIO DE CARGA"), "", 0, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 9, doc.Number, "", 1, "R", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(0, 5, tr("Data da venda: "+doc.SaleDate.Format("02/01/2006")), "", 1, "R", false, 0, "")
+	pdf.Ln(2)
+
+	// Emitente e destinatário lado a lado
+	top := pdf.GetY()
+	half := (210 - 2*packingListMargin - 4) / 2
+	leftBottom := drawParty(pdf, tr, packingListMargin, top, half, "Emitente", doc.Issuer)
+	rightBottom := drawParty(pdf, tr, packingListMargin+half+4, top, half, "Destinatário", doc.Customer)
+	pdf.SetY(maxFloat(leftBottom, rightBottom) + 3)
+
+	// Transportadora
+	drawCarrier(pdf, tr, doc.Carrier)
+	pdf.Ln(3)
+
+	// Itens
+	drawItemsHeader(pdf, tr)
+	pdf.SetFont("Helvetica", "", 8)
+	for i, item := range doc.Items {
+		if pdf.GetY()+packingListRowHeight > packingListBottom {
+			pdf.AddPage()
+			drawItemsHeader(pdf, tr)
+			pdf.SetFont("Helvetica", "", 8)
+		}
+
+		fill := i%2 == 1
+		pdf.SetFillColor(245, 245, 245)
+		for c, value := range itemValues(item) {
+			col := packingListColumns[c]
+			pdf.CellFormat(col.width, packingListRowHeight, fitCell(pdf, tr(value), col.width-1), "B", 0, col.align, fill, 0, "")
+		}
+		pdf.Ln(-1)
+	}
+
+	// Totais
+	if pdf.GetY()+packingListRowHeight*2 > packingListBottom {
+		pdf.AddPage()
+	}
+	pdf.SetFont("Helvetica", "B", 8)
+	labelWidth := 0.0
+	for _, col := range packingListColumns[:5] {
+		labelWidth += col.width
+	}
+	pdf.CellFormat(labelWidth, packingListRowHeight, "Totais", "T", 0, "R", false, 0, "")
+	pdf.CellFormat(packingListColumns[5].width, packingListRowHeight, strconv.Itoa(doc.TotalSlabs), "T", 0, "R", false, 0, "")
+	pdf.CellFormat(packingListColumns[6].width, packingListRowHeight, formatDecimal(doc.TotalArea, 2), "T", 0, "R", false, 0, "")
+	pdf.CellFormat(packingListColumns[7].width, packingListRowHeight, formatDecimal(doc.TotalWeight, 0), "T", 1, "R", false, 0, "")
+
+	pdf.SetFont("Helvetica", "I", 7)
+	pdf.SetTextColor(100, 100, 100)
+	pdf.CellFormat(0, 5, tr(fmt.Sprintf("Peso estimado pela área e espessura (densidade média de %s kg/m³).", formatDecimal(entity.StoneDensity, 0))), "", 1, "L", false, 0, "")
+	pdf.SetTextColor(0, 0, 0)
+
+	// Observações
+	if doc.Notes != nil && strings.TrimSpace(*doc.Notes) != "" {
+		pdf.Ln(2)
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.CellFormat(0, 5, tr("Observações"), "", 1, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 9)
+		pdf.MultiCell(0, 4.5, tr(*doc.Notes), "", "L", false)
+	}
+
+	// Assinaturas
+	if pdf.GetY()+25 > packingListBottom {
+		pdf.AddPage()
+	}
+	pdf.Ln(15)
+	y := pdf.GetY()
+	pdf.SetDrawColor(0, 0, 0)
+	pdf.Line(packingListMargin, y, packingListMargin+half-10, y)
+	pdf.Line(packingListMargin+half+14, y, 210-packingListMargin, y)
+	pdf.SetFont("Helvetica", "", 8)
+	pdf.SetXY(packingListMargin, y+1)
+	pdf.CellFormat(half-10, 4, tr("Conferido por (expedição)"), "", 0, "C", false, 0, "")
+	pdf.SetXY(packingListMargin+half+14, y+1)
+	pdf.CellFormat(half-10, 4, tr("Recebido por (motorista / cliente)"), "", 0, "C", false, 0, "")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("falha ao gerar PDF do romaneio: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawParty desenha o bloco de emitente/destinatário e retorna a posição final (Y)
+func drawParty(pdf *gofpdf.Fpdf, tr func(string) string, x, y, w float64, title string, party entity.PackingListParty) float64 {
+	pdf.SetXY(x, y)
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.SetTextColor(100, 100, 100)
+	pdf.CellFormat(w, 5, tr(strings.ToUpper(title)), "B", 2, "L", false, 0, "")
+	pdf.SetTextColor(0, 0, 0)
+
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(w, 5, fitCell(pdf, tr(party.Name), w), "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 8)
+	for _, line := range []*string{party.Document, party.Address, party.Contact} {
+		if line != nil && *line != "" {
+			pdf.CellFormat(w, 4, fitCell(pdf, tr(*line), w), "", 2, "L", false, 0, "")
+		}
+	}
+
+	return pdf.GetY()
+}
+
+// drawCarrier desenha os dados da transportadora (ou campos em branco para preenchimento manual)
+func drawCarrier(pdf *gofpdf.Fpdf, tr func(string) string, carrier *entity.PackingListCarrier) {
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.SetTextColor(100, 100, 100)
+	pdf.CellFormat(0, 5, "TRANSPORTADORA", "B", 1, "L", false, 0, "")
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont("Helvetica", "", 9)
+
+	name, document, plate, driver := "", "", "", ""
+	if carrier != nil {
+		name = carrier.Name
+		document = derefString(carrier.Document)
+		plate = derefString(carrier.VehiclePlate)
+		driver = derefString(carrier.DriverName)
+	}
+
+	pdf.CellFormat(95, 5, fitCell(pdf, tr("Nome: "+name), 94), "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 5, tr("CNPJ/CPF: "+document), "", 1, "L", false, 0, "")
+	pdf.CellFormat(95, 5, fitCell(pdf, tr("Motorista: "+driver), 94), "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 5, tr("Placa: "+plate), "", 1, "L", false, 0, "")
+}
+
+func drawItemsHeader(pdf *gofpdf.Fpdf, tr func(string) string) {
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.SetFillColor(230, 230, 230)
+	for _, col := range packingListColumns {
+		pdf.CellFormat(col.width, packingListRowHeight, tr(col.title), "", 0, col.align, true, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+// itemValues retorna os valores da linha na ordem de packingListColumns
+func itemValues(item entity.PackingListItem) []string {
+	piece := "-"
+	if item.RemnantCode != nil {
+		piece = *item.RemnantCode
+	} else if item.SlabNumber != nil {
+		piece = strconv.Itoa(*item.SlabNumber)
+	}
+
+	details := item.Material
+	if item.Finish != "" {
+		if details != "" {
+			details += " / "
+		}
+		details += item.Finish
+	}
+
+	return []string{
+		item.BatchCode,
+		piece,
+		item.ProductName,
+		details,
+		fmt.Sprintf("%s × %s × %s", formatDecimal(item.Height, 1), formatDecimal(item.Width, 1), formatDecimal(item.Thickness, 1)),
+		strconv.Itoa(item.Quantity),
+		formatDecimal(item.Area, 2),
+		formatDecimal(item.Weight, 0),
+	}
+}
+
+// formatDecimal formata números no padrão brasileiro (vírgula decimal)
+func formatDecimal(v float64, decimals int) string {
+	return strings.Replace(strconv.FormatFloat(v, 'f', decimals, 64), ".", ",", 1)
+}
+
+// fitCell corta o texto (já em cp1252) com reticências para caber na largura
+func fitCell(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	const ellipsis = "\x85"
+	for len(text) > 0 && pdf.GetStringWidth(text+ellipsis) > width {
+		text = text[:len(text)-1]
+	}
+	return text + ellipsis
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
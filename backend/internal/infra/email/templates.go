@@ -62,6 +62,21 @@ type OffersEmailData struct {
 	Links         []OfferLink
 }
 
+// StockAlertLine representa um lote em alerta de estoque
+type StockAlertLine struct {
+	BatchCode   string
+	ProductName string
+	Detail      string // ex: "2 chapas disponíveis (limite: 3)"
+}
+
+// StockAlertEmailData contém os dados para o email de alertas de estoque
+type StockAlertEmailData struct {
+	UserName  string
+	LowStock  []StockAlertLine
+	Stale     []StockAlertLine
+	ActionURL string
+}
+
 // Templates HTML para emails - Design System CAVA Premium
 const (
 	// Template base premium que envolve todos os emails
@@ -502,6 +517,38 @@ const (
 <div class="divider"></div>
 
 <p style="color: #888888; font-size: 13px;">Este email foi enviado porque você demonstrou interesse em nossos produtos. Se não deseja mais receber estas mensagens, por favor entre em contato.</p>
+`
+
+	stockAlertContent = `
+<h1>Alertas de Estoque 📦</h1>
+<p>Olá, <strong>{{.UserName}}</strong>!</p>
+<p>Os lotes abaixo cruzaram os limites de estoque configurados para o seu depósito.</p>
+
+{{if .LowStock}}
+<h3 style="margin: 24px 0 12px 0; color: #121212; font-size: 16px;">Estoque baixo</h3>
+<div class="info-box">
+{{range .LowStock}}
+    <p><strong>{{.BatchCode}}</strong>{{if .ProductName}} · {{.ProductName}}{{end}}<br><span style="color: #4A4A4A; font-size: 14px;">{{.Detail}}</span></p>
+{{end}}
+</div>
+{{end}}
+
+{{if .Stale}}
+<h3 style="margin: 24px 0 12px 0; color: #121212; font-size: 16px;">Estoque parado</h3>
+<div class="info-box">
+{{range .Stale}}
+    <p><strong>{{.BatchCode}}</strong>{{if .ProductName}} · {{.ProductName}}{{end}}<br><span style="color: #4A4A4A; font-size: 14px;">{{.Detail}}</span></p>
+{{end}}
+</div>
+{{end}}
+
+{{if .ActionURL}}
+<div style="text-align: center; margin: 32px 0;">
+    <a href="{{.ActionURL}}" class="btn-primary">Ver lotes →</a>
+</div>
+{{end}}
+
+<p style="color: #888888; font-size: 13px;">Cada lote é avisado uma única vez por cruzamento de limite. Os limites podem ser ajustados nas configurações do depósito.</p>
 `
 )
 
@@ -560,6 +607,17 @@ func RenderOffersEmail(data OffersEmailData) (html string, text string, err erro
 	return html, text, nil
 }
 
+// RenderStockAlertEmail gera o HTML do email de alertas de estoque
+func RenderStockAlertEmail(data StockAlertEmailData) (html string, text string, err error) {
+	html, err = renderTemplate("Alertas de Estoque", stockAlertContent, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	text = renderStockAlertText(data)
+	return html, text, nil
+}
+
 // renderTemplate renderiza um template com o conteúdo específico
 func renderTemplate(title string, content string, data interface{}) (string, error) {
 	// Criar struct para o template base
@@ -783,3 +841,55 @@ Se não deseja mais receber estas mensagens, por favor entre em contato.
 `
 	return text
 }
+
+func renderStockAlertText(data StockAlertEmailData) string {
+	text := `═══════════════════════════════════════════
+            CAVA STONE PLATFORM
+═══════════════════════════════════════════
+
+Alertas de Estoque 📦
+
+Olá, ` + data.UserName + `!
+
+Os lotes abaixo cruzaram os limites de estoque configurados para o seu depósito.
+`
+
+	sections := []struct {
+		title string
+		lines []StockAlertLine
+	}{
+		{"ESTOQUE BAIXO", data.LowStock},
+		{"ESTOQUE PARADO", data.Stale},
+	}
+	for _, section := range sections {
+		if len(section.lines) == 0 {
+			continue
+		}
+		text += `
+───────────────────────────────────────────
+` + section.title
+		for _, line := range section.lines {
+			text += `
+• ` + line.BatchCode
+			if line.ProductName != "" {
+				text += ` · ` + line.ProductName
+			}
+			text += ` — ` + line.Detail
+		}
+		text += "\n"
+	}
+
+	if data.ActionURL != "" {
+		text += `
+Ver lotes: ` + data.ActionURL
+	}
+
+	text += `
+
+───────────────────────────────────────────
+Cada lote é avisado uma única vez por cruzamento de limite.
+Este email foi enviado automaticamente pelo sistema CAVA.
+© 2025 CAVA Stone Platform
+`
+	return text
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type batchCodeSequenceRepository struct {
+	db *DB
+}
+
+func NewBatchCodeSequenceRepository(db *DB) *batchCodeSequenceRepository {
+	return &batchCodeSequenceRepository{db: db}
+}
+
+// maxBatchCodeSequenceSQL calcula o maior número já usado pelo prefixo nos lotes da indústria
+const maxBatchCodeSequenceSQL = `
+	SELECT COALESCE(MAX(CAST(SUBSTRING(batch_code FROM 5) AS INTEGER)), 0)
+	FROM batches
+	WHERE industry_id = $1 AND batch_code ~ ('^' || $2 || '-[0-9]{6}$')
+`
+
+func (r *batchCodeSequenceRepository) Next(ctx context.Context, tx *sql.Tx, industryID, prefix string) (int, error) {
+	// O UPSERT mantém o lock da linha até o fim da transação: se o lote não for
+	// criado, o incremento é desfeito junto e a sequência não fica com lacunas
+	query := `
+		INSERT INTO batch_code_sequences (industry_id, prefix, last_value)
+		VALUES ($1, $2, (` + maxBatchCodeSequenceSQL + `) + 1)
+		ON CONFLICT (industry_id, prefix) DO UPDATE
+		SET last_value = batch_code_sequences.last_value + 1, updated_at = CURRENT_TIMESTAMP
+		RETURNING last_value
+	`
+
+	var next int
+	if err := r.db.conn(tx).QueryRowContext(ctx, query, industryID, prefix).Scan(&next); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23514" { // check_violation
+			return 0, errors.ValidationError("Sequência de códigos de lote esgotada para o prefixo " + prefix)
+		}
+		return 0, errors.DatabaseError(err)
+	}
+
+	return next, nil
+}
+
+func (r *batchCodeSequenceRepository) Peek(ctx context.Context, industryID, prefix string) (int, error) {
+	query := `
+		SELECT COALESCE(
+			(SELECT last_value FROM batch_code_sequences WHERE industry_id = $1 AND prefix = $2),
+			(` + maxBatchCodeSequenceSQL + `)
+		) + 1
+	`
+
+	var next int
+	if err := r.db.QueryRowContext(ctx, query, industryID, prefix).Scan(&next); err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return next, nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type batchLineageRepository struct {
+	db *DB
+}
+
+func NewBatchLineageRepository(db *DB) *batchLineageRepository {
+	return &batchLineageRepository{db: db}
+}
+
+func (r *batchLineageRepository) Create(ctx context.Context, tx *sql.Tx, lineage *entity.BatchLineage) error {
+	query := `
+		INSERT INTO batch_lineage (
+			id, parent_batch_id, child_batch_id, operation, quantity, slab_numbers, notes, actor_user_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at
+	`
+
+	var slabNumbers interface{}
+	if len(lineage.SlabNumbers) > 0 {
+		slabNumbers = pq.Array(lineage.SlabNumbers)
+	}
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		lineage.ID, lineage.ParentBatchID, lineage.ChildBatchID, lineage.Operation,
+		lineage.Quantity, slabNumbers, lineage.Notes, lineage.ActorUserID,
+	).Scan(&lineage.CreatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *batchLineageRepository) FindByBatchID(ctx context.Context, batchID string) (*entity.BatchLineageResponse, error) {
+	query := `
+		SELECT l.id, l.parent_batch_id, p.batch_code, l.child_batch_id, c.batch_code,
+		       l.operation, l.quantity, l.slab_numbers, l.notes, l.actor_user_id, u.name, l.created_at
+		FROM batch_lineage l
+		INNER JOIN batches p ON p.id = l.parent_batch_id
+		INNER JOIN batches c ON c.id = l.child_batch_id
+		LEFT JOIN users u ON u.id = l.actor_user_id
+		WHERE l.parent_batch_id = $1 OR l.child_batch_id = $1
+		ORDER BY l.created_at, l.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, batchID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	response := &entity.BatchLineageResponse{
+		Parents:  []entity.BatchLineage{},
+		Children: []entity.BatchLineage{},
+	}
+	for rows.Next() {
+		var l entity.BatchLineage
+		var slabNumbers pq.Int64Array
+		if err := rows.Scan(
+			&l.ID, &l.ParentBatchID, &l.ParentBatchCode, &l.ChildBatchID, &l.ChildBatchCode,
+			&l.Operation, &l.Quantity, &slabNumbers, &l.Notes, &l.ActorUserID, &l.ActorName, &l.CreatedAt,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		for _, n := range slabNumbers {
+			l.SlabNumbers = append(l.SlabNumbers, int(n))
+		}
+		if l.ChildBatchID == batchID {
+			response.Parents = append(response.Parents, l)
+		} else {
+			response.Children = append(response.Children, l)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return response, nil
+}
+
+func (r *batchLineageRepository) CopyReferences(ctx context.Context, tx *sql.Tx, fromBatchID, toBatchID string, quantity int) error {
+	queries := []string{
+		// Mídias: as fotos do lote de origem também representam as chapas divididas
+		`INSERT INTO batch_medias (batch_id, url, display_order)
+		 SELECT $2, url, display_order FROM batch_medias WHERE batch_id = $1`,
+
+		// Compartilhamentos: quem via o lote de origem passa a ver o novo lote, com o mesmo preço negociado
+		`INSERT INTO shared_inventory_batches (batch_id, shared_with_user_id, industry_owner_id, negotiated_price, negotiated_price_unit, is_active)
+		 SELECT $2, shared_with_user_id, industry_owner_id, negotiated_price, negotiated_price_unit, is_active
+		 FROM shared_inventory_batches WHERE batch_id = $1
+		 ON CONFLICT (batch_id, shared_with_user_id) DO NOTHING`,
+
+		// Catálogos: o novo lote aparece logo após o lote de origem
+		`INSERT INTO catalog_link_batches (catalog_link_id, batch_id, display_order)
+		 SELECT catalog_link_id, $2, display_order FROM catalog_link_batches WHERE batch_id = $1
+		 ON CONFLICT (catalog_link_id, batch_id) DO NOTHING`,
+	}
+
+	conn := r.db.conn(tx)
+	for _, query := range queries {
+		if _, err := conn.ExecContext(ctx, query, fromBatchID, toBatchID); err != nil {
+			return errors.DatabaseError(err)
+		}
+	}
+
+	// Links com múltiplos lotes: o novo lote entra com o mesmo preço unitário, limitado às chapas divididas
+	query := `
+		INSERT INTO sales_link_items (sales_link_id, batch_id, quantity, unit_price)
+		SELECT sales_link_id, $2, LEAST(quantity, $3), unit_price
+		FROM sales_link_items WHERE batch_id = $1
+		ON CONFLICT (sales_link_id, batch_id) DO NOTHING
+	`
+	if _, err := conn.ExecContext(ctx, query, fromBatchID, toBatchID, quantity); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *batchLineageRepository) MoveReferences(ctx context.Context, tx *sql.Tx, fromBatchID, toBatchID string) error {
+	queries := []string{
+		// Mídias: anexadas após as mídias do lote de destino
+		`INSERT INTO batch_medias (batch_id, url, display_order)
+		 SELECT $2, m.url, m.display_order + COALESCE((SELECT MAX(display_order) + 1 FROM batch_medias WHERE batch_id = $2), 0)
+		 FROM batch_medias m
+		 WHERE m.batch_id = $1
+		   AND NOT EXISTS (SELECT 1 FROM batch_medias d WHERE d.batch_id = $2 AND d.url = m.url)`,
+
+		// Compartilhamentos: o destino mantém o compartilhamento existente quando já houver
+		`INSERT INTO shared_inventory_batches (batch_id, shared_with_user_id, industry_owner_id, negotiated_price, negotiated_price_unit, is_active)
+		 SELECT $2, shared_with_user_id, industry_owner_id, negotiated_price, negotiated_price_unit, is_active
+		 FROM shared_inventory_batches WHERE batch_id = $1
+		 ON CONFLICT (batch_id, shared_with_user_id) DO NOTHING`,
+		`DELETE FROM shared_inventory_batches WHERE batch_id = $1`,
+
+		`INSERT INTO catalog_link_batches (catalog_link_id, batch_id, display_order)
+		 SELECT catalog_link_id, $2, display_order FROM catalog_link_batches WHERE batch_id = $1
+		 ON CONFLICT (catalog_link_id, batch_id) DO NOTHING`,
+		`DELETE FROM catalog_link_batches WHERE batch_id = $1`,
+
+		// Itens de links com múltiplos lotes: quantidades somadas quando o destino já estiver no link
+		`INSERT INTO sales_link_items (sales_link_id, batch_id, quantity, unit_price)
+		 SELECT sales_link_id, $2, quantity, unit_price FROM sales_link_items WHERE batch_id = $1
+		 ON CONFLICT (sales_link_id, batch_id) DO UPDATE SET quantity = sales_link_items.quantity + EXCLUDED.quantity`,
+		`DELETE FROM sales_link_items WHERE batch_id = $1`,
+
+		// Links de lote único passam a apontar para o destino
+		`UPDATE sales_links SET batch_id = $2, updated_at = CURRENT_TIMESTAMP WHERE batch_id = $1`,
+	}
+
+	conn := r.db.conn(tx)
+	for _, query := range queries {
+		if _, err := conn.ExecContext(ctx, query, fromBatchID, toBatchID); err != nil {
+			return errors.DatabaseError(err)
+		}
+	}
+
+	return nil
+}
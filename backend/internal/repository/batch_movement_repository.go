@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type batchMovementRepository struct {
+	db *DB
+}
+
+func NewBatchMovementRepository(db *DB) *batchMovementRepository {
+	return &batchMovementRepository{db: db}
+}
+
+func (r *batchMovementRepository) Create(ctx context.Context, tx *sql.Tx, movement *entity.BatchMovement) error {
+	query := `
+		INSERT INTO batch_movements (
+			id, batch_id, from_status, to_status, quantity, slab_numbers,
+			reason, notes, actor_user_id, reservation_id, sale_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING created_at
+	`
+
+	var slabNumbers interface{}
+	if len(movement.SlabNumbers) > 0 {
+		slabNumbers = pq.Array(movement.SlabNumbers)
+	}
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		movement.ID, movement.BatchID, movement.FromStatus, movement.ToStatus, movement.Quantity, slabNumbers,
+		movement.Reason, movement.Notes, movement.ActorUserID, movement.ReservationID, movement.SaleID,
+	).Scan(&movement.CreatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *batchMovementRepository) ListByBatchID(ctx context.Context, batchID string, filters entity.BatchMovementFilters) ([]entity.BatchMovement, int, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	where := sq.And{sq.Eq{"m.batch_id": batchID}}
+	if filters.Reason != nil {
+		where = append(where, sq.Eq{"m.reason": *filters.Reason})
+	}
+
+	countSQL, countArgs, err := psql.Select("COUNT(*)").From("batch_movements m").Where(where).ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countSQL, countArgs...).Scan(&total); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	offset := (filters.Page - 1) * filters.Limit
+	query, args, err := psql.Select(
+		"m.id", "m.batch_id", "m.from_status", "m.to_status", "m.quantity", "m.slab_numbers",
+		"m.reason", "m.notes", "m.actor_user_id", "u.name", "m.reservation_id", "m.sale_id", "m.created_at",
+	).
+		From("batch_movements m").
+		LeftJoin("users u ON m.actor_user_id = u.id").
+		Where(where).
+		OrderBy("m.created_at DESC", "m.id").
+		Limit(uint64(filters.Limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	movements := []entity.BatchMovement{}
+	for rows.Next() {
+		var m entity.BatchMovement
+		var slabNumbers pq.Int64Array
+		if err := rows.Scan(
+			&m.ID, &m.BatchID, &m.FromStatus, &m.ToStatus, &m.Quantity, &slabNumbers,
+			&m.Reason, &m.Notes, &m.ActorUserID, &m.ActorName, &m.ReservationID, &m.SaleID, &m.CreatedAt,
+		); err != nil {
+			return nil, 0, errors.DatabaseError(err)
+		}
+		for _, n := range slabNumbers {
+			m.SlabNumbers = append(m.SlabNumbers, int(n))
+		}
+		movements = append(movements, m)
+	}
+
+	return movements, total, nil
+}
+
+func (r *batchMovementRepository) Balance(ctx context.Context, batchID string) (entity.SlabCounts, error) {
+	query := `
+		SELECT
+			COALESCE(SUM(CASE WHEN to_status = 'DISPONIVEL' THEN quantity ELSE 0 END), 0)
+				- COALESCE(SUM(CASE WHEN from_status = 'DISPONIVEL' THEN quantity ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN to_status = 'RESERVADO' THEN quantity ELSE 0 END), 0)
+				- COALESCE(SUM(CASE WHEN from_status = 'RESERVADO' THEN quantity ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN to_status = 'VENDIDO' THEN quantity ELSE 0 END), 0)
+				- COALESCE(SUM(CASE WHEN from_status = 'VENDIDO' THEN quantity ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN to_status = 'INATIVO' THEN quantity ELSE 0 END), 0)
+				- COALESCE(SUM(CASE WHEN from_status = 'INATIVO' THEN quantity ELSE 0 END), 0)
+		FROM batch_movements
+		WHERE batch_id = $1
+	`
+
+	var counts entity.SlabCounts
+	err := r.db.QueryRowContext(ctx, query, batchID).Scan(
+		&counts.Available, &counts.Reserved, &counts.Sold, &counts.Inactive,
+	)
+	if err != nil {
+		return entity.SlabCounts{}, errors.DatabaseError(err)
+	}
+
+	return counts, nil
+}
@@ -18,21 +18,23 @@ func NewBatchRepository(db *DB) *batchRepository {
 	return &batchRepository{db: db}
 }
 
-func (r *batchRepository) Create(ctx context.Context, batch *entity.Batch) error {
+func (r *batchRepository) Create(ctx context.Context, tx *sql.Tx, batch *entity.Batch) error {
 	query := `
 		INSERT INTO batches (
 			id, product_id, industry_id, batch_code, height, width, thickness,
 			quantity_slabs, available_slabs, reserved_slabs, sold_slabs, inactive_slabs,
-			industry_price, price_unit, price_override, origin_quarry, entry_date, status, is_public
-			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+			industry_price, price_unit, price_override, origin_quarry, entry_date, status, is_public, block_id,
+			warehouse_id, location_id, currency
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)
 		RETURNING created_at, updated_at, net_area
 	`
 
-	err := r.db.QueryRowContext(ctx, query,
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
 		batch.ID, batch.ProductID, batch.IndustryID, batch.BatchCode,
 		batch.Height, batch.Width, batch.Thickness, batch.QuantitySlabs,
 		batch.AvailableSlabs, batch.ReservedSlabs, batch.SoldSlabs, batch.InactiveSlabs,
-		batch.IndustryPrice, batch.PriceUnit, batch.PriceOverride, batch.OriginQuarry, batch.EntryDate, batch.Status, batch.IsPublic,
+		batch.IndustryPrice, batch.PriceUnit, batch.PriceOverride, batch.OriginQuarry, batch.EntryDate, batch.Status, batch.IsPublic, batch.BlockID,
+		batch.WarehouseID, batch.LocationID, batch.Currency.OrDefault(),
 	).Scan(&batch.CreatedAt, &batch.UpdatedAt, &batch.TotalArea)
 
 	if err != nil {
@@ -54,7 +56,7 @@ func (r *batchRepository) FindByID(ctx context.Context, id string) (*entity.Batc
 	query := `
 		SELECT id, product_id, industry_id, batch_code, height, width, thickness,
 		       quantity_slabs, available_slabs, reserved_slabs, sold_slabs, inactive_slabs,
-		       net_area, industry_price, price_unit, COALESCE(price_override, FALSE), origin_quarry, 
+		       net_area, industry_price, price_unit, currency, COALESCE(price_override, FALSE), origin_quarry, block_id, warehouse_id, location_id,
 		       entry_date, status, is_active, is_public, created_at, updated_at, deleted_at
 		FROM batches
 		WHERE id = $1
@@ -65,8 +67,8 @@ func (r *batchRepository) FindByID(ctx context.Context, id string) (*entity.Batc
 		&batch.ID, &batch.ProductID, &batch.IndustryID, &batch.BatchCode,
 		&batch.Height, &batch.Width, &batch.Thickness, &batch.QuantitySlabs,
 		&batch.AvailableSlabs, &batch.ReservedSlabs, &batch.SoldSlabs, &batch.InactiveSlabs,
-		&batch.TotalArea, &batch.IndustryPrice, &batch.PriceUnit, &batch.PriceOverride,
-		&batch.OriginQuarry, &batch.EntryDate, &batch.Status, &batch.IsActive, &batch.IsPublic,
+		&batch.TotalArea, &batch.IndustryPrice, &batch.PriceUnit, &batch.Currency, &batch.PriceOverride,
+		&batch.OriginQuarry, &batch.BlockID, &batch.WarehouseID, &batch.LocationID, &batch.EntryDate, &batch.Status, &batch.IsActive, &batch.IsPublic,
 		&batch.CreatedAt, &batch.UpdatedAt, &batch.DeletedAt,
 	)
 
@@ -87,7 +89,7 @@ func (r *batchRepository) FindByIDForUpdate(ctx context.Context, tx *sql.Tx, id
 	query := `
 		SELECT id, product_id, industry_id, batch_code, height, width, thickness,
 		       quantity_slabs, available_slabs, reserved_slabs, sold_slabs, inactive_slabs,
-		       net_area, industry_price, price_unit, origin_quarry, 
+		       net_area, industry_price, price_unit, currency, COALESCE(price_override, FALSE), origin_quarry, block_id, warehouse_id, location_id,
 		       entry_date, status, is_active, is_public, created_at, updated_at, deleted_at
 		FROM batches
 		WHERE id = $1
@@ -99,8 +101,8 @@ func (r *batchRepository) FindByIDForUpdate(ctx context.Context, tx *sql.Tx, id
 		&batch.ID, &batch.ProductID, &batch.IndustryID, &batch.BatchCode,
 		&batch.Height, &batch.Width, &batch.Thickness, &batch.QuantitySlabs,
 		&batch.AvailableSlabs, &batch.ReservedSlabs, &batch.SoldSlabs, &batch.InactiveSlabs,
-		&batch.TotalArea, &batch.IndustryPrice, &batch.PriceUnit,
-		&batch.OriginQuarry, &batch.EntryDate, &batch.Status, &batch.IsActive, &batch.IsPublic,
+		&batch.TotalArea, &batch.IndustryPrice, &batch.PriceUnit, &batch.Currency, &batch.PriceOverride,
+		&batch.OriginQuarry, &batch.BlockID, &batch.WarehouseID, &batch.LocationID, &batch.EntryDate, &batch.Status, &batch.IsActive, &batch.IsPublic,
 		&batch.CreatedAt, &batch.UpdatedAt, &batch.DeletedAt,
 	)
 
@@ -118,7 +120,7 @@ func (r *batchRepository) FindByProductID(ctx context.Context, productID string)
 	query := `
 		SELECT id, product_id, industry_id, batch_code, height, width, thickness,
 		       quantity_slabs, available_slabs, reserved_slabs, sold_slabs, inactive_slabs,
-		       net_area, industry_price, price_unit, COALESCE(price_override, FALSE), origin_quarry, 
+		       net_area, industry_price, price_unit, currency, COALESCE(price_override, FALSE), origin_quarry, block_id, warehouse_id, location_id,
 		       entry_date, status, is_active, is_public, created_at, updated_at, deleted_at
 		FROM batches
 		WHERE product_id = $1 AND is_active = TRUE AND deleted_at IS NULL
@@ -138,7 +140,7 @@ func (r *batchRepository) FindByStatus(ctx context.Context, industryID string, s
 	query := `
 		SELECT id, product_id, industry_id, batch_code, height, width, thickness,
 		       quantity_slabs, available_slabs, reserved_slabs, sold_slabs, inactive_slabs,
-		       net_area, industry_price, price_unit, COALESCE(price_override, FALSE), origin_quarry, 
+		       net_area, industry_price, price_unit, currency, COALESCE(price_override, FALSE), origin_quarry, block_id, warehouse_id, location_id,
 		       entry_date, status, is_active, is_public, created_at, updated_at, deleted_at
 		FROM batches
 		WHERE industry_id = $1 AND status = $2 AND is_active = TRUE AND deleted_at IS NULL
@@ -158,7 +160,7 @@ func (r *batchRepository) FindAvailable(ctx context.Context, industryID string)
 	query := `
 		SELECT id, product_id, industry_id, batch_code, height, width, thickness,
 		       quantity_slabs, available_slabs, reserved_slabs, sold_slabs, inactive_slabs,
-		       net_area, industry_price, price_unit, COALESCE(price_override, FALSE), origin_quarry, 
+		       net_area, industry_price, price_unit, currency, COALESCE(price_override, FALSE), origin_quarry, block_id, warehouse_id, location_id,
 		       entry_date, status, is_active, is_public, created_at, updated_at, deleted_at
 		FROM batches
 		WHERE industry_id = $1 AND status = 'DISPONIVEL' AND is_active = TRUE AND available_slabs > 0 AND deleted_at IS NULL
@@ -178,7 +180,7 @@ func (r *batchRepository) FindByCode(ctx context.Context, industryID, code strin
 	query := `
 		SELECT id, product_id, industry_id, batch_code, height, width, thickness,
 		       quantity_slabs, available_slabs, reserved_slabs, sold_slabs, inactive_slabs,
-		       net_area, industry_price, price_unit, COALESCE(price_override, FALSE), origin_quarry, 
+		       net_area, industry_price, price_unit, currency, COALESCE(price_override, FALSE), origin_quarry, block_id, warehouse_id, location_id,
 		       entry_date, status, is_active, is_public, created_at, updated_at, deleted_at
 		FROM batches
 		WHERE industry_id = $1 AND batch_code ILIKE $2 AND is_active = TRUE AND deleted_at IS NULL
@@ -198,92 +200,200 @@ func (r *batchRepository) FindByCode(ctx context.Context, industryID, code strin
 func (r *batchRepository) List(ctx context.Context, industryID string, filters entity.BatchFilters) ([]entity.Batch, int, error) {
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
+	where := batchFilterConditions("", industryID, filters)
+
 	query := psql.Select(
 		"id", "product_id", "industry_id", "batch_code", "height", "width",
-		"thickness", "quantity_slabs", "available_slabs", "reserved_slabs", "sold_slabs", "inactive_slabs", "net_area", "industry_price", "price_unit",
-		"COALESCE(price_override, FALSE)", "origin_quarry", "entry_date", "status", "is_active", "is_public", "created_at", "updated_at", "deleted_at",
+		"thickness", "quantity_slabs", "available_slabs", "reserved_slabs", "sold_slabs", "inactive_slabs", "net_area", "industry_price", "price_unit", "currency",
+		"COALESCE(price_override, FALSE)", "origin_quarry", "block_id", "warehouse_id", "location_id", "entry_date", "status", "is_active", "is_public", "created_at", "updated_at", "deleted_at",
 	).From("batches").
-		Where(sq.Eq{"industry_id": industryID})
+		Where(where)
 
-	// Filtro de deletados
-	if filters.OnlyDeleted {
-		query = query.Where("deleted_at IS NOT NULL")
-	} else if !filters.IncludeDeleted {
-		query = query.Where("deleted_at IS NULL")
+	// Contar total
+	countQuery := psql.Select("COUNT(*)").From("batches").Where(where)
+
+	countSQL, countArgs, _ := countQuery.ToSql()
+	var total int
+	if err := r.db.QueryRowContext(ctx, countSQL, countArgs...).Scan(&total); err != nil {
+		return nil, 0, errors.DatabaseError(err)
 	}
 
-	// Filtro de arquivados
-	if filters.OnlyArchived {
-		query = query.Where(sq.Eq{"is_active": false})
-	} else if !filters.IncludeArchived {
-		query = query.Where(sq.Eq{"is_active": true})
+	// Paginação e ordenação
+	offset := (filters.Page - 1) * filters.Limit
+
+	query = batchSearchRank(query, "", filters)
+	query = query.OrderBy(batchOrderBy("", filters)).Limit(uint64(filters.Limit)).Offset(uint64(offset))
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
 	}
 
-	// Filtros
-	if filters.ProductID != nil {
-		query = query.Where(sq.Eq{"product_id": *filters.ProductID})
+	rows, err := r.db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
 	}
-	if filters.Status != nil {
-		query = query.Where(sq.Eq{"status": *filters.Status})
+	defer rows.Close()
+
+	batches, err := r.scanBatches(rows)
+	if err != nil {
+		return nil, 0, err
 	}
-	if filters.Code != nil && *filters.Code != "" {
-		query = query.Where("batch_code ILIKE ?", "%"+*filters.Code+"%")
+
+	return batches, total, nil
+}
+
+func (r *batchRepository) Stream(ctx context.Context, industryID string, filters entity.BatchFilters, fn func(*entity.Batch) error) error {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	builder := psql.Select(
+		"b.id", "b.product_id", "b.industry_id", "b.batch_code", "b.height", "b.width",
+		"b.thickness", "b.quantity_slabs", "b.available_slabs", "b.reserved_slabs", "b.sold_slabs", "b.inactive_slabs", "b.net_area", "b.industry_price", "b.price_unit", "b.currency",
+		"COALESCE(b.price_override, FALSE)", "b.origin_quarry", "b.block_id", "b.warehouse_id", "b.location_id", "b.entry_date", "b.status", "b.is_active", "b.is_public", "b.created_at", "b.updated_at", "b.deleted_at",
+		"p.name", "p.sku_code", "p.material_type", "p.finish_type",
+	).From("batches b").
+		LeftJoin("products p ON p.id = b.product_id").
+		Where(batchFilterConditions("b.", industryID, filters))
+
+	query, args, err := batchSearchRank(builder, "b.", filters).
+		OrderBy(batchOrderBy("b.", filters), "b.id").
+		ToSql()
+	if err != nil {
+		return errors.DatabaseError(err)
 	}
-	if filters.OnlyWithAvailable {
-		query = query.Where(sq.Gt{"available_slabs": 0})
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return errors.DatabaseError(err)
 	}
-	if filters.LowStock {
-		query = query.Where(sq.And{sq.Gt{"available_slabs": 0}, sq.LtOrEq{"available_slabs": 3}})
+	defer rows.Close()
+
+	for rows.Next() {
+		var b entity.Batch
+		var productName, productSKU, material, finish sql.NullString
+		if err := rows.Scan(
+			&b.ID, &b.ProductID, &b.IndustryID, &b.BatchCode,
+			&b.Height, &b.Width, &b.Thickness, &b.QuantitySlabs,
+			&b.AvailableSlabs, &b.ReservedSlabs, &b.SoldSlabs, &b.InactiveSlabs, &b.TotalArea, &b.IndustryPrice, &b.PriceUnit, &b.Currency,
+			&b.PriceOverride, &b.OriginQuarry, &b.BlockID, &b.WarehouseID, &b.LocationID, &b.EntryDate, &b.Status, &b.IsActive, &b.IsPublic,
+			&b.CreatedAt, &b.UpdatedAt, &b.DeletedAt,
+			&productName, &productSKU, &material, &finish,
+		); err != nil {
+			return errors.DatabaseError(err)
+		}
+		b.PopulateCalculatedFields()
+
+		if productName.Valid {
+			b.Product = &entity.Product{
+				ID:       b.ProductID,
+				Name:     productName.String,
+				Material: entity.MaterialType(material.String),
+				Finish:   entity.FinishType(finish.String),
+			}
+			if productSKU.Valid {
+				b.Product.SKU = &productSKU.String
+			}
+		}
+
+		if err := fn(&b); err != nil {
+			return err
+		}
 	}
-	if filters.NoStock {
-		query = query.Where(sq.Eq{"available_slabs": 0})
+
+	if err := rows.Err(); err != nil {
+		return errors.DatabaseError(err)
 	}
 
-	// Contar total
-	countQuery := psql.Select("COUNT(*)").From("batches").
-		Where(sq.Eq{"industry_id": industryID})
+	return nil
+}
 
+// batchFilterConditions monta as condições de BatchFilters (prefix = alias da tabela, ex.: "b.")
+func batchFilterConditions(prefix, industryID string, filters entity.BatchFilters) sq.And {
+	where := sq.And{sq.Eq{prefix + "industry_id": industryID}}
+
+	// Filtro de deletados
 	if filters.OnlyDeleted {
-		countQuery = countQuery.Where("deleted_at IS NOT NULL")
+		where = append(where, sq.NotEq{prefix + "deleted_at": nil})
 	} else if !filters.IncludeDeleted {
-		countQuery = countQuery.Where("deleted_at IS NULL")
+		where = append(where, sq.Eq{prefix + "deleted_at": nil})
 	}
 
+	// Filtro de arquivados
 	if filters.OnlyArchived {
-		countQuery = countQuery.Where(sq.Eq{"is_active": false})
+		where = append(where, sq.Eq{prefix + "is_active": false})
 	} else if !filters.IncludeArchived {
-		countQuery = countQuery.Where(sq.Eq{"is_active": true})
+		where = append(where, sq.Eq{prefix + "is_active": true})
 	}
 
+	// Filtros
+	if len(filters.IDs) > 0 {
+		where = append(where, sq.Eq{prefix + "id": filters.IDs})
+	}
 	if filters.ProductID != nil {
-		countQuery = countQuery.Where(sq.Eq{"product_id": *filters.ProductID})
+		where = append(where, sq.Eq{prefix + "product_id": *filters.ProductID})
+	}
+	if filters.BlockID != nil {
+		where = append(where, sq.Eq{prefix + "block_id": *filters.BlockID})
+	}
+	if filters.WarehouseID != nil {
+		where = append(where, sq.Eq{prefix + "warehouse_id": *filters.WarehouseID})
+	}
+	if filters.LocationID != nil {
+		where = append(where, sq.Eq{prefix + "location_id": *filters.LocationID})
 	}
 	if filters.Status != nil {
-		countQuery = countQuery.Where(sq.Eq{"status": *filters.Status})
+		where = append(where, sq.Eq{prefix + "status": *filters.Status})
 	}
 	if filters.Code != nil && *filters.Code != "" {
-		countQuery = countQuery.Where("batch_code ILIKE ?", "%"+*filters.Code+"%")
+		where = append(where, sq.ILike{prefix + "batch_code": "%" + *filters.Code + "%"})
 	}
 	if filters.OnlyWithAvailable {
-		countQuery = countQuery.Where(sq.Gt{"available_slabs": 0})
+		where = append(where, sq.Gt{prefix + "available_slabs": 0})
 	}
+	table := batchTable(prefix)
+	// Busca textual (código, pedreira e dados do produto)
+	if search := searchTerm(filters.Search); search != "" {
+		where = append(where, searchCondition(table, search))
+	}
+	// Limites efetivos do lote (produto > indústria), ver view batch_stock_thresholds
 	if filters.LowStock {
-		countQuery = countQuery.Where(sq.And{sq.Gt{"available_slabs": 0}, sq.LtOrEq{"available_slabs": 3}})
+		where = append(where,
+			sq.Gt{prefix + "available_slabs": 0},
+			sq.Expr(table+"available_slabs <= (SELECT t.low_stock_slabs FROM batch_stock_thresholds t WHERE t.batch_id = "+table+"id)"),
+		)
+	}
+	if filters.Stale {
+		where = append(where, sq.Expr(
+			"EXTRACT(EPOCH FROM (NOW() - COALESCE("+table+"last_activity_at, "+table+"entry_date)))/86400 > (SELECT t.stale_days FROM batch_stock_thresholds t WHERE t.batch_id = "+table+"id)",
+		))
 	}
 	if filters.NoStock {
-		countQuery = countQuery.Where(sq.Eq{"available_slabs": 0})
+		where = append(where, sq.Eq{prefix + "available_slabs": 0})
 	}
 
-	countSQL, countArgs, _ := countQuery.ToSql()
-	var total int
-	if err := r.db.QueryRowContext(ctx, countSQL, countArgs...).Scan(&total); err != nil {
-		return nil, 0, errors.DatabaseError(err)
+	return where
+}
+
+// batchTable retorna o prefixo qualificado das colunas de batches (sem alias, "batches.")
+func batchTable(prefix string) string {
+	if prefix == "" {
+		return "batches."
 	}
+	return prefix
+}
 
-	// Paginação e ordenação
-	offset := (filters.Page - 1) * filters.Limit
+// batchSearchRank aplica a ordenação por relevância quando há busca sem ordenação explícita
+func batchSearchRank(query sq.SelectBuilder, prefix string, filters entity.BatchFilters) sq.SelectBuilder {
+	search := searchTerm(filters.Search)
+	if search == "" || filters.SortBy != "" {
+		return query
+	}
+	rank, args := searchRankOrder(batchTable(prefix), search)
+	return query.OrderByClause(rank, args...)
+}
 
-	// Determinar ordenação
+// batchOrderBy retorna a cláusula de ordenação a partir de SortBy/SortDir
+func batchOrderBy(prefix string, filters entity.BatchFilters) string {
 	orderColumn := "entry_date"
 	orderDir := "DESC"
 
@@ -308,50 +418,80 @@ func (r *batchRepository) List(ctx context.Context, industryID string, filters e
 		orderDir = "DESC"
 	}
 
-	query = query.OrderBy(orderColumn + " " + orderDir).Limit(uint64(filters.Limit)).Offset(uint64(offset))
+	return prefix + orderColumn + " " + orderDir
+}
 
-	sql, args, err := query.ToSql()
+func (r *batchRepository) Update(ctx context.Context, tx *sql.Tx, batch *entity.Batch) error {
+	query := `
+		UPDATE batches
+		SET batch_code = $1, height = $2, width = $3, thickness = $4,
+		    quantity_slabs = $5, available_slabs = $6, industry_price = $7, price_unit = $8,
+		    price_override = $9, origin_quarry = $10, is_public = $11, block_id = $12, currency = $13, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $14
+		RETURNING updated_at, net_area
+	`
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		batch.BatchCode, batch.Height, batch.Width, batch.Thickness,
+		batch.QuantitySlabs, batch.AvailableSlabs, batch.IndustryPrice, batch.PriceUnit,
+		batch.PriceOverride, batch.OriginQuarry, batch.IsPublic, batch.BlockID, batch.Currency.OrDefault(), batch.ID,
+	).Scan(&batch.UpdatedAt, &batch.TotalArea)
+
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("Lote")
+	}
 	if err != nil {
-		return nil, 0, errors.DatabaseError(err)
+		return errors.DatabaseError(err)
 	}
 
-	rows, err := r.db.QueryContext(ctx, sql, args...)
+	return nil
+}
+
+func (r *batchRepository) UpdateLocation(ctx context.Context, tx *sql.Tx, id string, warehouseID, locationID *string) error {
+	query := `
+		UPDATE batches
+		SET warehouse_id = $1, location_id = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, warehouseID, locationID, id)
 	if err != nil {
-		return nil, 0, errors.DatabaseError(err)
+		return errors.DatabaseError(err)
 	}
-	defer rows.Close()
 
-	batches, err := r.scanBatches(rows)
+	rows, err := result.RowsAffected()
 	if err != nil {
-		return nil, 0, err
+		return errors.DatabaseError(err)
 	}
 
-	return batches, total, nil
+	if rows == 0 {
+		return errors.NewNotFoundError("Lote")
+	}
+
+	return nil
 }
 
-func (r *batchRepository) Update(ctx context.Context, batch *entity.Batch) error {
+func (r *batchRepository) UpdatePrice(ctx context.Context, tx *sql.Tx, id string, price float64, unit entity.PriceUnit) error {
 	query := `
 		UPDATE batches
-		SET batch_code = $1, height = $2, width = $3, thickness = $4,
-		    quantity_slabs = $5, available_slabs = $6, industry_price = $7, price_unit = $8,
-		    price_override = $9, origin_quarry = $10, is_public = $11, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $12
-		RETURNING updated_at, net_area
+		SET industry_price = $1, price_unit = $2, price_override = TRUE, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3 AND deleted_at IS NULL
 	`
 
-	err := r.db.QueryRowContext(ctx, query,
-		batch.BatchCode, batch.Height, batch.Width, batch.Thickness,
-		batch.QuantitySlabs, batch.AvailableSlabs, batch.IndustryPrice, batch.PriceUnit,
-		batch.PriceOverride, batch.OriginQuarry, batch.IsPublic, batch.ID,
-	).Scan(&batch.UpdatedAt, &batch.TotalArea)
-
-	if err == sql.ErrNoRows {
-		return errors.NewNotFoundError("Lote")
+	result, err := r.db.conn(tx).ExecContext(ctx, query, price, unit, id)
+	if err != nil {
+		return errors.DatabaseError(err)
 	}
+
+	rows, err := result.RowsAffected()
 	if err != nil {
 		return errors.DatabaseError(err)
 	}
 
+	if rows == 0 {
+		return errors.NewNotFoundError("Lote")
+	}
+
 	return nil
 }
 
@@ -452,6 +592,31 @@ func (r *batchRepository) UpdateSlabCounts(ctx context.Context, tx *sql.Tx, id s
 	return nil
 }
 
+func (r *batchRepository) UpdateSlabTotals(ctx context.Context, tx *sql.Tx, id string, quantity, available, reserved, sold, inactive int) error {
+	query := `
+		UPDATE batches
+		SET quantity_slabs = $1, available_slabs = $2, reserved_slabs = $3, sold_slabs = $4, inactive_slabs = $5,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, quantity, available, reserved, sold, inactive, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Lote")
+	}
+
+	return nil
+}
+
 func (r *batchRepository) DecrementAvailableSlabs(ctx context.Context, tx *sql.Tx, id string, quantity int) error {
 	query := `
 		UPDATE batches
@@ -532,7 +697,7 @@ func (r *batchRepository) CountByStatus(ctx context.Context, industryID string,
 	return count, nil
 }
 
-func (r *batchRepository) ExistsByCode(ctx context.Context, industryID, code string) (bool, error) {
+func (r *batchRepository) ExistsByCode(ctx context.Context, tx *sql.Tx, industryID, code string) (bool, error) {
 	query := `
 		SELECT EXISTS(
 			SELECT 1 FROM batches 
@@ -541,7 +706,7 @@ func (r *batchRepository) ExistsByCode(ctx context.Context, industryID, code str
 	`
 
 	var exists bool
-	err := r.db.QueryRowContext(ctx, query, industryID, code).Scan(&exists)
+	err := r.db.conn(tx).QueryRowContext(ctx, query, industryID, code).Scan(&exists)
 	if err != nil {
 		return false, errors.DatabaseError(err)
 	}
@@ -556,8 +721,8 @@ func (r *batchRepository) scanBatches(rows *sql.Rows) ([]entity.Batch, error) {
 		if err := rows.Scan(
 			&b.ID, &b.ProductID, &b.IndustryID, &b.BatchCode,
 			&b.Height, &b.Width, &b.Thickness, &b.QuantitySlabs,
-			&b.AvailableSlabs, &b.ReservedSlabs, &b.SoldSlabs, &b.InactiveSlabs, &b.TotalArea, &b.IndustryPrice, &b.PriceUnit,
-			&b.PriceOverride, &b.OriginQuarry, &b.EntryDate, &b.Status, &b.IsActive, &b.IsPublic,
+			&b.AvailableSlabs, &b.ReservedSlabs, &b.SoldSlabs, &b.InactiveSlabs, &b.TotalArea, &b.IndustryPrice, &b.PriceUnit, &b.Currency,
+			&b.PriceOverride, &b.OriginQuarry, &b.BlockID, &b.WarehouseID, &b.LocationID, &b.EntryDate, &b.Status, &b.IsActive, &b.IsPublic,
 			&b.CreatedAt, &b.UpdatedAt, &b.DeletedAt,
 		); err != nil {
 			return nil, errors.DatabaseError(err)
@@ -569,14 +734,14 @@ func (r *batchRepository) scanBatches(rows *sql.Rows) ([]entity.Batch, error) {
 	return batches, nil
 }
 
-func (r *batchRepository) Archive(ctx context.Context, id string) error {
+func (r *batchRepository) Archive(ctx context.Context, tx *sql.Tx, id string) error {
 	query := `
 		UPDATE batches
 		SET is_active = FALSE, updated_at = CURRENT_TIMESTAMP
 		WHERE id = $1
 	`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.db.conn(tx).ExecContext(ctx, query, id)
 	if err != nil {
 		return errors.DatabaseError(err)
 	}
@@ -641,21 +806,37 @@ func (r *batchRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *batchRepository) FindPublicBatchesByIndustrySlug(ctx context.Context, slug string) ([]entity.PublicBatch, error) {
+func (r *batchRepository) FindPublicBatchesByIndustrySlug(ctx context.Context, slug string, filters entity.PublicBatchFilters) ([]entity.PublicBatch, error) {
 	query := `
 		SELECT b.batch_code, b.height, b.width, b.thickness, b.net_area, b.available_slabs, b.origin_quarry,
-		       p.name, p.material_type, p.finish_type
+		       p.name, p.material_type, p.finish_type, w.code, w.name, wl.code
 		FROM batches b
 		INNER JOIN industries i ON b.industry_id = i.id
 		LEFT JOIN products p ON b.product_id = p.id
+		LEFT JOIN warehouses w ON b.warehouse_id = w.id
+		LEFT JOIN warehouse_locations wl ON b.location_id = wl.id
 		WHERE i.slug = $1
 			AND (b.is_public = TRUE OR COALESCE(p.is_public_catalog, FALSE) = TRUE)
 			AND b.deleted_at IS NULL
 			AND b.is_active = TRUE
-		ORDER BY b.entry_date DESC
+			AND ($2::text IS NULL OR w.code = $2)
+			AND ($3::text IS NULL OR wl.code = $3)
+			AND ($4::text IS NULL
+				OR b.search_vector @@ search_tsquery($4)
+				OR search_normalize($4) <% b.search_text
+				OR b.search_text LIKE '%' || search_normalize($4) || '%')
+		ORDER BY CASE WHEN $4::text IS NULL THEN 0
+		              ELSE ts_rank(b.search_vector, search_tsquery($4)) + word_similarity(search_normalize($4), b.search_text)
+		         END DESC,
+		         b.entry_date DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, slug)
+	var search *string
+	if term := searchTerm(filters.Search); term != "" {
+		search = &term
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, slug, filters.WarehouseCode, filters.LocationCode, search)
 	if err != nil {
 		return nil, errors.DatabaseError(err)
 	}
@@ -670,7 +851,7 @@ func (r *batchRepository) FindPublicBatchesByIndustrySlug(ctx context.Context, s
 
 		if err := rows.Scan(
 			&pb.BatchCode, &pb.Height, &pb.Width, &pb.Thickness, &pb.TotalArea, &pb.AvailableSlabs, &originQuarry,
-			&productName, &material, &finish,
+			&productName, &material, &finish, &pb.WarehouseCode, &pb.WarehouseName, &pb.LocationCode,
 		); err != nil {
 			return nil, errors.DatabaseError(err)
 		}
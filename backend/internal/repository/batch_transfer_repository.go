@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type batchTransferRepository struct {
+	db *DB
+}
+
+func NewBatchTransferRepository(db *DB) *batchTransferRepository {
+	return &batchTransferRepository{db: db}
+}
+
+func (r *batchTransferRepository) Create(ctx context.Context, tx *sql.Tx, transfer *entity.BatchTransfer) error {
+	query := `
+		INSERT INTO batch_transfers (
+			id, batch_id, from_warehouse_id, from_location_id, to_warehouse_id, to_location_id,
+			notes, actor_user_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at
+	`
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		transfer.ID, transfer.BatchID, transfer.FromWarehouseID, transfer.FromLocationID,
+		transfer.ToWarehouseID, transfer.ToLocationID, transfer.Notes, transfer.ActorUserID,
+	).Scan(&transfer.CreatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *batchTransferRepository) FindByBatchID(ctx context.Context, batchID string) ([]entity.BatchTransfer, error) {
+	query := `
+		SELECT t.id, t.batch_id,
+		       t.from_warehouse_id, fw.name, t.from_location_id, fl.code,
+		       t.to_warehouse_id, tw.name, t.to_location_id, tl.code,
+		       t.notes, t.actor_user_id, u.name, t.created_at
+		FROM batch_transfers t
+		LEFT JOIN warehouses fw ON fw.id = t.from_warehouse_id
+		LEFT JOIN warehouse_locations fl ON fl.id = t.from_location_id
+		LEFT JOIN warehouses tw ON tw.id = t.to_warehouse_id
+		LEFT JOIN warehouse_locations tl ON tl.id = t.to_location_id
+		LEFT JOIN users u ON u.id = t.actor_user_id
+		WHERE t.batch_id = $1
+		ORDER BY t.created_at DESC, t.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, batchID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	transfers := []entity.BatchTransfer{}
+	for rows.Next() {
+		var t entity.BatchTransfer
+		if err := rows.Scan(
+			&t.ID, &t.BatchID,
+			&t.FromWarehouseID, &t.FromWarehouseName, &t.FromLocationID, &t.FromLocationCode,
+			&t.ToWarehouseID, &t.ToWarehouseName, &t.ToLocationID, &t.ToLocationCode,
+			&t.Notes, &t.ActorUserID, &t.ActorName, &t.CreatedAt,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		transfers = append(transfers, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return transfers, nil
+}
@@ -22,12 +22,13 @@ func NewBIRepository(db *DB) *biRepository {
 func (r *biRepository) GetSalesMetrics(ctx context.Context, filters entity.BIFilters) (*entity.SalesMetrics, error) {
 	query := `
 		SELECT
-			COALESCE(SUM(sale_price), 0) as total_revenue,
-			COALESCE(SUM(broker_commission), 0) as total_commissions,
-			COALESCE(SUM(net_industry_value), 0) as net_revenue,
+			COALESCE(SUM(to_base_currency(industry_id, sale_price, currency, sold_at::date)), 0) as total_revenue,
+			COALESCE(SUM(to_base_currency(industry_id, broker_commission, currency, sold_at::date)), 0) as total_commissions,
+			COALESCE(SUM(to_base_currency(industry_id, net_industry_value, currency, sold_at::date)), 0) as net_revenue,
 			COUNT(*) as sales_count,
-			COALESCE(AVG(sale_price), 0) as average_ticket,
-			COALESCE(SUM(quantity_slabs_sold), 0) as total_slabs,
+			COALESCE(AVG(to_base_currency(industry_id, sale_price, currency, sold_at::date)), 0) as average_ticket,
+			COALESCE(SUM(quantity_slabs_sold) FILTER (WHERE remnant_id IS NULL), 0) as total_slabs,
+			COUNT(*) FILTER (WHERE remnant_id IS NOT NULL) as remnants_sold,
 			COALESCE(SUM(total_area_sold), 0) as total_area
 		FROM sales_history
 		WHERE industry_id = $1
@@ -45,6 +46,7 @@ func (r *biRepository) GetSalesMetrics(ctx context.Context, filters entity.BIFil
 		&metrics.SalesCount,
 		&metrics.AverageTicket,
 		&metrics.TotalSlabs,
+		&metrics.RemnantsSold,
 		&metrics.TotalArea,
 	)
 
@@ -116,12 +118,13 @@ func (r *biRepository) GetInventoryMetrics(ctx context.Context, industryID strin
 			COALESCE(SUM(available_slabs), 0) as available_slabs,
 			COALESCE(SUM(reserved_slabs), 0) as reserved_slabs,
 			COALESCE(SUM(sold_slabs), 0) as sold_slabs,
-			COALESCE(SUM(available_slabs * industry_price * (height * width / 10000)), 0) as inventory_value,
+			COALESCE(SUM(to_base_currency(batches.industry_id, available_slabs * industry_price * (height * width / 10000), currency, CURRENT_DATE)), 0) as inventory_value,
 			COALESCE(AVG(EXTRACT(EPOCH FROM (NOW() - entry_date))/86400)::INTEGER, 0) as avg_days_in_stock,
-			COUNT(*) FILTER (WHERE available_slabs <= 3 AND available_slabs > 0) as low_stock_count,
-			COUNT(*) FILTER (WHERE EXTRACT(EPOCH FROM (NOW() - COALESCE(last_activity_at, entry_date)))/86400 > 90) as stale_batch_count
+			COUNT(*) FILTER (WHERE available_slabs <= t.low_stock_slabs AND available_slabs > 0) as low_stock_count,
+			COUNT(*) FILTER (WHERE EXTRACT(EPOCH FROM (NOW() - COALESCE(last_activity_at, entry_date)))/86400 > t.stale_days) as stale_batch_count
 		FROM batches
-		WHERE industry_id = $1
+		INNER JOIN batch_stock_thresholds t ON t.batch_id = batches.id
+		WHERE batches.industry_id = $1
 		  AND is_active = true
 		  AND deleted_at IS NULL
 	`
@@ -148,9 +151,150 @@ func (r *biRepository) GetInventoryMetrics(ctx context.Context, industryID strin
 		metrics.OccupancyRate = float64(metrics.ReservedSlabs) / float64(metrics.AvailableSlabs+metrics.ReservedSlabs) * 100
 	}
 
+	if err := r.fillLossMetrics(ctx, industryID, metrics); err != nil {
+		return nil, err
+	}
+
+	if err := r.fillRemnantMetrics(ctx, industryID, metrics); err != nil {
+		return nil, err
+	}
+
 	return metrics, nil
 }
 
+// fillRemnantMetrics calcula o estoque de retalhos, separado das chapas dos lotes
+func (r *biRepository) fillRemnantMetrics(ctx context.Context, industryID string, metrics *entity.InventoryMetrics) error {
+	query := `
+		SELECT
+			COUNT(*) as total_remnants,
+			COUNT(*) FILTER (WHERE status = 'DISPONIVEL') as available_remnants,
+			COUNT(*) FILTER (WHERE status = 'RESERVADO') as reserved_remnants,
+			COUNT(*) FILTER (WHERE status = 'VENDIDO') as sold_remnants,
+			COALESCE(SUM(area) FILTER (WHERE status = 'DISPONIVEL'), 0) as available_area,
+			COALESCE(SUM(to_base_currency(
+				industry_id,
+				area * CASE WHEN price_unit = 'FT2' THEN industry_price * 10.76391042 ELSE industry_price END,
+				currency,
+				CURRENT_DATE
+			)) FILTER (WHERE status = 'DISPONIVEL'), 0) as inventory_value
+		FROM remnants
+		WHERE industry_id = $1
+	`
+
+	err := r.db.QueryRowContext(ctx, query, industryID).Scan(
+		&metrics.Remnants.TotalRemnants,
+		&metrics.Remnants.AvailableRemnants,
+		&metrics.Remnants.ReservedRemnants,
+		&metrics.Remnants.SoldRemnants,
+		&metrics.Remnants.AvailableArea,
+		&metrics.Remnants.InventoryValue,
+	)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+// fillLossMetrics calcula as perdas por avarias aprovadas, por produto e por causa
+func (r *biRepository) fillLossMetrics(ctx context.Context, industryID string, metrics *entity.InventoryMetrics) error {
+	// Base de chapas inclui lotes arquivados, já que a perda ocorreu enquanto estavam em estoque
+	var totalSlabs int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(quantity_slabs), 0)
+		FROM batches
+		WHERE industry_id = $1 AND deleted_at IS NULL
+	`, industryID).Scan(&totalSlabs)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	productQuery := `
+		SELECT
+			p.id,
+			p.name,
+			COALESCE(p.material_type, '') as material,
+			COALESCE(SUM(b.quantity_slabs), 0) as total_slabs,
+			COALESCE(SUM(l.lost_slabs), 0) as lost_slabs
+		FROM batches b
+		INNER JOIN products p ON p.id = b.product_id
+		LEFT JOIN (
+			SELECT batch_id, SUM(quantity) as lost_slabs
+			FROM damage_reports
+			WHERE industry_id = $1 AND status = $2
+			GROUP BY batch_id
+		) l ON l.batch_id = b.id
+		WHERE b.industry_id = $1
+		  AND b.deleted_at IS NULL
+		GROUP BY p.id, p.name, p.material_type
+		HAVING COALESCE(SUM(l.lost_slabs), 0) > 0
+		ORDER BY lost_slabs DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, productQuery, industryID, entity.DamageReportStatusAprovado)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	metrics.LossByProduct = []entity.ProductLossMetric{}
+	for rows.Next() {
+		var p entity.ProductLossMetric
+		if err := rows.Scan(&p.ProductID, &p.ProductName, &p.Material, &p.TotalSlabs, &p.LostSlabs); err != nil {
+			return errors.DatabaseError(err)
+		}
+		if p.TotalSlabs > 0 {
+			p.LossRate = float64(p.LostSlabs) / float64(p.TotalSlabs) * 100
+		}
+		metrics.LostSlabs += p.LostSlabs
+		metrics.LossByProduct = append(metrics.LossByProduct, p)
+	}
+	if err := rows.Err(); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	causeQuery := `
+		SELECT d.cause, COUNT(*) as reports, SUM(d.quantity) as lost_slabs
+		FROM damage_reports d
+		INNER JOIN batches b ON b.id = d.batch_id
+		WHERE d.industry_id = $1
+		  AND d.status = $2
+		  AND b.deleted_at IS NULL
+		GROUP BY d.cause
+		ORDER BY lost_slabs DESC
+	`
+
+	causeRows, err := r.db.QueryContext(ctx, causeQuery, industryID, entity.DamageReportStatusAprovado)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+	defer causeRows.Close()
+
+	metrics.LossByCause = []entity.LossCauseMetric{}
+	for causeRows.Next() {
+		var c entity.LossCauseMetric
+		if err := causeRows.Scan(&c.Cause, &c.Reports, &c.LostSlabs); err != nil {
+			return errors.DatabaseError(err)
+		}
+		if totalSlabs > 0 {
+			c.LossRate = float64(c.LostSlabs) / float64(totalSlabs) * 100
+		}
+		if metrics.LostSlabs > 0 {
+			c.ShareOfLoss = float64(c.LostSlabs) / float64(metrics.LostSlabs) * 100
+		}
+		metrics.LossByCause = append(metrics.LossByCause, c)
+	}
+	if err := causeRows.Err(); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if totalSlabs > 0 {
+		metrics.LossRate = float64(metrics.LostSlabs) / float64(totalSlabs) * 100
+	}
+
+	return nil
+}
+
 // GetBrokerPerformance retorna ranking de performance dos brokers
 func (r *biRepository) GetBrokerPerformance(ctx context.Context, filters entity.BIFilters) ([]entity.BrokerPerformance, error) {
 	query := `
@@ -159,9 +303,9 @@ func (r *biRepository) GetBrokerPerformance(ctx context.Context, filters entity.
 				sh.sold_by_user_id as broker_id,
 				COALESCE(u.name, sh.seller_name, 'Vendedor Externo') as broker_name,
 				COUNT(*) as sales_count,
-				COALESCE(SUM(sh.sale_price), 0) as total_revenue,
-				COALESCE(SUM(sh.broker_commission), 0) as total_commission,
-				COALESCE(AVG(sh.sale_price), 0) as avg_ticket,
+				COALESCE(SUM(to_base_currency(sh.industry_id, sh.sale_price, sh.currency, sh.sold_at::date)), 0) as total_revenue,
+				COALESCE(SUM(to_base_currency(sh.industry_id, sh.broker_commission, sh.currency, sh.sold_at::date)), 0) as total_commission,
+				COALESCE(AVG(to_base_currency(sh.industry_id, sh.sale_price, sh.currency, sh.sold_at::date)), 0) as avg_ticket,
 				COALESCE(AVG(sh.days_to_close), 0) as avg_days_to_close
 			FROM sales_history sh
 			LEFT JOIN users u ON sh.sold_by_user_id = u.id
@@ -257,7 +401,7 @@ func (r *biRepository) GetSalesTrend(ctx context.Context, filters entity.BIFilte
 	query := fmt.Sprintf(`
 		SELECT
 			TO_CHAR(DATE_TRUNC('%s', sold_at), '%s') as date,
-			COALESCE(SUM(sale_price), 0) as value,
+			COALESCE(SUM(to_base_currency(industry_id, sale_price, currency, sold_at::date)), 0) as value,
 			COUNT(*) as count
 		FROM sales_history
 		WHERE industry_id = $1
@@ -295,8 +439,9 @@ func (r *biRepository) GetTopProducts(ctx context.Context, filters entity.BIFilt
 			p.name as product_name,
 			p.material_type,
 			COUNT(*) as sales_count,
-			COALESCE(SUM(sh.sale_price), 0) as revenue,
-			COALESCE(SUM(sh.quantity_slabs_sold), 0) as slabs_sold,
+			COALESCE(SUM(to_base_currency(sh.industry_id, sh.sale_price, sh.currency, sh.sold_at::date)), 0) as revenue,
+			COALESCE(SUM(sh.quantity_slabs_sold) FILTER (WHERE sh.remnant_id IS NULL), 0) as slabs_sold,
+			COUNT(*) FILTER (WHERE sh.remnant_id IS NOT NULL) as remnants_sold,
 			COALESCE(SUM(sh.total_area_sold), 0) as area_sold
 		FROM sales_history sh
 		JOIN batches b ON sh.batch_id = b.id
@@ -327,6 +472,7 @@ func (r *biRepository) GetTopProducts(ctx context.Context, filters entity.BIFilt
 			&p.SalesCount,
 			&p.Revenue,
 			&p.SlabsSold,
+			&p.RemnantsSold,
 			&p.AreaSold,
 		); err != nil {
 			return nil, errors.DatabaseError(err)
@@ -356,6 +502,20 @@ func (r *biRepository) CountPendingApprovals(ctx context.Context, industryID str
 	return count, nil
 }
 
+// GetBaseCurrency retorna a moeda base da indústria usada nas métricas
+func (r *biRepository) GetBaseCurrency(ctx context.Context, industryID string) (entity.Currency, error) {
+	var currency entity.Currency
+	err := r.db.QueryRowContext(ctx, `SELECT base_currency FROM industries WHERE id = $1`, industryID).Scan(&currency)
+	if err == sql.ErrNoRows {
+		return entity.DefaultCurrency, nil
+	}
+	if err != nil {
+		return "", errors.DatabaseError(err)
+	}
+
+	return currency, nil
+}
+
 // RefreshMaterializedViews atualiza as views materializadas
 func (r *biRepository) RefreshMaterializedViews(ctx context.Context) error {
 	_, err := r.db.ExecContext(ctx, "SELECT refresh_bi_views()")
@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type blockRepository struct {
+	db *DB
+}
+
+func NewBlockRepository(db *DB) *blockRepository {
+	return &blockRepository{db: db}
+}
+
+// blockColumns inclui o rendimento agregado dos lotes não excluídos vinculados ao bloco
+var blockColumns = []string{
+	"q.id", "q.industry_id", "q.block_code", "q.quarry_name", "q.supplier_name", "q.material_type",
+	"q.length", "q.width", "q.height", "q.volume_m3", "q.purchase_cost", "q.arrival_date", "q.notes",
+	"q.is_active", "q.created_at", "q.updated_at",
+	"COALESCE(y.batch_count, 0)", "COALESCE(y.quantity_slabs, 0)", "COALESCE(y.sold_slabs, 0)", "COALESCE(y.slab_area, 0)",
+}
+
+const blockYieldJoin = `LATERAL (
+	SELECT COUNT(*) AS batch_count,
+	       SUM(b.quantity_slabs) AS quantity_slabs,
+	       SUM(b.sold_slabs) AS sold_slabs,
+	       SUM(b.height * b.width * b.quantity_slabs) / 10000 AS slab_area
+	FROM batches b
+	WHERE b.block_id = q.id AND b.deleted_at IS NULL
+) y ON TRUE`
+
+func (r *blockRepository) Create(ctx context.Context, block *entity.Block) error {
+	query := `
+		INSERT INTO quarry_blocks (
+			id, industry_id, block_code, quarry_name, supplier_name, material_type,
+			length, width, height, volume_m3, purchase_cost, arrival_date, notes, is_active
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		block.ID, block.IndustryID, block.BlockCode, block.QuarryName, block.SupplierName, block.Material,
+		block.Length, block.Width, block.Height, block.VolumeM3, block.PurchaseCost, block.ArrivalDate,
+		block.Notes, block.IsActive,
+	).Scan(&block.CreatedAt, &block.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Código de bloco já cadastrado")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	block.Yield.Calculate(block.VolumeM3, block.PurchaseCost)
+	return nil
+}
+
+func (r *blockRepository) FindByID(ctx context.Context, id string) (*entity.Block, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.Select(blockColumns...).
+		From("quarry_blocks q").
+		JoinClause("LEFT JOIN " + blockYieldJoin).
+		Where(sq.Eq{"q.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	block, err := scanBlock(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Bloco")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return block, nil
+}
+
+func (r *blockRepository) List(ctx context.Context, industryID string, filters entity.BlockFilters) ([]entity.Block, int, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	where := sq.And{sq.Eq{"q.industry_id": industryID}}
+	if !filters.IncludeInactive {
+		where = append(where, sq.Eq{"q.is_active": true})
+	}
+	if filters.Search != nil && *filters.Search != "" {
+		search := "%" + *filters.Search + "%"
+		where = append(where, sq.Or{
+			sq.ILike{"q.block_code": search},
+			sq.ILike{"q.quarry_name": search},
+			sq.ILike{"q.supplier_name": search},
+		})
+	}
+
+	countSQL, countArgs, err := psql.Select("COUNT(*)").From("quarry_blocks q").Where(where).ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countSQL, countArgs...).Scan(&total); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	offset := (filters.Page - 1) * filters.Limit
+	query, args, err := psql.Select(blockColumns...).
+		From("quarry_blocks q").
+		JoinClause("LEFT JOIN "+blockYieldJoin).
+		Where(where).
+		OrderBy("q.arrival_date DESC", "q.block_code").
+		Limit(uint64(filters.Limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	blocks := []entity.Block{}
+	for rows.Next() {
+		block, err := scanBlock(rows)
+		if err != nil {
+			return nil, 0, errors.DatabaseError(err)
+		}
+		blocks = append(blocks, *block)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	return blocks, total, nil
+}
+
+func (r *blockRepository) Update(ctx context.Context, block *entity.Block) error {
+	query := `
+		UPDATE quarry_blocks
+		SET block_code = $1, quarry_name = $2, supplier_name = $3, material_type = $4,
+		    length = $5, width = $6, height = $7, volume_m3 = $8, purchase_cost = $9,
+		    arrival_date = $10, notes = $11, is_active = $12, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $13
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		block.BlockCode, block.QuarryName, block.SupplierName, block.Material,
+		block.Length, block.Width, block.Height, block.VolumeM3, block.PurchaseCost,
+		block.ArrivalDate, block.Notes, block.IsActive, block.ID,
+	).Scan(&block.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("Bloco")
+	}
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Código de bloco já cadastrado")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	block.Yield.Calculate(block.VolumeM3, block.PurchaseCost)
+	return nil
+}
+
+func (r *blockRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM quarry_blocks WHERE id = $1`, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Bloco")
+	}
+
+	return nil
+}
+
+func (r *blockRepository) ExistsByCode(ctx context.Context, industryID, code, excludeID string) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM quarry_blocks
+			WHERE industry_id = $1 AND block_code = $2 AND ($3 = '' OR id::text <> $3)
+		)
+	`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, industryID, code, excludeID).Scan(&exists); err != nil {
+		return false, errors.DatabaseError(err)
+	}
+
+	return exists, nil
+}
+
+func (r *blockRepository) CountBatches(ctx context.Context, id string) (int, error) {
+	query := `SELECT COUNT(*) FROM batches WHERE block_id = $1 AND deleted_at IS NULL`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&count); err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return count, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanBlock(row rowScanner) (*entity.Block, error) {
+	var b entity.Block
+	var material sql.NullString
+	if err := row.Scan(
+		&b.ID, &b.IndustryID, &b.BlockCode, &b.QuarryName, &b.SupplierName, &material,
+		&b.Length, &b.Width, &b.Height, &b.VolumeM3, &b.PurchaseCost, &b.ArrivalDate, &b.Notes,
+		&b.IsActive, &b.CreatedAt, &b.UpdatedAt,
+		&b.Yield.BatchCount, &b.Yield.QuantitySlabs, &b.Yield.SoldSlabs, &b.Yield.SlabAreaM2,
+	); err != nil {
+		return nil, err
+	}
+
+	if material.Valid {
+		m := entity.MaterialType(material.String)
+		b.Material = &m
+	}
+	b.Yield.Calculate(b.VolumeM3, b.PurchaseCost)
+
+	return &b, nil
+}
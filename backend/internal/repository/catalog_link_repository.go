@@ -28,14 +28,14 @@ func (r *catalogLinkRepository) Create(ctx context.Context, link *entity.Catalog
 	query := `
 		INSERT INTO catalog_links (
 			id, created_by_user_id, industry_id, slug_token, title,
-			custom_message, expires_at, is_active
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			custom_message, show_price, expires_at, is_active
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING created_at, updated_at
 	`
 
 	err = tx.QueryRowContext(ctx, query,
 		link.ID, link.CreatedByUserID, link.IndustryID, link.SlugToken,
-		link.Title, link.CustomMessage, link.ExpiresAt, link.IsActive,
+		link.Title, link.CustomMessage, link.ShowPrice, link.ExpiresAt, link.IsActive,
 	).Scan(&link.CreatedAt, &link.UpdatedAt)
 
 	if err != nil {
@@ -73,7 +73,7 @@ func (r *catalogLinkRepository) Create(ctx context.Context, link *entity.Catalog
 func (r *catalogLinkRepository) FindByID(ctx context.Context, id string) (*entity.CatalogLink, error) {
 	query := `
 		SELECT id, created_by_user_id, industry_id, slug_token, title,
-		       custom_message, views_count, expires_at, is_active,
+		       custom_message, show_price, views_count, expires_at, is_active,
 		       created_at, updated_at
 		FROM catalog_links
 		WHERE id = $1
@@ -82,7 +82,7 @@ func (r *catalogLinkRepository) FindByID(ctx context.Context, id string) (*entit
 	link := &entity.CatalogLink{}
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&link.ID, &link.CreatedByUserID, &link.IndustryID, &link.SlugToken,
-		&link.Title, &link.CustomMessage, &link.ViewsCount, &link.ExpiresAt,
+		&link.Title, &link.CustomMessage, &link.ShowPrice, &link.ViewsCount, &link.ExpiresAt,
 		&link.IsActive, &link.CreatedAt, &link.UpdatedAt,
 	)
 
@@ -106,7 +106,7 @@ func (r *catalogLinkRepository) FindByID(ctx context.Context, id string) (*entit
 func (r *catalogLinkRepository) FindBySlug(ctx context.Context, slug string) (*entity.CatalogLink, error) {
 	query := `
 		SELECT id, created_by_user_id, industry_id, slug_token, title,
-		       custom_message, views_count, expires_at, is_active,
+		       custom_message, show_price, views_count, expires_at, is_active,
 		       created_at, updated_at
 		FROM catalog_links
 		WHERE slug_token = $1
@@ -115,7 +115,7 @@ func (r *catalogLinkRepository) FindBySlug(ctx context.Context, slug string) (*e
 	link := &entity.CatalogLink{}
 	err := r.db.QueryRowContext(ctx, query, slug).Scan(
 		&link.ID, &link.CreatedByUserID, &link.IndustryID, &link.SlugToken,
-		&link.Title, &link.CustomMessage, &link.ViewsCount, &link.ExpiresAt,
+		&link.Title, &link.CustomMessage, &link.ShowPrice, &link.ViewsCount, &link.ExpiresAt,
 		&link.IsActive, &link.CreatedAt, &link.UpdatedAt,
 	)
 
@@ -144,7 +144,7 @@ func (r *catalogLinkRepository) List(ctx context.Context, industryID string, use
 		// Filtrar por usuário (para brokers)
 		query = `
 			SELECT id, created_by_user_id, industry_id, slug_token, title,
-			       custom_message, views_count, expires_at, is_active,
+			       custom_message, show_price, views_count, expires_at, is_active,
 			       created_at, updated_at
 			FROM catalog_links
 			WHERE created_by_user_id = $1
@@ -155,7 +155,7 @@ func (r *catalogLinkRepository) List(ctx context.Context, industryID string, use
 		// Filtrar por indústria (para admins/vendedores)
 		query = `
 			SELECT id, created_by_user_id, industry_id, slug_token, title,
-			       custom_message, views_count, expires_at, is_active,
+			       custom_message, show_price, views_count, expires_at, is_active,
 			       created_at, updated_at
 			FROM catalog_links
 			WHERE industry_id = $1
@@ -175,7 +175,7 @@ func (r *catalogLinkRepository) List(ctx context.Context, industryID string, use
 		var link entity.CatalogLink
 		if err := rows.Scan(
 			&link.ID, &link.CreatedByUserID, &link.IndustryID, &link.SlugToken,
-			&link.Title, &link.CustomMessage, &link.ViewsCount, &link.ExpiresAt,
+			&link.Title, &link.CustomMessage, &link.ShowPrice, &link.ViewsCount, &link.ExpiresAt,
 			&link.IsActive, &link.CreatedAt, &link.UpdatedAt,
 		); err != nil {
 			return nil, errors.DatabaseError(err)
@@ -196,14 +196,14 @@ func (r *catalogLinkRepository) Update(ctx context.Context, link *entity.Catalog
 	// Atualizar o link
 	query := `
 		UPDATE catalog_links
-		SET title = $1, custom_message = $2, expires_at = $3, is_active = $4,
+		SET title = $1, custom_message = $2, expires_at = $3, is_active = $4, show_price = $5,
 		    updated_at = CURRENT_TIMESTAMP
-		WHERE id = $5
+		WHERE id = $6
 		RETURNING updated_at
 	`
 
 	err = tx.QueryRowContext(ctx, query,
-		link.Title, link.CustomMessage, link.ExpiresAt, link.IsActive, link.ID,
+		link.Title, link.CustomMessage, link.ExpiresAt, link.IsActive, link.ShowPrice, link.ID,
 	).Scan(&link.UpdatedAt)
 
 	if err == sql.ErrNoRows {
@@ -293,7 +293,7 @@ func (r *catalogLinkRepository) findBatchesByLinkID(ctx context.Context, linkID
 	query := `
 		SELECT b.id, b.product_id, b.industry_id, b.batch_code, b.height, b.width, b.thickness,
 		       b.quantity_slabs, b.available_slabs, b.reserved_slabs, b.sold_slabs, b.inactive_slabs,
-		       b.net_area, b.industry_price, b.price_unit, b.origin_quarry,
+		       b.net_area, b.industry_price, b.price_unit, b.currency, b.origin_quarry,
 		       b.entry_date, b.status, b.is_active, b.is_public, b.created_at, b.updated_at, b.deleted_at,
 		       clb.display_order
 		FROM catalog_link_batches clb
@@ -317,7 +317,7 @@ func (r *catalogLinkRepository) findBatchesByLinkID(ctx context.Context, linkID
 			&b.ID, &b.ProductID, &b.IndustryID, &b.BatchCode,
 			&b.Height, &b.Width, &b.Thickness, &b.QuantitySlabs,
 			&b.AvailableSlabs, &b.ReservedSlabs, &b.SoldSlabs, &b.InactiveSlabs,
-			&b.TotalArea, &b.IndustryPrice, &b.PriceUnit, &b.OriginQuarry,
+			&b.TotalArea, &b.IndustryPrice, &b.PriceUnit, &b.Currency, &b.OriginQuarry,
 			&b.EntryDate, &b.Status, &b.IsActive, &b.IsPublic,
 			&b.CreatedAt, &b.UpdatedAt, &b.DeletedAt, &displayOrder,
 		); err != nil {
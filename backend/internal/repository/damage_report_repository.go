@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type damageReportRepository struct {
+	db *DB
+}
+
+func NewDamageReportRepository(db *DB) *damageReportRepository {
+	return &damageReportRepository{db: db}
+}
+
+var damageReportColumns = []string{
+	"d.id", "d.industry_id", "d.batch_id", "b.batch_code", "p.name", "d.quantity", "d.cause", "d.description",
+	"d.status", "d.reported_by_user_id", "ru.name", "d.reviewed_by_user_id", "vu.name", "d.reviewed_at",
+	"d.review_notes", "d.created_at", "d.updated_at",
+}
+
+func (r *damageReportRepository) Create(ctx context.Context, report *entity.DamageReport) error {
+	query := `
+		INSERT INTO damage_reports (id, industry_id, batch_id, quantity, cause, description, status, reported_by_user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		report.ID, report.IndustryID, report.BatchID, report.Quantity, report.Cause,
+		report.Description, report.Status, report.ReportedByUserID,
+	).Scan(&report.CreatedAt, &report.UpdatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *damageReportRepository) FindByID(ctx context.Context, id string) (*entity.DamageReport, error) {
+	query, args, err := r.selectReports().Where(sq.Eq{"d.id": id}).ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	report, err := scanDamageReport(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Relatório de avaria")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	photos, err := r.findPhotos(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	report.Photos = photos
+
+	return report, nil
+}
+
+func (r *damageReportRepository) List(ctx context.Context, industryID string, filters entity.DamageReportFilters) ([]entity.DamageReport, int, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	where := sq.And{sq.Eq{"d.industry_id": industryID}}
+	if filters.BatchID != nil {
+		where = append(where, sq.Eq{"d.batch_id": *filters.BatchID})
+	}
+	if filters.Status != nil {
+		where = append(where, sq.Eq{"d.status": *filters.Status})
+	}
+	if filters.Cause != nil {
+		where = append(where, sq.Eq{"d.cause": *filters.Cause})
+	}
+
+	countSQL, countArgs, err := psql.Select("COUNT(*)").From("damage_reports d").Where(where).ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countSQL, countArgs...).Scan(&total); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	offset := (filters.Page - 1) * filters.Limit
+	query, args, err := r.selectReports().
+		Where(where).
+		OrderBy("d.created_at DESC").
+		Limit(uint64(filters.Limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	reports := []entity.DamageReport{}
+	for rows.Next() {
+		report, err := scanDamageReport(rows)
+		if err != nil {
+			return nil, 0, errors.DatabaseError(err)
+		}
+		reports = append(reports, *report)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	return reports, total, nil
+}
+
+func (r *damageReportRepository) AddPhotos(ctx context.Context, reportID string, urls []string) error {
+	query := `
+		INSERT INTO damage_report_photos (report_id, url, display_order)
+		SELECT $1, $2, COALESCE(MAX(display_order) + 1, 0)
+		FROM damage_report_photos
+		WHERE report_id = $1
+	`
+
+	for _, url := range urls {
+		if _, err := r.db.ExecContext(ctx, query, reportID, url); err != nil {
+			return errors.DatabaseError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *damageReportRepository) Review(ctx context.Context, tx *sql.Tx, id string, status entity.DamageReportStatus, userID string, notes *string) error {
+	query := `
+		UPDATE damage_reports
+		SET status = $1, reviewed_by_user_id = $2, reviewed_at = CURRENT_TIMESTAMP, review_notes = $3,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4 AND status = $5
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, status, userID, notes, id, entity.DamageReportStatusPendente)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewConflictError("Relatório de avaria já foi revisado")
+	}
+
+	return nil
+}
+
+func (r *damageReportRepository) findPhotos(ctx context.Context, reportID string) ([]entity.Media, error) {
+	query := `
+		SELECT id, url, display_order, created_at
+		FROM damage_report_photos
+		WHERE report_id = $1
+		ORDER BY display_order, created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, reportID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	photos := []entity.Media{}
+	for rows.Next() {
+		var m entity.Media
+		if err := rows.Scan(&m.ID, &m.URL, &m.DisplayOrder, &m.CreatedAt); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		photos = append(photos, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return photos, nil
+}
+
+func (r *damageReportRepository) selectReports() sq.SelectBuilder {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(damageReportColumns...).
+		From("damage_reports d").
+		Join("batches b ON b.id = d.batch_id").
+		LeftJoin("products p ON p.id = b.product_id").
+		LeftJoin("users ru ON ru.id = d.reported_by_user_id").
+		LeftJoin("users vu ON vu.id = d.reviewed_by_user_id")
+}
+
+func scanDamageReport(row rowScanner) (*entity.DamageReport, error) {
+	var d entity.DamageReport
+	if err := row.Scan(
+		&d.ID, &d.IndustryID, &d.BatchID, &d.BatchCode, &d.ProductName, &d.Quantity, &d.Cause, &d.Description,
+		&d.Status, &d.ReportedByUserID, &d.ReportedByName, &d.ReviewedByUserID, &d.ReviewedByName, &d.ReviewedAt,
+		&d.ReviewNotes, &d.CreatedAt, &d.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
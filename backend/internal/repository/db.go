@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"time"
 
@@ -16,6 +17,21 @@ type DB struct {
 	logger *zap.Logger
 }
 
+// queryer abstrai *sql.DB e *sql.Tx para repositórios que aceitam transação opcional
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// conn retorna a transação quando informada ou a conexão padrão
+func (db *DB) conn(tx *sql.Tx) queryer {
+	if tx != nil {
+		return tx
+	}
+	return db.DB
+}
+
 // Config contém configurações de conexão
 type Config struct {
 	Host            string
@@ -116,4 +132,33 @@ func (db *DB) HealthCheck(ctx context.Context) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// TryAdvisoryLock tenta obter um advisory lock de sessão no PostgreSQL sem bloquear.
+// O lock fica preso a uma conexão dedicada do pool, liberada por release.
+func (db *DB) TryAdvisoryLock(ctx context.Context, key string) (release func(), acquired bool, err error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("erro ao obter conexão para lock: %w", err)
+	}
+
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, key).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, fmt.Errorf("erro ao obter advisory lock: %w", err)
+	}
+	if !acquired {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	release = func() {
+		if _, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, key); err != nil {
+			db.logger.Error("erro ao liberar advisory lock", zap.String("key", key), zap.Error(err))
+			// Descartar a conexão para que o lock não volte ao pool
+			_ = conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}
+
+	return release, true, nil
+}
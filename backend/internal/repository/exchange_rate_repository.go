@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type exchangeRateRepository struct {
+	db *DB
+}
+
+func NewExchangeRateRepository(db *DB) *exchangeRateRepository {
+	return &exchangeRateRepository{db: db}
+}
+
+func (r *exchangeRateRepository) Upsert(ctx context.Context, tx *sql.Tx, rate *entity.ExchangeRate) error {
+	query := `
+		INSERT INTO exchange_rates (id, industry_id, from_currency, to_currency, rate, effective_date, source, created_by_user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (industry_id, from_currency, to_currency, effective_date)
+		DO UPDATE SET rate = EXCLUDED.rate, source = EXCLUDED.source,
+		              created_by_user_id = EXCLUDED.created_by_user_id, updated_at = CURRENT_TIMESTAMP
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		rate.ID, rate.IndustryID, rate.FromCurrency, rate.ToCurrency, rate.Rate,
+		rate.EffectiveDate, rate.Source, rate.CreatedByUserID,
+	).Scan(&rate.ID, &rate.CreatedAt, &rate.UpdatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+const exchangeRateSelect = `
+	SELECT id, industry_id, from_currency, to_currency, rate, effective_date, source,
+	       created_by_user_id, created_at, updated_at
+	FROM exchange_rates
+`
+
+func (r *exchangeRateRepository) FindByID(ctx context.Context, id string) (*entity.ExchangeRate, error) {
+	rate, err := scanExchangeRate(r.db.QueryRowContext(ctx, exchangeRateSelect+` WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Cotação")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return rate, nil
+}
+
+func (r *exchangeRateRepository) List(ctx context.Context, industryID string, filters entity.ExchangeRateFilters) ([]entity.ExchangeRate, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query := psql.Select(
+		"id", "industry_id", "from_currency", "to_currency", "rate", "effective_date", "source",
+		"created_by_user_id", "created_at", "updated_at",
+	).From("exchange_rates").
+		Where(sq.Eq{"industry_id": industryID})
+
+	if filters.FromCurrency != nil {
+		query = query.Where(sq.Eq{"from_currency": *filters.FromCurrency})
+	}
+	if filters.ToCurrency != nil {
+		query = query.Where(sq.Eq{"to_currency": *filters.ToCurrency})
+	}
+	if filters.StartDate != nil {
+		query = query.Where(sq.GtOrEq{"effective_date": *filters.StartDate})
+	}
+	if filters.EndDate != nil {
+		query = query.Where(sq.LtOrEq{"effective_date": *filters.EndDate})
+	}
+
+	sqlStr, args, err := query.OrderBy("effective_date DESC", "from_currency", "to_currency").ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	rates := []entity.ExchangeRate{}
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		rates = append(rates, *rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return rates, nil
+}
+
+func (r *exchangeRateRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM exchange_rates WHERE id = $1`, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Cotação")
+	}
+
+	return nil
+}
+
+func (r *exchangeRateRepository) GetRate(ctx context.Context, industryID string, from, to entity.Currency, date time.Time) (*float64, error) {
+	var rate sql.NullFloat64
+	err := r.db.QueryRowContext(ctx, `SELECT currency_rate($1, $2, $3, $4::date)`,
+		industryID, from, to, date.Format("2006-01-02"),
+	).Scan(&rate)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	if !rate.Valid {
+		return nil, nil
+	}
+
+	return &rate.Float64, nil
+}
+
+func scanExchangeRate(row rowScanner) (*entity.ExchangeRate, error) {
+	var rate entity.ExchangeRate
+	err := row.Scan(
+		&rate.ID, &rate.IndustryID, &rate.FromCurrency, &rate.ToCurrency, &rate.Rate, &rate.EffectiveDate, &rate.Source,
+		&rate.CreatedByUserID, &rate.CreatedAt, &rate.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rate, nil
+}
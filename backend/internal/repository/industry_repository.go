@@ -56,7 +56,7 @@ func (r *industryRepository) FindByID(ctx context.Context, id string) (*entity.I
 		SELECT id, name, cnpj, slug, contact_email, contact_phone, whatsapp,
 		       description, city, state, banner_url, logo_url, social_links,
 		       address_country, address_state, address_city, address_street, address_number, address_zip_code,
-		       portfolio_display_settings, is_public, created_at, updated_at
+		       portfolio_display_settings, batch_code_settings, base_currency, stock_alert_settings, reservation_policy, is_public, created_at, updated_at
 		FROM industries
 		WHERE id = $1
 	`
@@ -68,7 +68,7 @@ func (r *industryRepository) FindByID(ctx context.Context, id string) (*entity.I
 		&industry.Description, &industry.City, &industry.State, &industry.BannerURL, &industry.LogoURL, &industry.SocialLinks,
 		&industry.AddressCountry, &industry.AddressState, &industry.AddressCity, &industry.AddressStreet,
 		&industry.AddressNumber, &industry.AddressZipCode,
-		&industry.PortfolioDisplaySettings, &industry.IsPublic, &industry.CreatedAt, &industry.UpdatedAt,
+		&industry.PortfolioDisplaySettings, &industry.BatchCodeSettings, &industry.BaseCurrency, &industry.StockAlertSettings, &industry.ReservationPolicy, &industry.IsPublic, &industry.CreatedAt, &industry.UpdatedAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -86,7 +86,7 @@ func (r *industryRepository) FindBySlug(ctx context.Context, slug string) (*enti
 		SELECT id, name, cnpj, slug, contact_email, contact_phone, whatsapp,
 		       description, city, state, banner_url, logo_url, social_links,
 		       address_country, address_state, address_city, address_street, address_number, address_zip_code,
-		       portfolio_display_settings, is_public, created_at, updated_at
+		       portfolio_display_settings, batch_code_settings, base_currency, stock_alert_settings, reservation_policy, is_public, created_at, updated_at
 		FROM industries
 		WHERE slug = $1
 	`
@@ -98,7 +98,7 @@ func (r *industryRepository) FindBySlug(ctx context.Context, slug string) (*enti
 		&industry.Description, &industry.City, &industry.State, &industry.BannerURL, &industry.LogoURL, &industry.SocialLinks,
 		&industry.AddressCountry, &industry.AddressState, &industry.AddressCity, &industry.AddressStreet,
 		&industry.AddressNumber, &industry.AddressZipCode,
-		&industry.PortfolioDisplaySettings, &industry.IsPublic, &industry.CreatedAt, &industry.UpdatedAt,
+		&industry.PortfolioDisplaySettings, &industry.BatchCodeSettings, &industry.BaseCurrency, &industry.StockAlertSettings, &industry.ReservationPolicy, &industry.IsPublic, &industry.CreatedAt, &industry.UpdatedAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -116,7 +116,7 @@ func (r *industryRepository) FindByCNPJ(ctx context.Context, cnpj string) (*enti
 		SELECT id, name, cnpj, slug, contact_email, contact_phone, whatsapp,
 		       description, city, state, banner_url, logo_url, social_links,
 		       address_country, address_state, address_city, address_street, address_number, address_zip_code,
-		       portfolio_display_settings, is_public, created_at, updated_at
+		       portfolio_display_settings, batch_code_settings, base_currency, stock_alert_settings, reservation_policy, is_public, created_at, updated_at
 		FROM industries
 		WHERE cnpj = $1
 	`
@@ -128,7 +128,7 @@ func (r *industryRepository) FindByCNPJ(ctx context.Context, cnpj string) (*enti
 		&industry.Description, &industry.City, &industry.State, &industry.BannerURL, &industry.LogoURL, &industry.SocialLinks,
 		&industry.AddressCountry, &industry.AddressState, &industry.AddressCity, &industry.AddressStreet,
 		&industry.AddressNumber, &industry.AddressZipCode,
-		&industry.PortfolioDisplaySettings, &industry.IsPublic, &industry.CreatedAt, &industry.UpdatedAt,
+		&industry.PortfolioDisplaySettings, &industry.BatchCodeSettings, &industry.BaseCurrency, &industry.StockAlertSettings, &industry.ReservationPolicy, &industry.IsPublic, &industry.CreatedAt, &industry.UpdatedAt,
 	)
 
 	if err == sql.ErrNoRows {
@@ -148,8 +148,10 @@ func (r *industryRepository) Update(ctx context.Context, industry *entity.Indust
 		    description = $7, city = $8, state = $9, banner_url = $10,
 		    logo_url = $11, social_links = $12, address_country = $13, address_state = $14,
 		    address_city = $15, address_street = $16, address_number = $17,
-		    address_zip_code = $18, portfolio_display_settings = $19, is_public = $20, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $21
+		    address_zip_code = $18, portfolio_display_settings = $19, is_public = $20,
+		    batch_code_settings = $21, base_currency = $22, stock_alert_settings = $23,
+		    reservation_policy = $24, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $25
 		RETURNING updated_at
 	`
 
@@ -158,7 +160,9 @@ func (r *industryRepository) Update(ctx context.Context, industry *entity.Indust
 		industry.Description, industry.City, industry.State, industry.BannerURL,
 		industry.LogoURL, industry.SocialLinks, industry.AddressCountry, industry.AddressState,
 		industry.AddressCity, industry.AddressStreet, industry.AddressNumber,
-		industry.AddressZipCode, industry.PortfolioDisplaySettings, industry.IsPublic, industry.ID,
+		industry.AddressZipCode, industry.PortfolioDisplaySettings, industry.IsPublic,
+		industry.BatchCodeSettings, industry.BaseCurrency.OrDefault(), industry.StockAlertSettings,
+		industry.ReservationPolicy, industry.ID,
 	).Scan(&industry.UpdatedAt)
 
 	if err == sql.ErrNoRows {
@@ -205,7 +209,7 @@ func (r *industryRepository) List(ctx context.Context) ([]entity.Industry, error
 		SELECT id, name, cnpj, slug, contact_email, contact_phone, whatsapp,
 		       description, city, state, banner_url, logo_url, social_links,
 		       address_country, address_state, address_city, address_street, address_number, address_zip_code,
-		       portfolio_display_settings, is_public, created_at, updated_at
+		       portfolio_display_settings, batch_code_settings, base_currency, stock_alert_settings, reservation_policy, is_public, created_at, updated_at
 		FROM industries
 		ORDER BY name
 	`
@@ -225,7 +229,7 @@ func (r *industryRepository) List(ctx context.Context) ([]entity.Industry, error
 			&ind.Description, &ind.City, &ind.State, &ind.BannerURL, &ind.LogoURL, &ind.SocialLinks,
 			&ind.AddressCountry, &ind.AddressState, &ind.AddressCity, &ind.AddressStreet,
 			&ind.AddressNumber, &ind.AddressZipCode,
-			&ind.PortfolioDisplaySettings, &ind.IsPublic, &ind.CreatedAt, &ind.UpdatedAt,
+			&ind.PortfolioDisplaySettings, &ind.BatchCodeSettings, &ind.BaseCurrency, &ind.StockAlertSettings, &ind.ReservationPolicy, &ind.IsPublic, &ind.CreatedAt, &ind.UpdatedAt,
 		); err != nil {
 			return nil, errors.DatabaseError(err)
 		}
@@ -346,5 +350,31 @@ func (r *industryRepository) FindPublicDepositBySlug(ctx context.Context, slug s
 		}
 	}
 
+	// Buscar depósitos físicos ativos (filtro de localização dos lotes)
+	deposit.Warehouses = []entity.PublicWarehouse{}
+	warehouseQuery := `
+		SELECT w.code, w.name, w.city, w.state,
+		       COALESCE(ARRAY_AGG(wl.code ORDER BY wl.code) FILTER (WHERE wl.id IS NOT NULL), '{}')
+		FROM warehouses w
+		LEFT JOIN warehouse_locations wl ON wl.warehouse_id = w.id AND wl.is_active = TRUE
+		WHERE w.industry_id = (SELECT id FROM industries WHERE slug = $1)
+			AND w.is_active = TRUE
+		GROUP BY w.id
+		ORDER BY w.name
+	`
+
+	warehouseRows, err := r.db.QueryContext(ctx, warehouseQuery, slug)
+	if err == nil {
+		defer warehouseRows.Close()
+		for warehouseRows.Next() {
+			var warehouse entity.PublicWarehouse
+			var locations pq.StringArray
+			if err := warehouseRows.Scan(&warehouse.Code, &warehouse.Name, &warehouse.City, &warehouse.State, &locations); err == nil {
+				warehouse.Locations = []string(locations)
+				deposit.Warehouses = append(deposit.Warehouses, warehouse)
+			}
+		}
+	}
+
 	return deposit, nil
 }
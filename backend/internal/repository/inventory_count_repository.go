@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type inventoryCountRepository struct {
+	db *DB
+}
+
+func NewInventoryCountRepository(db *DB) *inventoryCountRepository {
+	return &inventoryCountRepository{db: db}
+}
+
+// inventoryCountColumns inclui o resumo da contagem calculado sobre os itens e os contadores atuais
+var inventoryCountColumns = []string{
+	"c.id", "c.industry_id", "c.scope", "c.product_id", "p.name", "c.material_type", "c.status", "c.notes",
+	"c.adjustment_reason", "c.created_by_user_id", "cu.name", "c.closed_by_user_id", "xu.name", "c.closed_at",
+	"c.created_at", "c.updated_at",
+	"COALESCE(s.total_items, 0)", "COALESCE(s.counted_items, 0)", "COALESCE(s.variance_items, 0)", "COALESCE(s.net_variance, 0)",
+}
+
+// inventoryCountSummaryJoin usa a divergência aplicada nos itens ajustados e a divergência da contagem nos demais
+const inventoryCountSummaryJoin = `LATERAL (
+	SELECT COUNT(*) AS total_items,
+	       COUNT(i.counted_slabs) AS counted_items,
+	       COUNT(*) FILTER (WHERE COALESCE(i.adjusted_quantity, i.counted_slabs - COALESCE(i.counted_system_slabs, b.available_slabs)) <> 0) AS variance_items,
+	       SUM(COALESCE(i.adjusted_quantity, i.counted_slabs - COALESCE(i.counted_system_slabs, b.available_slabs))) AS net_variance
+	FROM inventory_count_items i
+	INNER JOIN batches b ON b.id = i.batch_id
+	WHERE i.count_id = c.id
+) s ON TRUE`
+
+func (r *inventoryCountRepository) Create(ctx context.Context, tx *sql.Tx, count *entity.InventoryCount) error {
+	query := `
+		INSERT INTO inventory_counts (id, industry_id, scope, product_id, material_type, status, notes, created_by_user_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		count.ID, count.IndustryID, count.Scope, count.ProductID, count.Material,
+		count.Status, count.Notes, count.CreatedByUserID,
+	).Scan(&count.CreatedAt, &count.UpdatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *inventoryCountRepository) FreezeScope(ctx context.Context, tx *sql.Tx, count *entity.InventoryCount) (int, error) {
+	query := `
+		INSERT INTO inventory_count_items (count_id, batch_id, expected_slabs)
+		SELECT $1, b.id, b.available_slabs
+		FROM batches b
+		LEFT JOIN products p ON p.id = b.product_id
+		WHERE b.industry_id = $2
+			AND b.deleted_at IS NULL
+			AND b.is_active = TRUE
+			AND ($3::uuid IS NULL OR b.product_id = $3)
+			AND ($4::text IS NULL OR p.material_type = $4)
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, count.ID, count.IndustryID, count.ProductID, count.Material)
+	if err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return int(rows), nil
+}
+
+func (r *inventoryCountRepository) FindByID(ctx context.Context, id string) (*entity.InventoryCount, error) {
+	query, args, err := r.selectCounts().Where(sq.Eq{"c.id": id}).ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	count, err := scanInventoryCount(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Inventário")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return count, nil
+}
+
+func (r *inventoryCountRepository) List(ctx context.Context, industryID string, filters entity.InventoryCountFilters) ([]entity.InventoryCount, int, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	where := sq.And{sq.Eq{"c.industry_id": industryID}}
+	if filters.Status != nil {
+		where = append(where, sq.Eq{"c.status": *filters.Status})
+	}
+
+	countSQL, countArgs, err := psql.Select("COUNT(*)").From("inventory_counts c").Where(where).ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countSQL, countArgs...).Scan(&total); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	offset := (filters.Page - 1) * filters.Limit
+	query, args, err := r.selectCounts().
+		Where(where).
+		OrderBy("c.created_at DESC").
+		Limit(uint64(filters.Limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	counts := []entity.InventoryCount{}
+	for rows.Next() {
+		count, err := scanInventoryCount(rows)
+		if err != nil {
+			return nil, 0, errors.DatabaseError(err)
+		}
+		counts = append(counts, *count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	return counts, total, nil
+}
+
+// inventoryCountItemsQuery seleciona os itens da sessão com os contadores atuais dos lotes
+const inventoryCountItemsQuery = `
+	SELECT i.id, i.count_id, i.batch_id, b.batch_code, p.name,
+	       i.expected_slabs, b.available_slabs, b.inactive_slabs, i.counted_slabs, i.counted_system_slabs,
+	       i.counted_by_user_id, u.name, i.counted_at, i.notes, i.adjusted_quantity, i.adjusted_at
+	FROM inventory_count_items i
+	INNER JOIN batches b ON b.id = i.batch_id
+	LEFT JOIN products p ON p.id = b.product_id
+	LEFT JOIN users u ON u.id = i.counted_by_user_id
+	WHERE i.count_id = $1
+	ORDER BY b.batch_code
+`
+
+func (r *inventoryCountRepository) FindItems(ctx context.Context, countID string) ([]entity.InventoryCountItem, error) {
+	return r.findItems(ctx, nil, inventoryCountItemsQuery, countID)
+}
+
+func (r *inventoryCountRepository) FindItemsForUpdate(ctx context.Context, tx *sql.Tx, countID string) ([]entity.InventoryCountItem, error) {
+	return r.findItems(ctx, tx, inventoryCountItemsQuery+"FOR UPDATE OF i", countID)
+}
+
+func (r *inventoryCountRepository) findItems(ctx context.Context, tx *sql.Tx, query, countID string) ([]entity.InventoryCountItem, error) {
+	rows, err := r.db.conn(tx).QueryContext(ctx, query, countID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	items := []entity.InventoryCountItem{}
+	for rows.Next() {
+		var item entity.InventoryCountItem
+		var counted, countedSystem, adjusted sql.NullInt64
+		if err := rows.Scan(
+			&item.ID, &item.CountID, &item.BatchID, &item.BatchCode, &item.ProductName,
+			&item.ExpectedSlabs, &item.SystemSlabs, &item.InactiveSlabs, &counted, &countedSystem,
+			&item.CountedByUserID, &item.CountedByName, &item.CountedAt, &item.Notes, &adjusted, &item.AdjustedAt,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		if counted.Valid {
+			value := int(counted.Int64)
+			item.CountedSlabs = &value
+		}
+		if countedSystem.Valid {
+			value := int(countedSystem.Int64)
+			item.CountedSystemSlabs = &value
+		}
+		if adjusted.Valid {
+			value := int(adjusted.Int64)
+			item.AdjustedQuantity = &value
+		}
+		item.CalculateVariance()
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return items, nil
+}
+
+func (r *inventoryCountRepository) RecordItem(ctx context.Context, countID string, entry entity.InventoryCountEntry, userID string) error {
+	query := `
+		UPDATE inventory_count_items
+		SET counted_slabs = $1, notes = COALESCE($2, notes), counted_by_user_id = $3, counted_at = CURRENT_TIMESTAMP,
+		    counted_system_slabs = (SELECT available_slabs FROM batches WHERE id = $5)
+		WHERE count_id = $4 AND batch_id = $5 AND adjusted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, entry.CountedSlabs, entry.Notes, userID, countID, entry.BatchID)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NotFoundError("Lote não faz parte deste inventário")
+	}
+
+	return nil
+}
+
+func (r *inventoryCountRepository) MarkItemAdjusted(ctx context.Context, tx *sql.Tx, itemID string, quantity int) error {
+	query := `
+		UPDATE inventory_count_items
+		SET adjusted_quantity = $1, adjusted_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND adjusted_at IS NULL
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, quantity, itemID)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewConflictError("Item do inventário já foi ajustado")
+	}
+
+	return nil
+}
+
+func (r *inventoryCountRepository) Close(ctx context.Context, tx *sql.Tx, id string, status entity.InventoryCountStatus, userID string, reason *entity.MovementReason) error {
+	query := `
+		UPDATE inventory_counts
+		SET status = $1, adjustment_reason = $2, closed_by_user_id = $3, closed_at = CURRENT_TIMESTAMP,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4 AND status = $5
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, status, reason, userID, id, entity.InventoryCountStatusAberta)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewConflictError("Inventário já foi encerrado")
+	}
+
+	return nil
+}
+
+func (r *inventoryCountRepository) selectCounts() sq.SelectBuilder {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(inventoryCountColumns...).
+		From("inventory_counts c").
+		LeftJoin("products p ON p.id = c.product_id").
+		LeftJoin("users cu ON cu.id = c.created_by_user_id").
+		LeftJoin("users xu ON xu.id = c.closed_by_user_id").
+		JoinClause("LEFT JOIN " + inventoryCountSummaryJoin)
+}
+
+func scanInventoryCount(row rowScanner) (*entity.InventoryCount, error) {
+	var c entity.InventoryCount
+	var material, reason sql.NullString
+	if err := row.Scan(
+		&c.ID, &c.IndustryID, &c.Scope, &c.ProductID, &c.ProductName, &material, &c.Status, &c.Notes,
+		&reason, &c.CreatedByUserID, &c.CreatedByName, &c.ClosedByUserID, &c.ClosedByName, &c.ClosedAt,
+		&c.CreatedAt, &c.UpdatedAt,
+		&c.Summary.TotalItems, &c.Summary.CountedItems, &c.Summary.VarianceItems, &c.Summary.NetVariance,
+	); err != nil {
+		return nil, err
+	}
+
+	if material.Valid {
+		m := entity.MaterialType(material.String)
+		c.Material = &m
+	}
+	if reason.Valid {
+		m := entity.MovementReason(reason.String)
+		c.AdjustmentReason = &m
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type jobRunRepository struct {
+	db *DB
+}
+
+func NewJobRunRepository(db *DB) *jobRunRepository {
+	return &jobRunRepository{db: db}
+}
+
+const jobRunColumns = `
+	id, job_name, trigger, status, triggered_by_user_id, instance,
+	affected, error, started_at, finished_at
+`
+
+func (r *jobRunRepository) Create(ctx context.Context, run *entity.JobRun) error {
+	query := `
+		INSERT INTO job_runs (id, job_name, trigger, status, triggered_by_user_id, instance)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING started_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		run.ID, run.JobName, run.Trigger, run.Status, run.TriggeredByUserID, run.Instance,
+	).Scan(&run.StartedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *jobRunRepository) Finish(ctx context.Context, run *entity.JobRun) error {
+	query := `
+		UPDATE job_runs
+		SET status = $1, affected = $2, error = $3, finished_at = CURRENT_TIMESTAMP
+		WHERE id = $4
+		RETURNING finished_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, run.Status, run.Affected, run.Error, run.ID).Scan(&run.FinishedAt)
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("Execução de job")
+	}
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *jobRunRepository) FindLast(ctx context.Context, jobName string) (*entity.JobRun, error) {
+	query := `SELECT ` + jobRunColumns + `
+		FROM job_runs
+		WHERE job_name = $1
+		ORDER BY started_at DESC
+		LIMIT 1
+	`
+
+	run, err := scanJobRun(r.db.QueryRowContext(ctx, query, jobName))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Execução de job")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return run, nil
+}
+
+func (r *jobRunRepository) ListByJob(ctx context.Context, jobName string, limit int) ([]entity.JobRun, error) {
+	query := `SELECT ` + jobRunColumns + `
+		FROM job_runs
+		WHERE job_name = $1
+		ORDER BY started_at DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, jobName, limit)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	runs := []entity.JobRun{}
+	for rows.Next() {
+		run, err := scanJobRun(rows)
+		if err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		runs = append(runs, *run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return runs, nil
+}
+
+func (r *jobRunRepository) FailInterrupted(ctx context.Context, jobName string) (int, error) {
+	query := `
+		UPDATE job_runs
+		SET status = 'FALHA', error = 'Execução interrompida', finished_at = CURRENT_TIMESTAMP
+		WHERE job_name = $1 AND status = 'EXECUTANDO'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, jobName)
+	if err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return int(rows), nil
+}
+
+func scanJobRun(row rowScanner) (*entity.JobRun, error) {
+	run := &entity.JobRun{}
+	err := row.Scan(
+		&run.ID, &run.JobName, &run.Trigger, &run.Status, &run.TriggeredByUserID, &run.Instance,
+		&run.Affected, &run.Error, &run.StartedAt, &run.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
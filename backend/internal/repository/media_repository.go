@@ -231,6 +231,92 @@ func (r *mediaRepository) UpdateDisplayOrder(ctx context.Context, id string, ord
 	return nil
 }
 
+func (r *mediaRepository) FindSlabMediaByID(ctx context.Context, id string) (*entity.SlabMedia, error) {
+	query := `
+		SELECT id, slab_id, url, display_order, created_at
+		FROM batch_slab_medias
+		WHERE id = $1
+	`
+
+	var m entity.SlabMedia
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&m.ID, &m.SlabID, &m.URL, &m.DisplayOrder, &m.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NewNotFoundError("Mídia de chapa")
+		}
+		return nil, errors.DatabaseError(err)
+	}
+
+	return &m, nil
+}
+
+func (r *mediaRepository) CreateSlabMedia(ctx context.Context, slabID string, media *entity.CreateMediaInput) error {
+	query := `
+		INSERT INTO batch_slab_medias (id, slab_id, url, display_order)
+		VALUES (gen_random_uuid(), $1, $2, $3)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		slabID, media.URL, media.DisplayOrder,
+	)
+
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *mediaRepository) FindSlabMedias(ctx context.Context, slabID string) ([]entity.Media, error) {
+	query := `
+		SELECT id, url, display_order, created_at
+		FROM batch_slab_medias
+		WHERE slab_id = $1
+		ORDER BY display_order, created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, slabID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	medias := []entity.Media{}
+	for rows.Next() {
+		var m entity.Media
+		if err := rows.Scan(
+			&m.ID, &m.URL, &m.DisplayOrder, &m.CreatedAt,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		medias = append(medias, m)
+	}
+
+	return medias, nil
+}
+
+func (r *mediaRepository) DeleteSlabMedia(ctx context.Context, id string) error {
+	query := `DELETE FROM batch_slab_medias WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Mídia")
+	}
+
+	return nil
+}
+
 func (r *mediaRepository) SetCover(ctx context.Context, productID, mediaID string) error {
 	return r.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
 		// Remover flag is_cover de todas as mídias do produto
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type packingListRepository struct {
+	db *DB
+}
+
+func NewPackingListRepository(db *DB) *packingListRepository {
+	return &packingListRepository{db: db}
+}
+
+func (r *packingListRepository) Upsert(ctx context.Context, packingList *entity.PackingList) error {
+	query := `
+		INSERT INTO packing_lists (
+			id, sale_id, industry_id, number, document, pdf_url, json_url, generated_by_user_id, generated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (sale_id) DO UPDATE
+		SET document = EXCLUDED.document,
+		    pdf_url = EXCLUDED.pdf_url,
+		    json_url = EXCLUDED.json_url,
+		    generated_by_user_id = EXCLUDED.generated_by_user_id,
+		    generated_at = EXCLUDED.generated_at
+		RETURNING id, number, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		packingList.ID, packingList.SaleID, packingList.IndustryID, packingList.Number, packingList.Document,
+		packingList.PDFURL, packingList.JSONURL, packingList.GeneratedByUserID, packingList.GeneratedAt,
+	).Scan(&packingList.ID, &packingList.Number, &packingList.CreatedAt, &packingList.UpdatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *packingListRepository) FindBySaleID(ctx context.Context, saleID string) (*entity.PackingList, error) {
+	query := `
+		SELECT id, sale_id, industry_id, number, document, pdf_url, json_url,
+		       generated_by_user_id, generated_at, created_at, updated_at
+		FROM packing_lists
+		WHERE sale_id = $1
+	`
+
+	pl := &entity.PackingList{}
+	err := r.db.QueryRowContext(ctx, query, saleID).Scan(
+		&pl.ID, &pl.SaleID, &pl.IndustryID, &pl.Number, &pl.Document, &pl.PDFURL, &pl.JSONURL,
+		&pl.GeneratedByUserID, &pl.GeneratedAt, &pl.CreatedAt, &pl.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Romaneio")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return pl, nil
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type priceHistoryRepository struct {
+	db *DB
+}
+
+func NewPriceHistoryRepository(db *DB) *priceHistoryRepository {
+	return &priceHistoryRepository{db: db}
+}
+
+const priceChangeColumns = `
+	h.id, h.industry_id, h.product_id, h.batch_id, h.price, h.price_unit, h.previous_price, h.previous_price_unit,
+	h.effective_from, h.status, h.applied_at, h.notes, h.created_by_user_id, u.name, h.created_at
+`
+
+func (r *priceHistoryRepository) Create(ctx context.Context, tx *sql.Tx, change *entity.PriceChange) error {
+	query := `
+		INSERT INTO price_history (
+			id, industry_id, product_id, batch_id, price, price_unit, previous_price, previous_price_unit,
+			effective_from, status, applied_at, notes, created_by_user_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING created_at
+	`
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		change.ID, change.IndustryID, change.ProductID, change.BatchID, change.Price, change.PriceUnit,
+		change.PreviousPrice, change.PreviousPriceUnit, change.EffectiveFrom, change.Status, change.AppliedAt,
+		change.Notes, change.CreatedByUserID,
+	).Scan(&change.CreatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *priceHistoryRepository) FindByID(ctx context.Context, id string) (*entity.PriceChange, error) {
+	query := `
+		SELECT ` + priceChangeColumns + `
+		FROM price_history h
+		LEFT JOIN users u ON u.id = h.created_by_user_id
+		WHERE h.id = $1
+	`
+
+	change, err := scanPriceChange(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Alteração de preço")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return change, nil
+}
+
+func (r *priceHistoryRepository) FindByProductID(ctx context.Context, productID string) ([]entity.PriceChange, error) {
+	query := `
+		SELECT ` + priceChangeColumns + `
+		FROM price_history h
+		LEFT JOIN users u ON u.id = h.created_by_user_id
+		WHERE h.product_id = $1
+		ORDER BY h.effective_from DESC, h.created_at DESC
+	`
+
+	return r.queryChanges(ctx, nil, query, productID)
+}
+
+func (r *priceHistoryRepository) FindByBatchID(ctx context.Context, batchID string) ([]entity.PriceChange, error) {
+	query := `
+		SELECT ` + priceChangeColumns + `
+		FROM price_history h
+		LEFT JOIN users u ON u.id = h.created_by_user_id
+		WHERE h.batch_id = $1
+		ORDER BY h.effective_from DESC, h.created_at DESC
+	`
+
+	return r.queryChanges(ctx, nil, query, batchID)
+}
+
+func (r *priceHistoryRepository) Cancel(ctx context.Context, tx *sql.Tx, id string) error {
+	query := `
+		UPDATE price_history
+		SET status = $1
+		WHERE id = $2 AND status = $3
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, entity.PriceChangeStatusCancelado, id, entity.PriceChangeStatusAgendado)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewConflictError("Alteração de preço não está mais agendada")
+	}
+
+	return nil
+}
+
+func (r *priceHistoryRepository) FindDueForUpdate(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]entity.PriceChange, error) {
+	// SKIP LOCKED permite várias instâncias do job sem aplicar a mesma alteração duas vezes
+	query := `
+		SELECT ` + priceChangeColumns + `
+		FROM price_history h
+		LEFT JOIN users u ON u.id = h.created_by_user_id
+		WHERE h.status = 'AGENDADO' AND h.effective_from <= $1
+		ORDER BY h.effective_from, h.created_at
+		LIMIT $2
+		FOR UPDATE OF h SKIP LOCKED
+	`
+
+	return r.queryChanges(ctx, tx, query, now, limit)
+}
+
+func (r *priceHistoryRepository) MarkApplied(ctx context.Context, tx *sql.Tx, id string, previousPrice *float64, previousUnit *entity.PriceUnit) error {
+	query := `
+		UPDATE price_history
+		SET status = $1, applied_at = CURRENT_TIMESTAMP, previous_price = $2, previous_price_unit = $3
+		WHERE id = $4
+	`
+
+	if _, err := r.db.conn(tx).ExecContext(ctx, query, entity.PriceChangeStatusAplicado, previousPrice, previousUnit, id); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *priceHistoryRepository) queryChanges(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) ([]entity.PriceChange, error) {
+	rows, err := r.db.conn(tx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	changes := []entity.PriceChange{}
+	for rows.Next() {
+		change, err := scanPriceChange(rows)
+		if err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		changes = append(changes, *change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return changes, nil
+}
+
+func scanPriceChange(row rowScanner) (*entity.PriceChange, error) {
+	var c entity.PriceChange
+	var previousUnit sql.NullString
+	if err := row.Scan(
+		&c.ID, &c.IndustryID, &c.ProductID, &c.BatchID, &c.Price, &c.PriceUnit, &c.PreviousPrice, &previousUnit,
+		&c.EffectiveFrom, &c.Status, &c.AppliedAt, &c.Notes, &c.CreatedByUserID, &c.CreatedByName, &c.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if previousUnit.Valid {
+		unit := entity.PriceUnit(previousUnit.String)
+		c.PreviousPriceUnit = &unit
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,350 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type priceListRepository struct {
+	db *DB
+}
+
+func NewPriceListRepository(db *DB) *priceListRepository {
+	return &priceListRepository{db: db}
+}
+
+var priceListColumns = []string{
+	"pl.id", "pl.industry_id", "pl.name", "pl.description", "pl.is_active", "pl.created_at", "pl.updated_at",
+	"(SELECT COUNT(*) FROM price_list_assignments a WHERE a.price_list_id = pl.id)",
+}
+
+func (r *priceListRepository) Create(ctx context.Context, tx *sql.Tx, list *entity.PriceList) error {
+	query := `
+		INSERT INTO price_lists (id, industry_id, name, description, is_active)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		list.ID, list.IndustryID, list.Name, list.Description, list.IsActive,
+	).Scan(&list.CreatedAt, &list.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Já existe uma tabela de preço com este nome")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *priceListRepository) FindByID(ctx context.Context, id string) (*entity.PriceList, error) {
+	lists, err := r.query(ctx, sq.Eq{"pl.id": id})
+	if err != nil {
+		return nil, err
+	}
+	if len(lists) == 0 {
+		return nil, errors.NewNotFoundError("Tabela de preço")
+	}
+
+	return &lists[0], nil
+}
+
+func (r *priceListRepository) List(ctx context.Context, industryID string) ([]entity.PriceList, error) {
+	return r.query(ctx, sq.Eq{"pl.industry_id": industryID})
+}
+
+func (r *priceListRepository) Update(ctx context.Context, tx *sql.Tx, list *entity.PriceList) error {
+	query := `
+		UPDATE price_lists
+		SET name = $1, description = $2, is_active = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4
+		RETURNING updated_at
+	`
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		list.Name, list.Description, list.IsActive, list.ID,
+	).Scan(&list.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("Tabela de preço")
+	}
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Já existe uma tabela de preço com este nome")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *priceListRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM price_lists WHERE id = $1`, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Tabela de preço")
+	}
+
+	return nil
+}
+
+func (r *priceListRepository) ExistsByName(ctx context.Context, industryID, name, excludeID string) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM price_lists
+			WHERE industry_id = $1 AND LOWER(name) = LOWER($2) AND ($3 = '' OR id::text <> $3)
+		)
+	`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, industryID, name, excludeID).Scan(&exists); err != nil {
+		return false, errors.DatabaseError(err)
+	}
+
+	return exists, nil
+}
+
+func (r *priceListRepository) ReplaceRules(ctx context.Context, tx *sql.Tx, listID string, rules []entity.PriceListRule) error {
+	conn := r.db.conn(tx)
+
+	if _, err := conn.ExecContext(ctx, `DELETE FROM price_list_rules WHERE price_list_id = $1`, listID); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	query := `
+		INSERT INTO price_list_rules (id, price_list_id, product_id, material_type, rule_type, value, price_unit)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at
+	`
+
+	for i := range rules {
+		rule := &rules[i]
+		rule.PriceListID = listID
+		err := conn.QueryRowContext(ctx, query,
+			rule.ID, rule.PriceListID, rule.ProductID, rule.Material, rule.RuleType, rule.Value, rule.PriceUnit,
+		).Scan(&rule.CreatedAt)
+		if err != nil {
+			return errors.DatabaseError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *priceListRepository) Assign(ctx context.Context, assignment *entity.PriceListAssignment) error {
+	// Cada usuário/cliente possui no máximo uma tabela por indústria: a nova atribuição substitui a anterior
+	target, conflict := "user_id", "(industry_id, user_id) WHERE user_id IS NOT NULL"
+	if assignment.ClienteID != nil {
+		target, conflict = "cliente_id", "(industry_id, cliente_id) WHERE cliente_id IS NOT NULL"
+	}
+
+	query := `
+		INSERT INTO price_list_assignments (id, industry_id, price_list_id, ` + target + `)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT ` + conflict + `
+		DO UPDATE SET price_list_id = EXCLUDED.price_list_id, created_at = CURRENT_TIMESTAMP
+		RETURNING id, created_at
+	`
+
+	targetID := assignment.UserID
+	if assignment.ClienteID != nil {
+		targetID = assignment.ClienteID
+	}
+
+	err := r.db.QueryRowContext(ctx, query,
+		assignment.ID, assignment.IndustryID, assignment.PriceListID, targetID,
+	).Scan(&assignment.ID, &assignment.CreatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+const priceListAssignmentSelect = `
+	SELECT a.id, a.industry_id, a.price_list_id, a.user_id, u.name, a.cliente_id, c.name, a.created_at
+	FROM price_list_assignments a
+	LEFT JOIN users u ON u.id = a.user_id
+	LEFT JOIN clientes c ON c.id = a.cliente_id
+`
+
+func (r *priceListRepository) FindAssignmentByID(ctx context.Context, id string) (*entity.PriceListAssignment, error) {
+	var a entity.PriceListAssignment
+	err := r.db.QueryRowContext(ctx, priceListAssignmentSelect+` WHERE a.id = $1`, id).Scan(
+		&a.ID, &a.IndustryID, &a.PriceListID, &a.UserID, &a.UserName, &a.ClienteID, &a.ClienteName, &a.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Atribuição de tabela de preço")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return &a, nil
+}
+
+func (r *priceListRepository) ListAssignments(ctx context.Context, listID string) ([]entity.PriceListAssignment, error) {
+	rows, err := r.db.QueryContext(ctx, priceListAssignmentSelect+` WHERE a.price_list_id = $1 ORDER BY a.created_at DESC`, listID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	assignments := []entity.PriceListAssignment{}
+	for rows.Next() {
+		var a entity.PriceListAssignment
+		if err := rows.Scan(
+			&a.ID, &a.IndustryID, &a.PriceListID, &a.UserID, &a.UserName, &a.ClienteID, &a.ClienteName, &a.CreatedAt,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		assignments = append(assignments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return assignments, nil
+}
+
+func (r *priceListRepository) DeleteAssignment(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM price_list_assignments WHERE id = $1`, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Atribuição de tabela de preço")
+	}
+
+	return nil
+}
+
+func (r *priceListRepository) FindActiveForUser(ctx context.Context, industryID, userID string) (*entity.PriceList, error) {
+	return r.findAssigned(ctx, industryID, "a.user_id", userID)
+}
+
+func (r *priceListRepository) FindActiveForCliente(ctx context.Context, industryID, clienteID string) (*entity.PriceList, error) {
+	return r.findAssigned(ctx, industryID, "a.cliente_id", clienteID)
+}
+
+func (r *priceListRepository) findAssigned(ctx context.Context, industryID, column, id string) (*entity.PriceList, error) {
+	lists, err := r.query(ctx, sq.And{
+		sq.Eq{"pl.industry_id": industryID, "pl.is_active": true},
+		sq.Expr(`EXISTS (SELECT 1 FROM price_list_assignments a WHERE a.price_list_id = pl.id AND a.industry_id = ? AND `+column+` = ?)`, industryID, id),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(lists) == 0 {
+		return nil, nil
+	}
+
+	return &lists[0], nil
+}
+
+func (r *priceListRepository) query(ctx context.Context, where sq.Sqlizer) ([]entity.PriceList, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.Select(priceListColumns...).
+		From("price_lists pl").
+		Where(where).
+		OrderBy("pl.name").
+		ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	lists := []entity.PriceList{}
+	for rows.Next() {
+		var l entity.PriceList
+		if err := rows.Scan(
+			&l.ID, &l.IndustryID, &l.Name, &l.Description, &l.IsActive, &l.CreatedAt, &l.UpdatedAt, &l.AssignmentCount,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		lists = append(lists, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	if err := r.loadRules(ctx, lists); err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+// loadRules preenche as regras de cada tabela
+func (r *priceListRepository) loadRules(ctx context.Context, lists []entity.PriceList) error {
+	if len(lists) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(lists))
+	index := make(map[string]int, len(lists))
+	for i := range lists {
+		lists[i].Rules = []entity.PriceListRule{}
+		ids = append(ids, lists[i].ID)
+		index[lists[i].ID] = i
+	}
+
+	query := `
+		SELECT id, price_list_id, product_id, material_type, rule_type, value, price_unit, created_at
+		FROM price_list_rules
+		WHERE price_list_id = ANY($1::uuid[])
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rule entity.PriceListRule
+		if err := rows.Scan(
+			&rule.ID, &rule.PriceListID, &rule.ProductID, &rule.Material, &rule.RuleType, &rule.Value, &rule.PriceUnit, &rule.CreatedAt,
+		); err != nil {
+			return errors.DatabaseError(err)
+		}
+		if i, ok := index[rule.PriceListID]; ok {
+			lists[i].Rules = append(lists[i].Rules, rule)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
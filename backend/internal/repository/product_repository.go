@@ -17,11 +17,11 @@ func NewProductRepository(db *DB) *productRepository {
 	return &productRepository{db: db}
 }
 
-func (r *productRepository) Create(ctx context.Context, product *entity.Product) error {
+func (r *productRepository) Create(ctx context.Context, tx *sql.Tx, product *entity.Product) error {
 	query := `
 		INSERT INTO products (id, industry_id, name, sku_code, description, 
-		                      material_type, finish_type, base_price, price_unit, is_public_catalog)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		                      material_type, finish_type, base_price, price_unit, is_public_catalog, currency)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING created_at, updated_at
 	`
 
@@ -31,10 +31,10 @@ func (r *productRepository) Create(ctx context.Context, product *entity.Product)
 		priceUnit = entity.PriceUnitM2
 	}
 
-	err := r.db.QueryRowContext(ctx, query,
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
 		product.ID, product.IndustryID, product.Name, product.SKU,
 		product.Description, product.Material, product.Finish,
-		product.BasePrice, priceUnit, product.IsPublicCatalog,
+		product.BasePrice, priceUnit, product.IsPublicCatalog, product.Currency.OrDefault(),
 	).Scan(&product.CreatedAt, &product.UpdatedAt)
 
 	if err != nil {
@@ -47,7 +47,7 @@ func (r *productRepository) Create(ctx context.Context, product *entity.Product)
 func (r *productRepository) FindByID(ctx context.Context, id string) (*entity.Product, error) {
 	query := `
 		SELECT id, industry_id, name, sku_code, description, material_type, 
-		       finish_type, base_price, price_unit, is_public_catalog, created_at, updated_at
+		       finish_type, base_price, price_unit, currency, is_public_catalog, created_at, updated_at
 		FROM products
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -57,7 +57,7 @@ func (r *productRepository) FindByID(ctx context.Context, id string) (*entity.Pr
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&product.ID, &product.IndustryID, &product.Name, &product.SKU,
 		&product.Description, &product.Material, &product.Finish,
-		&product.BasePrice, &priceUnit, &product.IsPublicCatalog,
+		&product.BasePrice, &priceUnit, &product.Currency, &product.IsPublicCatalog,
 		&product.CreatedAt, &product.UpdatedAt,
 	)
 
@@ -84,7 +84,7 @@ func (r *productRepository) FindByIndustryID(ctx context.Context, industryID str
 	// Query principal com aggregate para disponibilidade
 	query := psql.Select(
 		"p.id", "p.industry_id", "p.name", "p.sku_code", "p.description",
-		"p.material_type", "p.finish_type", "p.base_price", "p.price_unit", "p.is_public_catalog",
+		"p.material_type", "p.finish_type", "p.base_price", "p.price_unit", "p.currency", "p.is_public_catalog",
 		"p.created_at", "p.updated_at",
 		"COALESCE(COUNT(b.id), 0) as batch_count",
 		"COALESCE(SUM(CASE WHEN b.available_slabs > 0 THEN 1 ELSE 0 END), 0) as available_batch_count",
@@ -113,15 +113,10 @@ func (r *productRepository) FindByIndustryID(ctx context.Context, industryID str
 		query = query.Where(sq.Eq{"p.is_public_catalog": true})
 	}
 
-	// Filtro de busca multi-campo (nome, SKU, material, descrição)
-	if filters.Search != nil && *filters.Search != "" {
-		search := "%" + *filters.Search + "%"
-		query = query.Where(sq.Or{
-			sq.Expr("p.name ILIKE ?", search),
-			sq.Expr("p.sku_code ILIKE ?", search),
-			sq.Expr("p.material_type ILIKE ?", search),
-			sq.Expr("p.description ILIKE ?", search),
-		})
+	// Busca multi-campo sem acentos (nome, SKU, material, acabamento, descrição)
+	search := searchTerm(filters.Search)
+	if search != "" {
+		query = query.Where(searchCondition("p.", search))
 	}
 
 	// Contar total dos produtos filtrados (query parametrizada, segura contra SQL injection)
@@ -141,14 +136,8 @@ func (r *productRepository) FindByIndustryID(ctx context.Context, industryID str
 	if filters.OnlyPublic {
 		countQueryDirect = countQueryDirect.Where(sq.Eq{"p.is_public_catalog": true})
 	}
-	if filters.Search != nil && *filters.Search != "" {
-		search := "%" + *filters.Search + "%"
-		countQueryDirect = countQueryDirect.Where(sq.Or{
-			sq.Expr("p.name ILIKE ?", search),
-			sq.Expr("p.sku_code ILIKE ?", search),
-			sq.Expr("p.material_type ILIKE ?", search),
-			sq.Expr("p.description ILIKE ?", search),
-		})
+	if search != "" {
+		countQueryDirect = countQueryDirect.Where(searchCondition("p.", search))
 	}
 
 	countSQL, countArgs, _ := countQueryDirect.ToSql()
@@ -175,6 +164,11 @@ func (r *productRepository) FindByIndustryID(ctx context.Context, industryID str
 			orderBy = "available_batch_count " + order + ", p.name ASC"
 		}
 	}
+	if search != "" && filters.SortBy == nil {
+		// Sem ordenação explícita, a busca retorna os mais relevantes primeiro
+		rank, rankArgs := searchRankOrder("p.", search)
+		query = query.OrderByClause(rank, rankArgs...)
+	}
 	query = query.OrderBy(orderBy)
 
 	// Paginação
@@ -200,7 +194,7 @@ func (r *productRepository) FindByIndustryID(ctx context.Context, industryID str
 		var priceUnit sql.NullString
 		if err := rows.Scan(
 			&p.ID, &p.IndustryID, &p.Name, &p.SKU, &p.Description,
-			&p.Material, &p.Finish, &p.BasePrice, &priceUnit, &p.IsPublicCatalog,
+			&p.Material, &p.Finish, &p.BasePrice, &priceUnit, &p.Currency, &p.IsPublicCatalog,
 			&p.CreatedAt, &p.UpdatedAt, &batchCount, &availableBatchCount,
 		); err != nil {
 			return nil, 0, errors.DatabaseError(err)
@@ -233,13 +227,13 @@ func (r *productRepository) FindByIndustryID(ctx context.Context, industryID str
 	return products, total, nil
 }
 
-func (r *productRepository) Update(ctx context.Context, product *entity.Product) error {
+func (r *productRepository) Update(ctx context.Context, tx *sql.Tx, product *entity.Product) error {
 	query := `
 		UPDATE products
 		SET name = $1, sku_code = $2, description = $3, 
 		    material_type = $4, finish_type = $5, base_price = $6, price_unit = $7,
-		    is_public_catalog = $8, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $9 AND deleted_at IS NULL
+		    is_public_catalog = $8, currency = $9, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $10 AND deleted_at IS NULL
 		RETURNING updated_at
 	`
 
@@ -249,10 +243,10 @@ func (r *productRepository) Update(ctx context.Context, product *entity.Product)
 		priceUnit = entity.PriceUnitM2
 	}
 
-	err := r.db.QueryRowContext(ctx, query,
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
 		product.Name, product.SKU, product.Description,
 		product.Material, product.Finish, product.BasePrice, priceUnit,
-		product.IsPublicCatalog, product.ID,
+		product.IsPublicCatalog, product.Currency.OrDefault(), product.ID,
 	).Scan(&product.UpdatedAt)
 
 	if err == sql.ErrNoRows {
@@ -265,6 +259,30 @@ func (r *productRepository) Update(ctx context.Context, product *entity.Product)
 	return nil
 }
 
+func (r *productRepository) UpdatePrice(ctx context.Context, tx *sql.Tx, id string, price float64, unit entity.PriceUnit) error {
+	query := `
+		UPDATE products
+		SET base_price = $1, price_unit = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3 AND deleted_at IS NULL
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, price, unit, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Produto")
+	}
+
+	return nil
+}
+
 func (r *productRepository) SoftDelete(ctx context.Context, id string) error {
 	query := `
 		UPDATE products
@@ -339,3 +357,23 @@ func (r *productRepository) ExistsBySKU(ctx context.Context, industryID, sku str
 
 	return exists, nil
 }
+
+func (r *productRepository) FindBySKU(ctx context.Context, industryID, sku string) (*entity.Product, error) {
+	query := `
+		SELECT id
+		FROM products
+		WHERE industry_id = $1 AND sku_code = $2 AND deleted_at IS NULL
+		LIMIT 1
+	`
+
+	var id string
+	err := r.db.QueryRowContext(ctx, query, industryID, sku).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Produto")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return r.FindByID(ctx, id)
+}
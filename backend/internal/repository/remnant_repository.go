@@ -0,0 +1,363 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type remnantRepository struct {
+	db *DB
+}
+
+func NewRemnantRepository(db *DB) *remnantRepository {
+	return &remnantRepository{db: db}
+}
+
+var remnantColumns = []string{
+	"rm.id", "rm.industry_id", "rm.source_batch_id", "b.batch_code", "b.product_id", "p.name",
+	"COALESCE(p.material_type, '')", "rm.code", "rm.height", "rm.width", "rm.thickness", "rm.area",
+	"rm.industry_price", "rm.price_unit", "rm.currency", "rm.status", "rm.notes", "rm.created_by_user_id",
+	"rm.created_at", "rm.updated_at",
+}
+
+func (r *remnantRepository) Create(ctx context.Context, tx *sql.Tx, remnant *entity.Remnant) error {
+	conn := r.db.conn(tx)
+
+	// O lote de origem é bloqueado pelo serviço, garantindo o sequencial
+	var sequence int
+	err := conn.QueryRowContext(ctx,
+		`SELECT COALESCE(MAX(sequence), 0) + 1 FROM remnants WHERE source_batch_id = $1`,
+		remnant.SourceBatchID,
+	).Scan(&sequence)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+	remnant.Code = entity.FormatRemnantCode(remnant.BatchCode, sequence)
+
+	query := `
+		INSERT INTO remnants (
+			id, industry_id, source_batch_id, sequence, code, height, width, thickness, area,
+			industry_price, price_unit, currency, status, notes, created_by_user_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING created_at, updated_at
+	`
+
+	err = conn.QueryRowContext(ctx, query,
+		remnant.ID, remnant.IndustryID, remnant.SourceBatchID, sequence, remnant.Code,
+		remnant.Height, remnant.Width, remnant.Thickness, remnant.Area,
+		remnant.IndustryPrice, remnant.PriceUnit, remnant.Currency.OrDefault(), remnant.Status,
+		remnant.Notes, remnant.CreatedByUserID,
+	).Scan(&remnant.CreatedAt, &remnant.UpdatedAt)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *remnantRepository) FindByID(ctx context.Context, id string) (*entity.Remnant, error) {
+	query, args, err := r.selectRemnants().Where(sq.Eq{"rm.id": id}).ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	remnant, err := scanRemnant(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Retalho")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	photos, err := r.findPhotos(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	remnant.Photos = photos
+
+	return remnant, nil
+}
+
+func (r *remnantRepository) FindByIDForUpdate(ctx context.Context, tx *sql.Tx, id string) (*entity.Remnant, error) {
+	query, args, err := r.selectRemnants().Where(sq.Eq{"rm.id": id}).Suffix("FOR UPDATE OF rm").ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	remnant, err := scanRemnant(tx.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Retalho")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return remnant, nil
+}
+
+func (r *remnantRepository) List(ctx context.Context, industryID string, filters entity.RemnantFilters) ([]entity.Remnant, int, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	where := sq.And{sq.Eq{"rm.industry_id": industryID}}
+	if filters.SourceBatchID != nil {
+		where = append(where, sq.Eq{"rm.source_batch_id": *filters.SourceBatchID})
+	}
+	if filters.ProductID != nil {
+		where = append(where, sq.Eq{"b.product_id": *filters.ProductID})
+	}
+	if filters.Material != nil {
+		where = append(where, sq.Eq{"p.material_type": *filters.Material})
+	}
+	if filters.Status != nil {
+		where = append(where, sq.Eq{"rm.status": *filters.Status})
+	}
+	if filters.Search != nil && *filters.Search != "" {
+		search := "%" + *filters.Search + "%"
+		where = append(where, sq.Or{
+			sq.Expr("rm.code ILIKE ?", search),
+			sq.Expr("b.batch_code ILIKE ?", search),
+			sq.Expr("p.name ILIKE ?", search),
+		})
+	}
+	// A peça atende às dimensões mínimas em qualquer orientação
+	if filters.MinHeight != nil || filters.MinWidth != nil {
+		minHeight, minWidth := 0.0, 0.0
+		if filters.MinHeight != nil {
+			minHeight = *filters.MinHeight
+		}
+		if filters.MinWidth != nil {
+			minWidth = *filters.MinWidth
+		}
+		where = append(where, sq.Or{
+			sq.Expr("(rm.height >= ? AND rm.width >= ?)", minHeight, minWidth),
+			sq.Expr("(rm.height >= ? AND rm.width >= ?)", minWidth, minHeight),
+		})
+	}
+	if filters.MinArea != nil {
+		where = append(where, sq.GtOrEq{"rm.area": *filters.MinArea})
+	}
+	if filters.MaxArea != nil {
+		where = append(where, sq.LtOrEq{"rm.area": *filters.MaxArea})
+	}
+
+	countSQL, countArgs, err := psql.Select("COUNT(*)").
+		From("remnants rm").
+		Join("batches b ON b.id = rm.source_batch_id").
+		LeftJoin("products p ON p.id = b.product_id").
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countSQL, countArgs...).Scan(&total); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	offset := (filters.Page - 1) * filters.Limit
+	query, args, err := r.selectRemnants().
+		Where(where).
+		OrderBy("rm.created_at DESC").
+		Limit(uint64(filters.Limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	remnants := []entity.Remnant{}
+	for rows.Next() {
+		remnant, err := scanRemnant(rows)
+		if err != nil {
+			return nil, 0, errors.DatabaseError(err)
+		}
+		remnants = append(remnants, *remnant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	return remnants, total, nil
+}
+
+func (r *remnantRepository) Update(ctx context.Context, remnant *entity.Remnant) error {
+	query := `
+		UPDATE remnants
+		SET height = $1, width = $2, thickness = $3, area = $4, industry_price = $5,
+		    price_unit = $6, currency = $7, status = $8, notes = $9, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $10
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		remnant.Height, remnant.Width, remnant.Thickness, remnant.Area, remnant.IndustryPrice,
+		remnant.PriceUnit, remnant.Currency.OrDefault(), remnant.Status, remnant.Notes, remnant.ID,
+	).Scan(&remnant.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("Retalho")
+	}
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *remnantRepository) UpdateStatus(ctx context.Context, tx *sql.Tx, id string, status entity.BatchStatus) error {
+	result, err := r.db.conn(tx).ExecContext(ctx,
+		`UPDATE remnants SET status = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2`,
+		status, id,
+	)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Retalho")
+	}
+
+	return nil
+}
+
+func (r *remnantRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM remnants WHERE id = $1`, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Retalho")
+	}
+
+	return nil
+}
+
+func (r *remnantRepository) HasSales(ctx context.Context, id string) (bool, error) {
+	query := `
+		SELECT EXISTS(SELECT 1 FROM sales_history WHERE remnant_id = $1)
+		    OR EXISTS(SELECT 1 FROM reservations WHERE remnant_id = $1)
+	`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&exists); err != nil {
+		return false, errors.DatabaseError(err)
+	}
+
+	return exists, nil
+}
+
+func (r *remnantRepository) AddPhotos(ctx context.Context, remnantID string, urls []string) error {
+	query := `
+		INSERT INTO remnant_photos (remnant_id, url, display_order)
+		SELECT $1, $2, COALESCE(MAX(display_order) + 1, 0)
+		FROM remnant_photos
+		WHERE remnant_id = $1
+	`
+
+	for _, url := range urls {
+		if _, err := r.db.ExecContext(ctx, query, remnantID, url); err != nil {
+			return errors.DatabaseError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *remnantRepository) RemovePhoto(ctx context.Context, remnantID, photoID string) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM remnant_photos WHERE id = $1 AND remnant_id = $2`,
+		photoID, remnantID,
+	)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Foto do retalho")
+	}
+
+	return nil
+}
+
+func (r *remnantRepository) findPhotos(ctx context.Context, remnantID string) ([]entity.Media, error) {
+	query := `
+		SELECT id, url, display_order, created_at
+		FROM remnant_photos
+		WHERE remnant_id = $1
+		ORDER BY display_order, created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, remnantID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	photos := []entity.Media{}
+	for rows.Next() {
+		var m entity.Media
+		if err := rows.Scan(&m.ID, &m.URL, &m.DisplayOrder, &m.CreatedAt); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		photos = append(photos, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return photos, nil
+}
+
+func (r *remnantRepository) selectRemnants() sq.SelectBuilder {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(remnantColumns...).
+		From("remnants rm").
+		Join("batches b ON b.id = rm.source_batch_id").
+		LeftJoin("products p ON p.id = b.product_id")
+}
+
+func scanRemnant(row rowScanner) (*entity.Remnant, error) {
+	var rm entity.Remnant
+	var productName sql.NullString
+	if err := row.Scan(
+		&rm.ID, &rm.IndustryID, &rm.SourceBatchID, &rm.BatchCode, &rm.ProductID, &productName,
+		&rm.Material, &rm.Code, &rm.Height, &rm.Width, &rm.Thickness, &rm.Area,
+		&rm.IndustryPrice, &rm.PriceUnit, &rm.Currency, &rm.Status, &rm.Notes, &rm.CreatedByUserID,
+		&rm.CreatedAt, &rm.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	rm.ProductName = productName.String
+	rm.PopulateCalculatedFields()
+
+	return &rm, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type reservationEventRepository struct {
+	db *DB
+}
+
+func NewReservationEventRepository(db *DB) *reservationEventRepository {
+	return &reservationEventRepository{db: db}
+}
+
+func (r *reservationEventRepository) Create(ctx context.Context, tx *sql.Tx, event *entity.ReservationEvent) error {
+	query := `
+		INSERT INTO reservation_events (
+			id, reservation_id, event_type, from_status, to_status, actor_user_id,
+			old_price, new_price, old_expires_at, new_expires_at, sale_id, notes, created_at
+		) VALUES ($1, $2, $3, NULLIF($4, '')::reservation_status_type, NULLIF($5, '')::reservation_status_type, $6,
+			$7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err := r.db.conn(tx).ExecContext(ctx, query,
+		event.ID, event.ReservationID, event.Type, string(event.FromStatus), string(event.ToStatus), event.ActorUserID,
+		event.OldPrice, event.NewPrice, event.OldExpiresAt, event.NewExpiresAt, event.SaleID, event.Notes, event.CreatedAt,
+	)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *reservationEventRepository) FindByReservation(ctx context.Context, reservationID string) ([]entity.ReservationEvent, error) {
+	query := `
+		SELECT e.id, e.reservation_id, e.event_type, COALESCE(e.from_status::TEXT, ''), COALESCE(e.to_status::TEXT, ''),
+		       e.actor_user_id, e.old_price, e.new_price, e.old_expires_at, e.new_expires_at, e.sale_id, e.notes, e.created_at,
+		       COALESCE(u.name, '')
+		FROM reservation_events e
+		LEFT JOIN users u ON u.id = e.actor_user_id
+		WHERE e.reservation_id = $1
+		ORDER BY e.created_at, e.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, reservationID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	events := []entity.ReservationEvent{}
+	for rows.Next() {
+		var e entity.ReservationEvent
+		if err := rows.Scan(
+			&e.ID, &e.ReservationID, &e.Type, &e.FromStatus, &e.ToStatus,
+			&e.ActorUserID, &e.OldPrice, &e.NewPrice, &e.OldExpiresAt, &e.NewExpiresAt, &e.SaleID, &e.Notes, &e.CreatedAt,
+			&e.ActorName,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return events, nil
+}
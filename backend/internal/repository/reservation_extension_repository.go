@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type reservationExtensionRepository struct {
+	db *DB
+}
+
+func NewReservationExtensionRepository(db *DB) *reservationExtensionRepository {
+	return &reservationExtensionRepository{db: db}
+}
+
+var reservationExtensionColumns = []string{
+	"e.id", "e.reservation_id", "e.industry_id", "e.requested_by_user_id", "e.justification",
+	"e.old_expires_at", "e.requested_expires_at", "e.new_expires_at", "e.status",
+	"e.decided_by_user_id", "e.decided_at", "e.decision_reason", "e.created_at", "e.updated_at",
+	"COALESCE(u.name, '')", "d.name",
+}
+
+func (r *reservationExtensionRepository) Create(ctx context.Context, tx *sql.Tx, extension *entity.ReservationExtension) error {
+	query := `
+		INSERT INTO reservation_extensions (
+			id, reservation_id, industry_id, requested_by_user_id, justification,
+			old_expires_at, requested_expires_at, status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		extension.ID, extension.ReservationID, extension.IndustryID, extension.RequestedByUserID, extension.Justification,
+		extension.OldExpiresAt, extension.RequestedExpiresAt, extension.Status,
+	).Scan(&extension.CreatedAt, &extension.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Já existe um pedido de prorrogação pendente para esta reserva")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *reservationExtensionRepository) FindByID(ctx context.Context, id string) (*entity.ReservationExtension, error) {
+	query, args, err := r.selectBuilder().Where(sq.Eq{"e.id": id}).ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	extension, err := scanReservationExtension(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Pedido de prorrogação")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return extension, nil
+}
+
+func (r *reservationExtensionRepository) FindByReservation(ctx context.Context, reservationID string) ([]entity.ReservationExtension, error) {
+	query, args, err := r.selectBuilder().
+		Where(sq.Eq{"e.reservation_id": reservationID}).
+		OrderBy("e.created_at", "e.id").
+		ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return r.queryExtensions(ctx, query, args...)
+}
+
+func (r *reservationExtensionRepository) List(ctx context.Context, filters entity.ReservationExtensionFilters) ([]entity.ReservationExtension, error) {
+	where := sq.And{}
+	if filters.IndustryID != nil {
+		where = append(where, sq.Eq{"e.industry_id": *filters.IndustryID})
+	}
+	if filters.UserID != nil {
+		where = append(where, sq.Eq{"e.requested_by_user_id": *filters.UserID})
+	}
+	if filters.ReservationID != nil {
+		where = append(where, sq.Eq{"e.reservation_id": *filters.ReservationID})
+	}
+	if filters.Status != nil {
+		where = append(where, sq.Eq{"e.status": *filters.Status})
+	}
+
+	query, args, err := r.selectBuilder().
+		Where(where).
+		OrderBy("e.created_at DESC", "e.id").
+		ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return r.queryExtensions(ctx, query, args...)
+}
+
+func (r *reservationExtensionRepository) CountApproved(ctx context.Context, tx *sql.Tx, reservationID string) (int, error) {
+	query := `SELECT COUNT(*) FROM reservation_extensions WHERE reservation_id = $1 AND status = 'APROVADA'`
+
+	var count int
+	if err := r.db.conn(tx).QueryRowContext(ctx, query, reservationID).Scan(&count); err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return count, nil
+}
+
+func (r *reservationExtensionRepository) Approve(ctx context.Context, tx *sql.Tx, id, approverID string, oldExpiresAt, newExpiresAt time.Time) error {
+	query := `
+		UPDATE reservation_extensions
+		SET status = 'APROVADA', old_expires_at = $1, new_expires_at = $2,
+		    decided_by_user_id = $3, decided_at = CURRENT_TIMESTAMP
+		WHERE id = $4 AND status = 'PENDENTE'
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, oldExpiresAt, newExpiresAt, approverID, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return checkExtensionDecided(result)
+}
+
+func (r *reservationExtensionRepository) Deny(ctx context.Context, tx *sql.Tx, id, approverID, reason string) error {
+	query := `
+		UPDATE reservation_extensions
+		SET status = 'NEGADA', decision_reason = $1,
+		    decided_by_user_id = $2, decided_at = CURRENT_TIMESTAMP
+		WHERE id = $3 AND status = 'PENDENTE'
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, reason, approverID, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return checkExtensionDecided(result)
+}
+
+// checkExtensionDecided trata pedido inexistente ou já decidido (decisões concorrentes)
+func checkExtensionDecided(result sql.Result) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+	if rows == 0 {
+		return errors.NewNotFoundError("Pedido de prorrogação pendente")
+	}
+	return nil
+}
+
+func (r *reservationExtensionRepository) queryExtensions(ctx context.Context, query string, args ...interface{}) ([]entity.ReservationExtension, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	extensions := []entity.ReservationExtension{}
+	for rows.Next() {
+		extension, err := scanReservationExtension(rows)
+		if err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		extensions = append(extensions, *extension)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return extensions, nil
+}
+
+func (r *reservationExtensionRepository) selectBuilder() sq.SelectBuilder {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(reservationExtensionColumns...).
+		From("reservation_extensions e").
+		LeftJoin("users u ON u.id = e.requested_by_user_id").
+		LeftJoin("users d ON d.id = e.decided_by_user_id")
+}
+
+func scanReservationExtension(row rowScanner) (*entity.ReservationExtension, error) {
+	e := &entity.ReservationExtension{}
+	err := row.Scan(
+		&e.ID, &e.ReservationID, &e.IndustryID, &e.RequestedByUserID, &e.Justification,
+		&e.OldExpiresAt, &e.RequestedExpiresAt, &e.NewExpiresAt, &e.Status,
+		&e.DecidedByUserID, &e.DecidedAt, &e.DecisionReason, &e.CreatedAt, &e.UpdatedAt,
+		&e.RequestedBy, &e.DecidedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
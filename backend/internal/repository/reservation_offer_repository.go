@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type reservationOfferRepository struct {
+	db *DB
+}
+
+func NewReservationOfferRepository(db *DB) *reservationOfferRepository {
+	return &reservationOfferRepository{db: db}
+}
+
+func (r *reservationOfferRepository) Create(ctx context.Context, tx *sql.Tx, offer *entity.ReservationOffer) error {
+	query := `
+		INSERT INTO reservation_offers (
+			id, reservation_id, offered_by_user_id, side, price, notes, status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at
+	`
+
+	err := r.db.conn(tx).QueryRowContext(ctx, query,
+		offer.ID, offer.ReservationID, offer.OfferedByUserID, offer.Side, offer.Price, offer.Notes, offer.Status,
+	).Scan(&offer.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Já existe uma proposta aguardando resposta para esta reserva")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *reservationOfferRepository) FindByReservation(ctx context.Context, reservationID string) ([]entity.ReservationOffer, error) {
+	query := `
+		SELECT o.id, o.reservation_id, o.offered_by_user_id, o.side, o.price, o.notes, o.status,
+		       o.responded_by_user_id, o.responded_at, o.created_at, COALESCE(u.name, '')
+		FROM reservation_offers o
+		LEFT JOIN users u ON u.id = o.offered_by_user_id
+		WHERE o.reservation_id = $1
+		ORDER BY o.created_at, o.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, reservationID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	offers := []entity.ReservationOffer{}
+	for rows.Next() {
+		var o entity.ReservationOffer
+		if err := rows.Scan(
+			&o.ID, &o.ReservationID, &o.OfferedByUserID, &o.Side, &o.Price, &o.Notes, &o.Status,
+			&o.RespondedByUserID, &o.RespondedAt, &o.CreatedAt, &o.OfferedBy,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		offers = append(offers, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return offers, nil
+}
+
+func (r *reservationOfferRepository) FindPendingForUpdate(ctx context.Context, tx *sql.Tx, reservationID string) (*entity.ReservationOffer, error) {
+	query := `
+		SELECT id, reservation_id, offered_by_user_id, side, price, notes, status, created_at
+		FROM reservation_offers
+		WHERE reservation_id = $1 AND status = 'PENDENTE'
+		FOR UPDATE
+	`
+
+	o := &entity.ReservationOffer{}
+	err := r.db.conn(tx).QueryRowContext(ctx, query, reservationID).Scan(
+		&o.ID, &o.ReservationID, &o.OfferedByUserID, &o.Side, &o.Price, &o.Notes, &o.Status, &o.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return o, nil
+}
+
+func (r *reservationOfferRepository) Accept(ctx context.Context, tx *sql.Tx, id, userID string) error {
+	query := `
+		UPDATE reservation_offers
+		SET status = 'ACEITA', responded_by_user_id = $1, responded_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND status = 'PENDENTE'
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, userID, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+	if rows == 0 {
+		return errors.NewNotFoundError("Proposta pendente")
+	}
+
+	return nil
+}
+
+func (r *reservationOfferRepository) ClosePending(ctx context.Context, tx *sql.Tx, reservationID string, status entity.ReservationOfferStatus) error {
+	query := `
+		UPDATE reservation_offers
+		SET status = $1, responded_at = CURRENT_TIMESTAMP
+		WHERE reservation_id = $2 AND status = 'PENDENTE'
+	`
+
+	if _, err := r.db.conn(tx).ExecContext(ctx, query, status, reservationID); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
@@ -21,17 +21,19 @@ func NewReservationRepository(db *DB) *reservationRepository {
 func (r *reservationRepository) Create(ctx context.Context, tx *sql.Tx, reservation *entity.Reservation) error {
 	query := `
 		INSERT INTO reservations (
-			id, batch_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
-			reserved_price, broker_sold_price, notes, expires_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			id, batch_id, remnant_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
+			reserved_price, broker_sold_price, industry_price, price_unit, currency, notes, expires_at,
+			industry_id, approval_expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		RETURNING created_at
 	`
 
 	err := tx.QueryRowContext(ctx, query,
-		reservation.ID, reservation.BatchID, reservation.ReservedByUserID,
+		reservation.ID, reservation.BatchID, reservation.RemnantID, reservation.ReservedByUserID,
 		reservation.ClienteID, reservation.QuantitySlabsReserved, reservation.Status,
-		reservation.ReservedPrice, reservation.BrokerSoldPrice, reservation.Notes,
-		reservation.ExpiresAt,
+		reservation.ReservedPrice, reservation.BrokerSoldPrice, reservation.IndustryPrice,
+		reservation.PriceUnit, reservation.Currency, reservation.Notes, reservation.ExpiresAt,
+		reservation.IndustryID, reservation.ApprovalExpiresAt,
 	).Scan(&reservation.CreatedAt)
 
 	if err != nil {
@@ -43,17 +45,47 @@ func (r *reservationRepository) Create(ctx context.Context, tx *sql.Tx, reservat
 
 func (r *reservationRepository) FindByID(ctx context.Context, id string) (*entity.Reservation, error) {
 	query := `
-		SELECT id, batch_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
-		       reserved_price, broker_sold_price, notes, expires_at, created_at, is_active
+		SELECT id, batch_id, remnant_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
+		       reserved_price, broker_sold_price, industry_price, price_unit, currency, notes, expires_at, created_at, is_active,
+		       industry_id, approved_by, approved_at, rejection_reason, approval_expires_at, agreed_price
 		FROM reservations
 		WHERE id = $1
 	`
 
 	res := &entity.Reservation{}
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&res.ID, &res.BatchID, &res.ReservedByUserID, &res.ClienteID,
+		&res.ID, &res.BatchID, &res.RemnantID, &res.ReservedByUserID, &res.ClienteID,
 		&res.QuantitySlabsReserved, &res.Status, &res.ReservedPrice, &res.BrokerSoldPrice,
-		&res.Notes, &res.ExpiresAt, &res.CreatedAt, &res.IsActive,
+		&res.IndustryPrice, &res.PriceUnit, &res.Currency, &res.Notes, &res.ExpiresAt, &res.CreatedAt, &res.IsActive,
+		&res.IndustryID, &res.ApprovedBy, &res.ApprovedAt, &res.RejectionReason, &res.ApprovalExpiresAt, &res.AgreedPrice,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Reserva")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return res, nil
+}
+
+func (r *reservationRepository) FindByIDForUpdate(ctx context.Context, tx *sql.Tx, id string) (*entity.Reservation, error) {
+	query := `
+		SELECT id, batch_id, remnant_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
+		       reserved_price, broker_sold_price, industry_price, price_unit, currency, notes, expires_at, created_at, is_active,
+		       industry_id, approved_by, approved_at, rejection_reason, approval_expires_at, agreed_price
+		FROM reservations
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	res := &entity.Reservation{}
+	err := tx.QueryRowContext(ctx, query, id).Scan(
+		&res.ID, &res.BatchID, &res.RemnantID, &res.ReservedByUserID, &res.ClienteID,
+		&res.QuantitySlabsReserved, &res.Status, &res.ReservedPrice, &res.BrokerSoldPrice,
+		&res.IndustryPrice, &res.PriceUnit, &res.Currency, &res.Notes, &res.ExpiresAt, &res.CreatedAt, &res.IsActive,
+		&res.IndustryID, &res.ApprovedBy, &res.ApprovedAt, &res.RejectionReason, &res.ApprovalExpiresAt, &res.AgreedPrice,
 	)
 
 	if err == sql.ErrNoRows {
@@ -68,8 +100,8 @@ func (r *reservationRepository) FindByID(ctx context.Context, id string) (*entit
 
 func (r *reservationRepository) FindByBatchID(ctx context.Context, batchID string) ([]entity.Reservation, error) {
 	query := `
-		SELECT id, batch_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
-		       reserved_price, broker_sold_price, notes, expires_at, created_at, is_active
+		SELECT id, batch_id, remnant_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
+		       reserved_price, broker_sold_price, industry_price, price_unit, currency, notes, expires_at, created_at, is_active
 		FROM reservations
 		WHERE batch_id = $1
 		ORDER BY created_at DESC
@@ -86,8 +118,8 @@ func (r *reservationRepository) FindByBatchID(ctx context.Context, batchID strin
 
 func (r *reservationRepository) FindActive(ctx context.Context, userID string) ([]entity.Reservation, error) {
 	query := `
-		SELECT id, batch_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
-		       reserved_price, broker_sold_price, notes, expires_at, created_at, is_active
+		SELECT id, batch_id, remnant_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
+		       reserved_price, broker_sold_price, industry_price, price_unit, currency, notes, expires_at, created_at, is_active
 		FROM reservations
 		WHERE reserved_by_user_id = $1
 		  AND status = 'ATIVA'
@@ -107,8 +139,8 @@ func (r *reservationRepository) FindActive(ctx context.Context, userID string) (
 
 func (r *reservationRepository) FindByUser(ctx context.Context, userID string) ([]entity.Reservation, error) {
 	query := `
-		SELECT id, batch_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
-		       reserved_price, broker_sold_price, notes, expires_at, created_at, is_active
+		SELECT id, batch_id, remnant_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
+		       reserved_price, broker_sold_price, industry_price, price_unit, currency, notes, expires_at, created_at, is_active
 		FROM reservations
 		WHERE reserved_by_user_id = $1
 		ORDER BY created_at DESC
@@ -125,8 +157,8 @@ func (r *reservationRepository) FindByUser(ctx context.Context, userID string) (
 
 func (r *reservationRepository) FindExpired(ctx context.Context) ([]entity.Reservation, error) {
 	query := `
-		SELECT id, batch_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
-		       reserved_price, broker_sold_price, notes, expires_at, created_at, is_active
+		SELECT id, batch_id, remnant_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
+		       reserved_price, broker_sold_price, industry_price, price_unit, currency, notes, expires_at, created_at, is_active
 		FROM reservations
 		WHERE status = 'ATIVA'
 		  AND expires_at < CURRENT_TIMESTAMP
@@ -168,20 +200,20 @@ func (r *reservationRepository) Update(ctx context.Context, reservation *entity.
 	return nil
 }
 
-func (r *reservationRepository) UpdateStatus(ctx context.Context, tx *sql.Tx, id string, status entity.ReservationStatus) error {
+func (r *reservationRepository) UpdateStatus(ctx context.Context, tx *sql.Tx, id string, from, to entity.ReservationStatus) error {
 	query := `
 		UPDATE reservations
 		SET status = $1
-		WHERE id = $2
+		WHERE id = $2 AND status = $3
 	`
 
 	var result sql.Result
 	var err error
 
 	if tx != nil {
-		result, err = tx.ExecContext(ctx, query, status, id)
+		result, err = tx.ExecContext(ctx, query, to, id, from)
 	} else {
-		result, err = r.db.ExecContext(ctx, query, status, id)
+		result, err = r.db.ExecContext(ctx, query, to, id, from)
 	}
 
 	if err != nil {
@@ -194,7 +226,7 @@ func (r *reservationRepository) UpdateStatus(ctx context.Context, tx *sql.Tx, id
 	}
 
 	if rows == 0 {
-		return errors.NewNotFoundError("Reserva")
+		return errors.NewConflictError("Reserva foi alterada por outra operação")
 	}
 
 	return nil
@@ -204,7 +236,7 @@ func (r *reservationRepository) Cancel(ctx context.Context, tx *sql.Tx, id strin
 	query := `
 		UPDATE reservations
 		SET status = 'CANCELADA', is_active = FALSE
-		WHERE id = $1
+		WHERE id = $1 AND status = 'ATIVA'
 	`
 
 	var result sql.Result
@@ -226,7 +258,7 @@ func (r *reservationRepository) Cancel(ctx context.Context, tx *sql.Tx, id strin
 	}
 
 	if rows == 0 {
-		return errors.NewNotFoundError("Reserva")
+		return errors.NewConflictError("Apenas reservas ativas podem ser canceladas")
 	}
 
 	return nil
@@ -234,8 +266,8 @@ func (r *reservationRepository) Cancel(ctx context.Context, tx *sql.Tx, id strin
 
 func (r *reservationRepository) List(ctx context.Context, filters entity.ReservationFilters) ([]entity.Reservation, error) {
 	query := `
-		SELECT id, batch_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status, notes,
-		       expires_at, created_at, is_active
+		SELECT id, batch_id, remnant_id, reserved_by_user_id, cliente_id, quantity_slabs_reserved, status,
+		       reserved_price, broker_sold_price, industry_price, price_unit, currency, notes, expires_at, created_at, is_active
 		FROM reservations
 		WHERE 1=1
 	`
@@ -277,9 +309,9 @@ func (r *reservationRepository) scanReservations(rows *sql.Rows) ([]entity.Reser
 	for rows.Next() {
 		var res entity.Reservation
 		if err := rows.Scan(
-			&res.ID, &res.BatchID, &res.ReservedByUserID, &res.ClienteID,
+			&res.ID, &res.BatchID, &res.RemnantID, &res.ReservedByUserID, &res.ClienteID,
 			&res.QuantitySlabsReserved, &res.Status, &res.ReservedPrice, &res.BrokerSoldPrice,
-			&res.Notes, &res.ExpiresAt, &res.CreatedAt, &res.IsActive,
+			&res.IndustryPrice, &res.PriceUnit, &res.Currency, &res.Notes, &res.ExpiresAt, &res.CreatedAt, &res.IsActive,
 		); err != nil {
 			return nil, errors.DatabaseError(err)
 		}
@@ -290,9 +322,9 @@ func (r *reservationRepository) scanReservations(rows *sql.Rows) ([]entity.Reser
 
 func (r *reservationRepository) FindByIndustry(ctx context.Context, industryID string) ([]entity.Reservation, error) {
 	query := `
-		SELECT r.id, r.batch_id, r.reserved_by_user_id, r.cliente_id, r.quantity_slabs_reserved,
-		       r.status, r.reserved_price, r.broker_sold_price, r.notes, r.expires_at, r.created_at, r.is_active,
-		       r.industry_id, r.approved_by, r.approved_at, r.rejection_reason, r.approval_expires_at
+		SELECT r.id, r.batch_id, r.remnant_id, r.reserved_by_user_id, r.cliente_id, r.quantity_slabs_reserved,
+		       r.status, r.reserved_price, r.broker_sold_price, r.industry_price, r.price_unit, r.currency, r.notes, r.expires_at, r.created_at, r.is_active,
+		       r.industry_id, r.approved_by, r.approved_at, r.rejection_reason, r.approval_expires_at, r.agreed_price
 		FROM reservations r
 		JOIN batches b ON r.batch_id = b.id
 		WHERE b.industry_id = $1
@@ -310,9 +342,9 @@ func (r *reservationRepository) FindByIndustry(ctx context.Context, industryID s
 
 func (r *reservationRepository) FindPendingByIndustry(ctx context.Context, industryID string) ([]entity.Reservation, error) {
 	query := `
-		SELECT r.id, r.batch_id, r.reserved_by_user_id, r.cliente_id, r.quantity_slabs_reserved,
-		       r.status, r.reserved_price, r.broker_sold_price, r.notes, r.expires_at, r.created_at, r.is_active,
-		       r.industry_id, r.approved_by, r.approved_at, r.rejection_reason, r.approval_expires_at
+		SELECT r.id, r.batch_id, r.remnant_id, r.reserved_by_user_id, r.cliente_id, r.quantity_slabs_reserved,
+		       r.status, r.reserved_price, r.broker_sold_price, r.industry_price, r.price_unit, r.currency, r.notes, r.expires_at, r.created_at, r.is_active,
+		       r.industry_id, r.approved_by, r.approved_at, r.rejection_reason, r.approval_expires_at, r.agreed_price
 		FROM reservations r
 		JOIN batches b ON r.batch_id = b.id
 		WHERE r.status = 'PENDENTE_APROVACAO'
@@ -331,9 +363,9 @@ func (r *reservationRepository) FindPendingByIndustry(ctx context.Context, indus
 
 func (r *reservationRepository) FindPendingExpired(ctx context.Context) ([]entity.Reservation, error) {
 	query := `
-		SELECT r.id, r.batch_id, r.reserved_by_user_id, r.cliente_id, r.quantity_slabs_reserved,
-		       r.status, r.reserved_price, r.broker_sold_price, r.notes, r.expires_at, r.created_at, r.is_active,
-		       r.industry_id, r.approved_by, r.approved_at, r.rejection_reason, r.approval_expires_at
+		SELECT r.id, r.batch_id, r.remnant_id, r.reserved_by_user_id, r.cliente_id, r.quantity_slabs_reserved,
+		       r.status, r.reserved_price, r.broker_sold_price, r.industry_price, r.price_unit, r.currency, r.notes, r.expires_at, r.created_at, r.is_active,
+		       r.industry_id, r.approved_by, r.approved_at, r.rejection_reason, r.approval_expires_at, r.agreed_price
 		FROM reservations r
 		WHERE r.status = 'PENDENTE_APROVACAO'
 		  AND r.approval_expires_at IS NOT NULL
@@ -350,23 +382,24 @@ func (r *reservationRepository) FindPendingExpired(ctx context.Context) ([]entit
 	return r.scanReservationsWithApproval(rows)
 }
 
-func (r *reservationRepository) Approve(ctx context.Context, tx *sql.Tx, id, approverID string, expiresAt time.Time) error {
+func (r *reservationRepository) Approve(ctx context.Context, tx *sql.Tx, id, approverID string, expiresAt time.Time, agreedPrice *float64) error {
 	query := `
 		UPDATE reservations
 		SET status = 'APROVADA',
 		    approved_by = $1,
 		    approved_at = CURRENT_TIMESTAMP,
-		    expires_at = $2
-		WHERE id = $3
+		    expires_at = $2,
+		    agreed_price = $3
+		WHERE id = $4 AND status = 'PENDENTE_APROVACAO'
 	`
 
 	var result sql.Result
 	var err error
 
 	if tx != nil {
-		result, err = tx.ExecContext(ctx, query, approverID, expiresAt, id)
+		result, err = tx.ExecContext(ctx, query, approverID, expiresAt, agreedPrice, id)
 	} else {
-		result, err = r.db.ExecContext(ctx, query, approverID, expiresAt, id)
+		result, err = r.db.ExecContext(ctx, query, approverID, expiresAt, agreedPrice, id)
 	}
 
 	if err != nil {
@@ -378,6 +411,119 @@ func (r *reservationRepository) Approve(ctx context.Context, tx *sql.Tx, id, app
 		return errors.DatabaseError(err)
 	}
 
+	if rows == 0 {
+		return errors.NewConflictError("Apenas reservas pendentes podem ser aprovadas")
+	}
+
+	return nil
+}
+
+func (r *reservationRepository) CountOpenByUser(ctx context.Context, tx *sql.Tx, userID, industryID string) (int, int, error) {
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(r.quantity_slabs_reserved), 0)
+		FROM reservations r
+		INNER JOIN batches b ON b.id = r.batch_id
+		WHERE r.reserved_by_user_id = $1
+		  AND b.industry_id = $2
+		  AND r.status IN ('ATIVA', 'APROVADA', 'PENDENTE_APROVACAO')
+	`
+
+	var reservations, slabs int
+	if err := r.db.conn(tx).QueryRowContext(ctx, query, userID, industryID).Scan(&reservations, &slabs); err != nil {
+		return 0, 0, errors.DatabaseError(err)
+	}
+
+	return reservations, slabs, nil
+}
+
+// reminderHoursSQL é a antecedência do lembrete configurada na política da indústria do lote
+const reminderHoursSQL = `COALESCE((i.reservation_policy->>'reminderHoursBefore')::INTEGER, 24)`
+
+func (r *reservationRepository) FindDueExpiryReminders(ctx context.Context) ([]entity.Reservation, error) {
+	query := `
+		SELECT r.id, r.batch_id, r.remnant_id, r.reserved_by_user_id, r.cliente_id, r.quantity_slabs_reserved,
+		       r.status, r.reserved_price, r.broker_sold_price, r.industry_price, r.price_unit, r.currency, r.notes, r.expires_at, r.created_at, r.is_active,
+		       r.industry_id, r.approved_by, r.approved_at, r.rejection_reason, r.approval_expires_at, r.agreed_price
+		FROM reservations r
+		INNER JOIN batches b ON b.id = r.batch_id
+		INNER JOIN industries i ON i.id = b.industry_id
+		WHERE r.status IN ('ATIVA', 'APROVADA')
+		  AND r.expiry_reminder_sent_at IS NULL
+		  AND ` + reminderHoursSQL + ` > 0
+		  AND r.expires_at > CURRENT_TIMESTAMP
+		  AND r.expires_at <= CURRENT_TIMESTAMP + make_interval(hours => ` + reminderHoursSQL + `)
+		ORDER BY r.expires_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	return r.scanReservationsWithApproval(rows)
+}
+
+func (r *reservationRepository) FindDueApprovalReminders(ctx context.Context) ([]entity.Reservation, error) {
+	query := `
+		SELECT r.id, r.batch_id, r.remnant_id, r.reserved_by_user_id, r.cliente_id, r.quantity_slabs_reserved,
+		       r.status, r.reserved_price, r.broker_sold_price, r.industry_price, r.price_unit, r.currency, r.notes, r.expires_at, r.created_at, r.is_active,
+		       r.industry_id, r.approved_by, r.approved_at, r.rejection_reason, r.approval_expires_at, r.agreed_price
+		FROM reservations r
+		INNER JOIN batches b ON b.id = r.batch_id
+		INNER JOIN industries i ON i.id = b.industry_id
+		WHERE r.status = 'PENDENTE_APROVACAO'
+		  AND r.approval_reminder_sent_at IS NULL
+		  AND ` + reminderHoursSQL + ` > 0
+		  AND r.approval_expires_at > CURRENT_TIMESTAMP
+		  AND r.approval_expires_at <= CURRENT_TIMESTAMP + make_interval(hours => ` + reminderHoursSQL + `)
+		ORDER BY r.approval_expires_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	return r.scanReservationsWithApproval(rows)
+}
+
+func (r *reservationRepository) MarkExpiryReminderSent(ctx context.Context, id string) error {
+	query := `UPDATE reservations SET expiry_reminder_sent_at = CURRENT_TIMESTAMP WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return errors.DatabaseError(err)
+	}
+	return nil
+}
+
+func (r *reservationRepository) MarkApprovalReminderSent(ctx context.Context, id string) error {
+	query := `UPDATE reservations SET approval_reminder_sent_at = CURRENT_TIMESTAMP WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return errors.DatabaseError(err)
+	}
+	return nil
+}
+
+func (r *reservationRepository) UpdateExpiresAt(ctx context.Context, tx *sql.Tx, id string, expiresAt time.Time) error {
+	query := `
+		UPDATE reservations
+		SET expires_at = $1, expiry_reminder_sent_at = NULL
+		WHERE id = $2 AND status IN ('ATIVA', 'APROVADA')
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, expiresAt, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
 	if rows == 0 {
 		return errors.NewNotFoundError("Reserva")
 	}
@@ -393,7 +539,7 @@ func (r *reservationRepository) Reject(ctx context.Context, tx *sql.Tx, id, appr
 		    approved_at = CURRENT_TIMESTAMP,
 		    rejection_reason = $2,
 		    is_active = FALSE
-		WHERE id = $3
+		WHERE id = $3 AND status = 'PENDENTE_APROVACAO'
 	`
 
 	var result sql.Result
@@ -415,7 +561,7 @@ func (r *reservationRepository) Reject(ctx context.Context, tx *sql.Tx, id, appr
 	}
 
 	if rows == 0 {
-		return errors.NewNotFoundError("Reserva")
+		return errors.NewConflictError("Apenas reservas pendentes podem ser rejeitadas")
 	}
 
 	return nil
@@ -426,10 +572,10 @@ func (r *reservationRepository) scanReservationsWithApproval(rows *sql.Rows) ([]
 	for rows.Next() {
 		var res entity.Reservation
 		if err := rows.Scan(
-			&res.ID, &res.BatchID, &res.ReservedByUserID, &res.ClienteID,
+			&res.ID, &res.BatchID, &res.RemnantID, &res.ReservedByUserID, &res.ClienteID,
 			&res.QuantitySlabsReserved, &res.Status, &res.ReservedPrice, &res.BrokerSoldPrice,
-			&res.Notes, &res.ExpiresAt, &res.CreatedAt, &res.IsActive,
-			&res.IndustryID, &res.ApprovedBy, &res.ApprovedAt, &res.RejectionReason, &res.ApprovalExpiresAt,
+			&res.IndustryPrice, &res.PriceUnit, &res.Currency, &res.Notes, &res.ExpiresAt, &res.CreatedAt, &res.IsActive,
+			&res.IndustryID, &res.ApprovedBy, &res.ApprovedAt, &res.RejectionReason, &res.ApprovalExpiresAt, &res.AgreedPrice,
 		); err != nil {
 			return nil, errors.DatabaseError(err)
 		}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type reservationWaitlistRepository struct {
+	db *DB
+}
+
+func NewReservationWaitlistRepository(db *DB) *reservationWaitlistRepository {
+	return &reservationWaitlistRepository{db: db}
+}
+
+// waitlistColumns inclui a posição na fila (pedidos aguardando do mesmo lote até este, por ordem de chegada)
+var waitlistColumns = []string{
+	"w.id", "w.batch_id", "w.industry_id", "w.requested_by_user_id", "w.cliente_id", "w.quantity_slabs",
+	"w.reserved_price", "w.broker_sold_price", "w.notes", "w.status",
+	`CASE WHEN w.status = 'AGUARDANDO' THEN (
+		SELECT COUNT(*) FROM reservation_waitlist q
+		WHERE q.batch_id = w.batch_id AND q.status = 'AGUARDANDO' AND (q.created_at, q.id) <= (w.created_at, w.id)
+	) ELSE 0 END`,
+	"w.reservation_id", "w.converted_at", "w.created_at", "w.updated_at",
+	"b.batch_code", "COALESCE(p.name, '')", "COALESCE(u.name, '')",
+}
+
+func (r *reservationWaitlistRepository) Create(ctx context.Context, entry *entity.WaitlistEntry) error {
+	query := `
+		INSERT INTO reservation_waitlist (
+			id, batch_id, industry_id, requested_by_user_id, cliente_id, quantity_slabs,
+			reserved_price, broker_sold_price, notes, status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		entry.ID, entry.BatchID, entry.IndustryID, entry.RequestedByUserID, entry.ClienteID, entry.QuantitySlabs,
+		entry.ReservedPrice, entry.BrokerSoldPrice, entry.Notes, entry.Status,
+	).Scan(&entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Você já está na fila de espera deste lote")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *reservationWaitlistRepository) FindByID(ctx context.Context, id string) (*entity.WaitlistEntry, error) {
+	query, args, err := r.selectBuilder().Where(sq.Eq{"w.id": id}).ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	entry, err := scanWaitlistEntry(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Pedido na fila de espera")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return entry, nil
+}
+
+func (r *reservationWaitlistRepository) WaitingDemand(ctx context.Context, tx *sql.Tx, batchID string) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(quantity_slabs), 0)
+		FROM reservation_waitlist
+		WHERE batch_id = $1 AND status = 'AGUARDANDO'
+	`
+
+	var demand int
+	if err := r.db.conn(tx).QueryRowContext(ctx, query, batchID).Scan(&demand); err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return demand, nil
+}
+
+func (r *reservationWaitlistRepository) FindWaitingForUpdate(ctx context.Context, tx *sql.Tx, batchID string) ([]entity.WaitlistEntry, error) {
+	query := `
+		SELECT id, batch_id, industry_id, requested_by_user_id, cliente_id, quantity_slabs,
+		       reserved_price, broker_sold_price, notes, status, created_at, updated_at
+		FROM reservation_waitlist
+		WHERE batch_id = $1 AND status = 'AGUARDANDO'
+		ORDER BY created_at, id
+		FOR UPDATE
+	`
+
+	rows, err := r.db.conn(tx).QueryContext(ctx, query, batchID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	entries := []entity.WaitlistEntry{}
+	for rows.Next() {
+		var e entity.WaitlistEntry
+		if err := rows.Scan(
+			&e.ID, &e.BatchID, &e.IndustryID, &e.RequestedByUserID, &e.ClienteID, &e.QuantitySlabs,
+			&e.ReservedPrice, &e.BrokerSoldPrice, &e.Notes, &e.Status, &e.CreatedAt, &e.UpdatedAt,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return entries, nil
+}
+
+func (r *reservationWaitlistRepository) List(ctx context.Context, filters entity.WaitlistFilters) ([]entity.WaitlistEntry, error) {
+	where := sq.And{}
+	if filters.IndustryID != nil {
+		where = append(where, sq.Eq{"w.industry_id": *filters.IndustryID})
+	}
+	if filters.UserID != nil {
+		where = append(where, sq.Eq{"w.requested_by_user_id": *filters.UserID})
+	}
+	if filters.BatchID != nil {
+		where = append(where, sq.Eq{"w.batch_id": *filters.BatchID})
+	}
+	if filters.Status != nil {
+		where = append(where, sq.Eq{"w.status": *filters.Status})
+	}
+
+	query, args, err := r.selectBuilder().
+		Where(where).
+		OrderBy("w.created_at", "w.id").
+		ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	entries := []entity.WaitlistEntry{}
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		entries = append(entries, *entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return entries, nil
+}
+
+func (r *reservationWaitlistRepository) MarkConverted(ctx context.Context, tx *sql.Tx, id, reservationID string) error {
+	query := `
+		UPDATE reservation_waitlist
+		SET status = 'CONVERTIDA', reservation_id = $1, converted_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND status = 'AGUARDANDO'
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, reservationID, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+	if rows == 0 {
+		return errors.NewNotFoundError("Pedido na fila de espera")
+	}
+
+	return nil
+}
+
+func (r *reservationWaitlistRepository) Cancel(ctx context.Context, tx *sql.Tx, id string) error {
+	query := `
+		UPDATE reservation_waitlist
+		SET status = 'CANCELADA'
+		WHERE id = $1 AND status = 'AGUARDANDO'
+	`
+
+	result, err := r.db.conn(tx).ExecContext(ctx, query, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+	if rows == 0 {
+		return errors.NewNotFoundError("Pedido na fila de espera")
+	}
+
+	return nil
+}
+
+func (r *reservationWaitlistRepository) selectBuilder() sq.SelectBuilder {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(waitlistColumns...).
+		From("reservation_waitlist w").
+		Join("batches b ON b.id = w.batch_id").
+		LeftJoin("products p ON p.id = b.product_id").
+		LeftJoin("users u ON u.id = w.requested_by_user_id")
+}
+
+func scanWaitlistEntry(row rowScanner) (*entity.WaitlistEntry, error) {
+	e := &entity.WaitlistEntry{}
+	err := row.Scan(
+		&e.ID, &e.BatchID, &e.IndustryID, &e.RequestedByUserID, &e.ClienteID, &e.QuantitySlabs,
+		&e.ReservedPrice, &e.BrokerSoldPrice, &e.Notes, &e.Status, &e.Position,
+		&e.ReservationID, &e.ConvertedAt, &e.CreatedAt, &e.UpdatedAt,
+		&e.BatchCode, &e.ProductName, &e.RequestedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
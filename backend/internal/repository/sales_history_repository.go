@@ -25,8 +25,8 @@ func (r *salesHistoryRepository) Create(ctx context.Context, tx *sql.Tx, sale *e
 			id, batch_id, sold_by_user_id, seller_name, industry_id, cliente_id,
 			customer_name, customer_contact, quantity_slabs_sold, total_area_sold,
 			price_per_unit, price_unit, sale_price, broker_sold_price, broker_commission,
-			net_industry_value, invoice_url, notes, sold_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+			net_industry_value, invoice_url, notes, sold_at, currency, remnant_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)
 		RETURNING created_at
 	`
 
@@ -35,7 +35,7 @@ func (r *salesHistoryRepository) Create(ctx context.Context, tx *sql.Tx, sale *e
 		sale.CustomerName, sale.CustomerContact, sale.QuantitySlabsSold, sale.TotalAreaSold,
 		sale.PricePerUnit, sale.PriceUnit, sale.SalePrice, sale.BrokerSoldPrice,
 		sale.BrokerCommission, sale.NetIndustryValue, sale.InvoiceURL,
-		sale.Notes, sale.SaleDate,
+		sale.Notes, sale.SaleDate, sale.Currency.OrDefault(), sale.RemnantID,
 	).Scan(&sale.CreatedAt)
 
 	if err != nil {
@@ -47,9 +47,9 @@ func (r *salesHistoryRepository) Create(ctx context.Context, tx *sql.Tx, sale *e
 
 func (r *salesHistoryRepository) FindByID(ctx context.Context, id string) (*entity.Sale, error) {
 	query := `
-		SELECT id, batch_id, sold_by_user_id, COALESCE(seller_name, ''), industry_id, cliente_id,
+		SELECT id, batch_id, remnant_id, sold_by_user_id, COALESCE(seller_name, ''), industry_id, cliente_id,
 		       customer_name, customer_contact, quantity_slabs_sold, total_area_sold,
-		       price_per_unit, price_unit, sale_price, broker_sold_price, broker_commission,
+		       price_per_unit, price_unit, sale_price, currency, broker_sold_price, broker_commission,
 		       net_industry_value, invoice_url, notes, sold_at, created_at
 		FROM sales_history
 		WHERE id = $1
@@ -57,9 +57,9 @@ func (r *salesHistoryRepository) FindByID(ctx context.Context, id string) (*enti
 
 	sale := &entity.Sale{}
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&sale.ID, &sale.BatchID, &sale.SoldByUserID, &sale.SellerName, &sale.IndustryID, &sale.ClienteID,
+		&sale.ID, &sale.BatchID, &sale.RemnantID, &sale.SoldByUserID, &sale.SellerName, &sale.IndustryID, &sale.ClienteID,
 		&sale.CustomerName, &sale.CustomerContact, &sale.QuantitySlabsSold, &sale.TotalAreaSold,
-		&sale.PricePerUnit, &sale.PriceUnit, &sale.SalePrice, &sale.BrokerSoldPrice,
+		&sale.PricePerUnit, &sale.PriceUnit, &sale.SalePrice, &sale.Currency, &sale.BrokerSoldPrice,
 		&sale.BrokerCommission, &sale.NetIndustryValue, &sale.InvoiceURL,
 		&sale.Notes, &sale.SaleDate, &sale.CreatedAt,
 	)
@@ -84,9 +84,9 @@ func (r *salesHistoryRepository) FindByIndustryID(ctx context.Context, industryI
 
 func (r *salesHistoryRepository) FindByBrokerID(ctx context.Context, brokerID string, limit int) ([]entity.Sale, error) {
 	query := `
-		SELECT id, batch_id, sold_by_user_id, COALESCE(seller_name, ''), industry_id, cliente_id,
+		SELECT id, batch_id, remnant_id, sold_by_user_id, COALESCE(seller_name, ''), industry_id, cliente_id,
 		       customer_name, customer_contact, quantity_slabs_sold, total_area_sold,
-		       price_per_unit, price_unit, sale_price, broker_sold_price, broker_commission,
+		       price_per_unit, price_unit, sale_price, currency, broker_sold_price, broker_commission,
 		       net_industry_value, invoice_url, notes, sold_at, created_at
 		FROM sales_history
 		WHERE sold_by_user_id = $1
@@ -105,9 +105,9 @@ func (r *salesHistoryRepository) FindByBrokerID(ctx context.Context, brokerID st
 
 func (r *salesHistoryRepository) FindByPeriod(ctx context.Context, industryID string, startDate, endDate time.Time) ([]entity.Sale, error) {
 	query := `
-		SELECT id, batch_id, sold_by_user_id, COALESCE(seller_name, ''), industry_id, cliente_id,
+		SELECT id, batch_id, remnant_id, sold_by_user_id, COALESCE(seller_name, ''), industry_id, cliente_id,
 		       customer_name, customer_contact, quantity_slabs_sold, total_area_sold,
-		       price_per_unit, price_unit, sale_price, broker_sold_price, broker_commission,
+		       price_per_unit, price_unit, sale_price, currency, broker_sold_price, broker_commission,
 		       net_industry_value, invoice_url, notes, sold_at, created_at
 		FROM sales_history
 		WHERE industry_id = $1 
@@ -133,9 +133,9 @@ func (r *salesHistoryRepository) listWithFilters(ctx context.Context, sellerID,
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
 	query := psql.Select(
-		"id", "batch_id", "sold_by_user_id", "COALESCE(seller_name, '') as seller_name", "industry_id", "cliente_id",
+		"id", "batch_id", "remnant_id", "sold_by_user_id", "COALESCE(seller_name, '') as seller_name", "industry_id", "cliente_id",
 		"customer_name", "customer_contact", "quantity_slabs_sold", "total_area_sold",
-		"price_per_unit", "price_unit", "sale_price", "broker_sold_price", "broker_commission",
+		"price_per_unit", "price_unit", "sale_price", "currency", "broker_sold_price", "broker_commission",
 		"net_industry_value", "invoice_url", "notes", "sold_at", "created_at",
 	).From("sales_history")
 
@@ -221,9 +221,9 @@ func (r *salesHistoryRepository) listWithFilters(ctx context.Context, sellerID,
 func (r *salesHistoryRepository) CalculateSummary(ctx context.Context, filters entity.SaleSummaryFilters) (*entity.SaleSummary, error) {
 	query := `
 		SELECT 
-			COALESCE(SUM(sale_price), 0) as total_sales,
-			COALESCE(SUM(broker_commission), 0) as total_commissions,
-			COALESCE(AVG(sale_price), 0) as average_ticket
+			COALESCE(SUM(to_base_currency(industry_id, sale_price, currency, sold_at::date)), 0) as total_sales,
+			COALESCE(SUM(to_base_currency(industry_id, broker_commission, currency, sold_at::date)), 0) as total_commissions,
+			COALESCE(AVG(to_base_currency(industry_id, sale_price, currency, sold_at::date)), 0) as average_ticket
 		FROM sales_history
 		WHERE 1=1
 	`
@@ -262,7 +262,7 @@ func (r *salesHistoryRepository) CalculateSummary(ctx context.Context, filters e
 
 func (r *salesHistoryRepository) SumMonthlySales(ctx context.Context, entityID string, month time.Time) (float64, error) {
 	query := `
-		SELECT COALESCE(SUM(sale_price), 0)
+		SELECT COALESCE(SUM(to_base_currency(industry_id, sale_price, currency, sold_at::date)), 0)
 		FROM sales_history
 		WHERE (industry_id = $1 OR sold_by_user_id = $1)
 		  AND sold_at >= $2
@@ -283,7 +283,7 @@ func (r *salesHistoryRepository) SumMonthlySales(ctx context.Context, entityID s
 
 func (r *salesHistoryRepository) SumMonthlyCommission(ctx context.Context, brokerID string, month time.Time) (float64, error) {
 	query := `
-		SELECT COALESCE(SUM(broker_commission), 0)
+		SELECT COALESCE(SUM(to_base_currency(industry_id, broker_commission, currency, sold_at::date)), 0)
 		FROM sales_history
 		WHERE sold_by_user_id = $1
 		  AND sold_at >= $2
@@ -329,9 +329,9 @@ func (r *salesHistoryRepository) scanSales(rows *sql.Rows) ([]entity.Sale, error
 	for rows.Next() {
 		var s entity.Sale
 		if err := rows.Scan(
-			&s.ID, &s.BatchID, &s.SoldByUserID, &s.SellerName, &s.IndustryID, &s.ClienteID,
+			&s.ID, &s.BatchID, &s.RemnantID, &s.SoldByUserID, &s.SellerName, &s.IndustryID, &s.ClienteID,
 			&s.CustomerName, &s.CustomerContact, &s.QuantitySlabsSold, &s.TotalAreaSold,
-			&s.PricePerUnit, &s.PriceUnit, &s.SalePrice, &s.BrokerSoldPrice,
+			&s.PricePerUnit, &s.PriceUnit, &s.SalePrice, &s.Currency, &s.BrokerSoldPrice,
 			&s.BrokerCommission, &s.NetIndustryValue, &s.InvoiceURL,
 			&s.Notes, &s.SaleDate, &s.CreatedAt,
 		); err != nil {
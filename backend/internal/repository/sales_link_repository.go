@@ -23,16 +23,16 @@ func (r *salesLinkRepository) Create(ctx context.Context, link *entity.SalesLink
 		INSERT INTO sales_links (
 			id, created_by_user_id, industry_id, batch_id, product_id,
 			link_type, slug_token, title, custom_message, display_price,
-			show_price, expires_at, is_active
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			price_snapshot, price_snapshot_unit, currency, show_price, expires_at, is_active
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		RETURNING created_at, updated_at
 	`
 
 	err := r.db.QueryRowContext(ctx, query,
 		link.ID, link.CreatedByUserID, link.IndustryID, link.BatchID,
 		link.ProductID, link.LinkType, link.SlugToken, link.Title,
-		link.CustomMessage, link.DisplayPrice, link.ShowPrice,
-		link.ExpiresAt, link.IsActive,
+		link.CustomMessage, link.DisplayPrice, link.PriceSnapshot,
+		link.PriceSnapshotUnit, link.Currency.OrDefault(), link.ShowPrice, link.ExpiresAt, link.IsActive,
 	).Scan(&link.CreatedAt, &link.UpdatedAt)
 
 	if err != nil {
@@ -51,7 +51,7 @@ func (r *salesLinkRepository) FindByID(ctx context.Context, id string) (*entity.
 	query := `
 		SELECT id, created_by_user_id, industry_id, batch_id, product_id,
 		       link_type, slug_token, title, custom_message, display_price,
-		       show_price, views_count, expires_at, is_active, 
+		       price_snapshot, price_snapshot_unit, currency, show_price, views_count, expires_at, is_active, 
 		       created_at, updated_at
 		FROM sales_links
 		WHERE id = $1
@@ -61,8 +61,8 @@ func (r *salesLinkRepository) FindByID(ctx context.Context, id string) (*entity.
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&link.ID, &link.CreatedByUserID, &link.IndustryID, &link.BatchID,
 		&link.ProductID, &link.LinkType, &link.SlugToken, &link.Title,
-		&link.CustomMessage, &link.DisplayPrice, &link.ShowPrice,
-		&link.ViewsCount, &link.ExpiresAt, &link.IsActive,
+		&link.CustomMessage, &link.DisplayPrice, &link.PriceSnapshot,
+		&link.PriceSnapshotUnit, &link.Currency, &link.ShowPrice, &link.ViewsCount, &link.ExpiresAt, &link.IsActive,
 		&link.CreatedAt, &link.UpdatedAt,
 	)
 
@@ -80,7 +80,7 @@ func (r *salesLinkRepository) FindBySlug(ctx context.Context, slug string) (*ent
 	query := `
 		SELECT id, created_by_user_id, industry_id, batch_id, product_id,
 		       link_type, slug_token, title, custom_message, display_price,
-		       show_price, views_count, expires_at, is_active, 
+		       price_snapshot, price_snapshot_unit, currency, show_price, views_count, expires_at, is_active, 
 		       created_at, updated_at
 		FROM sales_links
 		WHERE slug_token = $1
@@ -90,8 +90,8 @@ func (r *salesLinkRepository) FindBySlug(ctx context.Context, slug string) (*ent
 	err := r.db.QueryRowContext(ctx, query, slug).Scan(
 		&link.ID, &link.CreatedByUserID, &link.IndustryID, &link.BatchID,
 		&link.ProductID, &link.LinkType, &link.SlugToken, &link.Title,
-		&link.CustomMessage, &link.DisplayPrice, &link.ShowPrice,
-		&link.ViewsCount, &link.ExpiresAt, &link.IsActive,
+		&link.CustomMessage, &link.DisplayPrice, &link.PriceSnapshot,
+		&link.PriceSnapshotUnit, &link.Currency, &link.ShowPrice, &link.ViewsCount, &link.ExpiresAt, &link.IsActive,
 		&link.CreatedAt, &link.UpdatedAt,
 	)
 
@@ -114,7 +114,7 @@ func (r *salesLinkRepository) FindByType(ctx context.Context, linkType entity.Li
 	query := `
 		SELECT id, created_by_user_id, industry_id, batch_id, product_id,
 		       link_type, slug_token, title, custom_message, display_price,
-		       show_price, views_count, expires_at, is_active, 
+		       price_snapshot, price_snapshot_unit, currency, show_price, views_count, expires_at, is_active, 
 		       created_at, updated_at
 		FROM sales_links
 		WHERE link_type = $1 AND is_active = TRUE
@@ -140,7 +140,7 @@ func (r *salesLinkRepository) listWithFilters(ctx context.Context, filters entit
 	query := psql.Select(
 		"id", "created_by_user_id", "industry_id", "batch_id", "product_id",
 		"link_type", "slug_token", "title", "custom_message", "display_price",
-		"show_price", "views_count", "expires_at", "is_active",
+		"price_snapshot", "price_snapshot_unit", "currency", "show_price", "views_count", "expires_at", "is_active",
 		"created_at", "updated_at",
 	).From("sales_links")
 
@@ -323,7 +323,7 @@ func (r *salesLinkRepository) scanLinks(rows *sql.Rows) ([]entity.SalesLink, err
 		if err := rows.Scan(
 			&l.ID, &l.CreatedByUserID, &l.IndustryID, &l.BatchID, &l.ProductID,
 			&l.LinkType, &l.SlugToken, &l.Title, &l.CustomMessage,
-			&l.DisplayPrice, &l.ShowPrice, &l.ViewsCount, &l.ExpiresAt,
+			&l.DisplayPrice, &l.PriceSnapshot, &l.PriceSnapshotUnit, &l.Currency, &l.ShowPrice, &l.ViewsCount, &l.ExpiresAt,
 			&l.IsActive, &l.CreatedAt, &l.UpdatedAt,
 		); err != nil {
 			return nil, errors.DatabaseError(err)
@@ -346,16 +346,16 @@ func (r *salesLinkRepository) CreateWithItems(ctx context.Context, link *entity.
 		INSERT INTO sales_links (
 			id, created_by_user_id, industry_id, batch_id, product_id,
 			link_type, slug_token, title, custom_message, display_price,
-			show_price, expires_at, is_active
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			price_snapshot, price_snapshot_unit, currency, show_price, expires_at, is_active
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
 		RETURNING created_at, updated_at
 	`
 
 	err = tx.QueryRowContext(ctx, linkQuery,
 		link.ID, link.CreatedByUserID, link.IndustryID, link.BatchID,
 		link.ProductID, link.LinkType, link.SlugToken, link.Title,
-		link.CustomMessage, link.DisplayPrice, link.ShowPrice,
-		link.ExpiresAt, link.IsActive,
+		link.CustomMessage, link.DisplayPrice, link.PriceSnapshot,
+		link.PriceSnapshotUnit, link.Currency.OrDefault(), link.ShowPrice, link.ExpiresAt, link.IsActive,
 	).Scan(&link.CreatedAt, &link.UpdatedAt)
 
 	if err != nil {
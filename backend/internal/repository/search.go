@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Busca textual de estoque (ver migration 000021): as colunas search_text/search_vector
+// de products e batches guardam o documento normalizado (minúsculas, sem acentos)
+
+// searchTerm retorna o termo de busca sem espaços nas pontas, ou "" se não informado
+func searchTerm(search *string) string {
+	if search == nil {
+		return ""
+	}
+	return strings.TrimSpace(*search)
+}
+
+// searchCondition casa o termo por full-text (prefixo), similaridade trigram ou substring.
+// table é o prefixo das colunas de busca (ex.: "p.", "batches.")
+func searchCondition(table, term string) sq.Sqlizer {
+	return sq.Expr(
+		"("+table+"search_vector @@ search_tsquery(?)"+
+			" OR search_normalize(?) <% "+table+"search_text"+
+			" OR "+table+"search_text LIKE '%' || search_normalize(?) || '%')",
+		term, term, term,
+	)
+}
+
+// searchRankOrder ordena pela relevância (peso full-text + similaridade trigram), mais relevantes primeiro
+func searchRankOrder(table, term string) (string, []interface{}) {
+	return "ts_rank(" + table + "search_vector, search_tsquery(?)) + word_similarity(search_normalize(?), " + table + "search_text) DESC",
+		[]interface{}{term, term}
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type slabRepository struct {
+	db *DB
+}
+
+func NewSlabRepository(db *DB) *slabRepository {
+	return &slabRepository{db: db}
+}
+
+const slabColumns = `
+	id, batch_id, slab_number, height, width, thickness, area, status,
+	defects, reservation_id, sale_id, created_at, updated_at
+`
+
+func (r *slabRepository) CreateMany(ctx context.Context, tx *sql.Tx, slabs []entity.Slab) error {
+	query := `
+		INSERT INTO batch_slabs (id, batch_id, slab_number, height, width, thickness, status, defects)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING area, created_at, updated_at
+	`
+
+	conn := r.db.conn(tx)
+	for i := range slabs {
+		s := &slabs[i]
+		err := conn.QueryRowContext(ctx, query,
+			s.ID, s.BatchID, s.SlabNumber, s.Height, s.Width, s.Thickness, s.Status, s.Defects,
+		).Scan(&s.Area, &s.CreatedAt, &s.UpdatedAt)
+		if err != nil {
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+				return errors.NewConflictError("Número de chapa já existe no lote")
+			}
+			return errors.DatabaseError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *slabRepository) FindByID(ctx context.Context, id string) (*entity.Slab, error) {
+	query := `SELECT ` + slabColumns + ` FROM batch_slabs WHERE id = $1`
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	slabs, err := r.scanSlabs(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(slabs) == 0 {
+		return nil, errors.NewNotFoundError("Chapa")
+	}
+
+	return &slabs[0], nil
+}
+
+func (r *slabRepository) FindByBatchID(ctx context.Context, batchID string) ([]entity.Slab, error) {
+	query := `SELECT ` + slabColumns + ` FROM batch_slabs WHERE batch_id = $1 ORDER BY slab_number`
+
+	rows, err := r.db.QueryContext(ctx, query, batchID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	return r.scanSlabs(rows)
+}
+
+func (r *slabRepository) FindByBatchIDForUpdate(ctx context.Context, tx *sql.Tx, batchID string) ([]entity.Slab, error) {
+	query := `SELECT ` + slabColumns + ` FROM batch_slabs WHERE batch_id = $1 ORDER BY slab_number FOR UPDATE`
+
+	rows, err := r.db.conn(tx).QueryContext(ctx, query, batchID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	return r.scanSlabs(rows)
+}
+
+func (r *slabRepository) FindByReservationID(ctx context.Context, reservationID string) ([]entity.Slab, error) {
+	query := `SELECT ` + slabColumns + ` FROM batch_slabs WHERE reservation_id = $1 ORDER BY slab_number`
+
+	rows, err := r.db.QueryContext(ctx, query, reservationID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	return r.scanSlabs(rows)
+}
+
+func (r *slabRepository) FindBySaleID(ctx context.Context, saleID string) ([]entity.Slab, error) {
+	query := `SELECT ` + slabColumns + ` FROM batch_slabs WHERE sale_id = $1 ORDER BY slab_number`
+
+	rows, err := r.db.QueryContext(ctx, query, saleID)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	return r.scanSlabs(rows)
+}
+
+func (r *slabRepository) FindByNumbersForUpdate(ctx context.Context, tx *sql.Tx, batchID string, numbers []int) ([]entity.Slab, error) {
+	query := `
+		SELECT ` + slabColumns + `
+		FROM batch_slabs
+		WHERE batch_id = $1 AND slab_number = ANY($2::int[])
+		ORDER BY slab_number
+		FOR UPDATE
+	`
+
+	rows, err := r.db.conn(tx).QueryContext(ctx, query, batchID, pq.Array(numbers))
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	return r.scanSlabs(rows)
+}
+
+func (r *slabRepository) FindByStatusForUpdate(ctx context.Context, tx *sql.Tx, batchID string, status entity.BatchStatus, reservationID, saleID *string, limit int) ([]entity.Slab, error) {
+	query := `
+		SELECT ` + slabColumns + `
+		FROM batch_slabs
+		WHERE batch_id = $1
+		  AND status = $2
+		  AND ($3::uuid IS NULL OR reservation_id = $3 OR reservation_id IS NULL)
+		  AND ($4::uuid IS NULL OR sale_id = $4 OR sale_id IS NULL)
+		ORDER BY (reservation_id IS NULL), (sale_id IS NULL), slab_number
+		LIMIT $5
+		FOR UPDATE
+	`
+
+	rows, err := r.db.conn(tx).QueryContext(ctx, query, batchID, status, reservationID, saleID, limit)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	return r.scanSlabs(rows)
+}
+
+func (r *slabRepository) UpdateStatus(ctx context.Context, tx *sql.Tx, ids []string, status entity.BatchStatus, reservationID, saleID *string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE batch_slabs
+		SET status = $1, reservation_id = $2, sale_id = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ANY($4::uuid[])
+	`
+
+	if _, err := r.db.conn(tx).ExecContext(ctx, query, status, reservationID, saleID, pq.Array(ids)); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *slabRepository) Update(ctx context.Context, slab *entity.Slab) error {
+	query := `
+		UPDATE batch_slabs
+		SET height = $1, width = $2, thickness = $3, defects = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $5
+		RETURNING area, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		slab.Height, slab.Width, slab.Thickness, slab.Defects, slab.ID,
+	).Scan(&slab.Area, &slab.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("Chapa")
+	}
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *slabRepository) Reassign(ctx context.Context, tx *sql.Tx, ids []string, batchID string, startNumber int) ([]int, error) {
+	query := `
+		UPDATE batch_slabs
+		SET batch_id = $1, slab_number = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3
+	`
+
+	conn := r.db.conn(tx)
+	numbers := make([]int, len(ids))
+	for i, id := range ids {
+		numbers[i] = startNumber + i
+		if _, err := conn.ExecContext(ctx, query, batchID, numbers[i], id); err != nil {
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+				return nil, errors.NewConflictError("Número de chapa já existe no lote")
+			}
+			return nil, errors.DatabaseError(err)
+		}
+	}
+
+	return numbers, nil
+}
+
+func (r *slabRepository) DeleteByIDs(ctx context.Context, tx *sql.Tx, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `DELETE FROM batch_slabs WHERE id = ANY($1::uuid[])`
+
+	if _, err := r.db.conn(tx).ExecContext(ctx, query, pq.Array(ids)); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *slabRepository) CountByBatchID(ctx context.Context, tx *sql.Tx, batchID string) (int, error) {
+	query := `SELECT COUNT(*) FROM batch_slabs WHERE batch_id = $1`
+
+	var count int
+	if err := r.db.conn(tx).QueryRowContext(ctx, query, batchID).Scan(&count); err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return count, nil
+}
+
+func (r *slabRepository) CountByStatus(ctx context.Context, tx *sql.Tx, batchID string) (entity.SlabCounts, error) {
+	query := `
+		SELECT
+			COUNT(*) FILTER (WHERE status = 'DISPONIVEL'),
+			COUNT(*) FILTER (WHERE status = 'RESERVADO'),
+			COUNT(*) FILTER (WHERE status = 'VENDIDO'),
+			COUNT(*) FILTER (WHERE status = 'INATIVO')
+		FROM batch_slabs
+		WHERE batch_id = $1
+	`
+
+	var counts entity.SlabCounts
+	err := r.db.conn(tx).QueryRowContext(ctx, query, batchID).Scan(
+		&counts.Available, &counts.Reserved, &counts.Sold, &counts.Inactive,
+	)
+	if err != nil {
+		return entity.SlabCounts{}, errors.DatabaseError(err)
+	}
+
+	return counts, nil
+}
+
+func (r *slabRepository) MaxSlabNumber(ctx context.Context, tx *sql.Tx, batchID string) (int, error) {
+	query := `SELECT COALESCE(MAX(slab_number), 0) FROM batch_slabs WHERE batch_id = $1`
+
+	var max int
+	if err := r.db.conn(tx).QueryRowContext(ctx, query, batchID).Scan(&max); err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return max, nil
+}
+
+func (r *slabRepository) scanSlabs(rows *sql.Rows) ([]entity.Slab, error) {
+	slabs := []entity.Slab{}
+	for rows.Next() {
+		var s entity.Slab
+		if err := rows.Scan(
+			&s.ID, &s.BatchID, &s.SlabNumber, &s.Height, &s.Width, &s.Thickness, &s.Area, &s.Status,
+			&s.Defects, &s.ReservationID, &s.SaleID, &s.CreatedAt, &s.UpdatedAt,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		slabs = append(slabs, s)
+	}
+	return slabs, nil
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type stockAlertRepository struct {
+	db *DB
+}
+
+func NewStockAlertRepository(db *DB) *stockAlertRepository {
+	return &stockAlertRepository{db: db}
+}
+
+// stockAlertCrossingsCTE lista os lotes ativos com chapas disponíveis que estão além de um limite
+const stockAlertCrossingsCTE = `
+	WITH crossings AS (
+		SELECT b.id AS batch_id, b.industry_id, 'ESTOQUE_BAIXO' AS alert_type,
+		       t.low_stock_slabs AS threshold, b.available_slabs AS current_value
+		FROM batches b
+		INNER JOIN batch_stock_thresholds t ON t.batch_id = b.id
+		WHERE b.is_active = TRUE
+		  AND b.deleted_at IS NULL
+		  AND b.available_slabs > 0
+		  AND b.available_slabs <= t.low_stock_slabs
+		UNION ALL
+		SELECT b.id, b.industry_id, 'ESTOQUE_PARADO',
+		       t.stale_days, FLOOR(EXTRACT(EPOCH FROM (NOW() - COALESCE(b.last_activity_at, b.entry_date)))/86400)::INTEGER
+		FROM batches b
+		INNER JOIN batch_stock_thresholds t ON t.batch_id = b.id
+		WHERE b.is_active = TRUE
+		  AND b.deleted_at IS NULL
+		  AND b.available_slabs > 0
+		  AND EXTRACT(EPOCH FROM (NOW() - COALESCE(b.last_activity_at, b.entry_date)))/86400 > t.stale_days
+	)
+`
+
+var stockAlertColumns = []string{
+	"a.id", "a.industry_id", "a.batch_id", "b.batch_code", "p.name", "a.alert_type",
+	"a.threshold", "a.current_value", "a.triggered_at", "a.notified_at", "a.resolved_at",
+}
+
+func (r *stockAlertRepository) Sync(ctx context.Context) (int, int, error) {
+	var opened, resolved int
+	err := r.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		// Resolver primeiro: um lote que saiu e voltou à condição gera um novo alerta
+		result, err := tx.ExecContext(ctx, stockAlertCrossingsCTE+`
+			UPDATE stock_alerts a
+			SET resolved_at = NOW()
+			WHERE a.resolved_at IS NULL
+			  AND NOT EXISTS (
+				SELECT 1 FROM crossings c
+				WHERE c.batch_id = a.batch_id AND c.alert_type = a.alert_type
+			  )
+		`)
+		if err != nil {
+			return errors.DatabaseError(err)
+		}
+		rows, _ := result.RowsAffected()
+		resolved = int(rows)
+
+		// O índice único parcial garante um único alerta aberto por lote e tipo
+		result, err = tx.ExecContext(ctx, stockAlertCrossingsCTE+`
+			INSERT INTO stock_alerts (industry_id, batch_id, alert_type, threshold, current_value)
+			SELECT c.industry_id, c.batch_id, c.alert_type, c.threshold, c.current_value
+			FROM crossings c
+			ON CONFLICT (batch_id, alert_type) WHERE resolved_at IS NULL DO NOTHING
+		`)
+		if err != nil {
+			return errors.DatabaseError(err)
+		}
+		rows, _ = result.RowsAffected()
+		opened = int(rows)
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return opened, resolved, nil
+}
+
+func (r *stockAlertRepository) FindPendingNotification(ctx context.Context) ([]entity.StockAlert, error) {
+	query, args, err := r.selectAlerts().
+		Where(sq.Eq{"a.notified_at": nil, "a.resolved_at": nil}).
+		OrderBy("a.industry_id", "a.alert_type", "b.batch_code").
+		ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return r.queryAlerts(ctx, query, args...)
+}
+
+func (r *stockAlertRepository) MarkNotified(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `UPDATE stock_alerts SET notified_at = NOW() WHERE id = ANY($1)`
+	if _, err := r.db.ExecContext(ctx, query, pq.Array(ids)); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *stockAlertRepository) List(ctx context.Context, industryID string, filters entity.StockAlertFilters) ([]entity.StockAlert, int, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	where := sq.And{sq.Eq{"a.industry_id": industryID}}
+	if filters.Type != nil {
+		where = append(where, sq.Eq{"a.alert_type": *filters.Type})
+	}
+	if filters.BatchID != nil {
+		where = append(where, sq.Eq{"a.batch_id": *filters.BatchID})
+	}
+	if filters.OnlyOpen {
+		where = append(where, sq.Eq{"a.resolved_at": nil})
+	}
+
+	countSQL, countArgs, err := psql.Select("COUNT(*)").From("stock_alerts a").Where(where).ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, countSQL, countArgs...).Scan(&total); err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	offset := (filters.Page - 1) * filters.Limit
+	query, args, err := r.selectAlerts().
+		Where(where).
+		OrderBy("a.triggered_at DESC").
+		Limit(uint64(filters.Limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		return nil, 0, errors.DatabaseError(err)
+	}
+
+	alerts, err := r.queryAlerts(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return alerts, total, nil
+}
+
+func (r *stockAlertRepository) selectAlerts() sq.SelectBuilder {
+	return sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(stockAlertColumns...).
+		From("stock_alerts a").
+		Join("batches b ON b.id = a.batch_id").
+		LeftJoin("products p ON p.id = b.product_id")
+}
+
+func (r *stockAlertRepository) queryAlerts(ctx context.Context, query string, args ...interface{}) ([]entity.StockAlert, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	alerts := []entity.StockAlert{}
+	for rows.Next() {
+		var a entity.StockAlert
+		var productName sql.NullString
+		if err := rows.Scan(
+			&a.ID, &a.IndustryID, &a.BatchID, &a.BatchCode, &productName, &a.Type,
+			&a.Threshold, &a.CurrentValue, &a.TriggeredAt, &a.NotifiedAt, &a.ResolvedAt,
+		); err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		if productName.Valid {
+			a.ProductName = &productName.String
+		}
+		alerts = append(alerts, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return alerts, nil
+}
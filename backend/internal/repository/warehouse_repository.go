@@ -0,0 +1,358 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+type warehouseRepository struct {
+	db *DB
+}
+
+func NewWarehouseRepository(db *DB) *warehouseRepository {
+	return &warehouseRepository{db: db}
+}
+
+var warehouseColumns = []string{
+	"w.id", "w.industry_id", "w.code", "w.name", "w.address", "w.city", "w.state",
+	"w.is_active", "w.created_at", "w.updated_at",
+	"(SELECT COUNT(*) FROM batches b WHERE b.warehouse_id = w.id AND b.deleted_at IS NULL AND b.is_active = TRUE)",
+}
+
+func (r *warehouseRepository) Create(ctx context.Context, warehouse *entity.Warehouse) error {
+	query := `
+		INSERT INTO warehouses (id, industry_id, code, name, address, city, state, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		warehouse.ID, warehouse.IndustryID, warehouse.Code, warehouse.Name,
+		warehouse.Address, warehouse.City, warehouse.State, warehouse.IsActive,
+	).Scan(&warehouse.CreatedAt, &warehouse.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Código de depósito já cadastrado")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) FindByID(ctx context.Context, id string) (*entity.Warehouse, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	query, args, err := psql.Select(warehouseColumns...).
+		From("warehouses w").
+		Where(sq.Eq{"w.id": id}).
+		ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	warehouse, err := scanWarehouse(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Depósito")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	warehouses := []entity.Warehouse{*warehouse}
+	if err := r.loadLocations(ctx, warehouses); err != nil {
+		return nil, err
+	}
+
+	return &warehouses[0], nil
+}
+
+func (r *warehouseRepository) List(ctx context.Context, industryID string, includeInactive bool) ([]entity.Warehouse, error) {
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	where := sq.And{sq.Eq{"w.industry_id": industryID}}
+	if !includeInactive {
+		where = append(where, sq.Eq{"w.is_active": true})
+	}
+
+	query, args, err := psql.Select(warehouseColumns...).
+		From("warehouses w").
+		Where(where).
+		OrderBy("w.name", "w.code").
+		ToSql()
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	warehouses := []entity.Warehouse{}
+	for rows.Next() {
+		warehouse, err := scanWarehouse(rows)
+		if err != nil {
+			return nil, errors.DatabaseError(err)
+		}
+		warehouses = append(warehouses, *warehouse)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	if err := r.loadLocations(ctx, warehouses); err != nil {
+		return nil, err
+	}
+
+	return warehouses, nil
+}
+
+// loadLocations preenche as posições (ativas e inativas) de cada depósito
+func (r *warehouseRepository) loadLocations(ctx context.Context, warehouses []entity.Warehouse) error {
+	if len(warehouses) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(warehouses))
+	index := make(map[string]int, len(warehouses))
+	for i := range warehouses {
+		warehouses[i].Locations = []entity.WarehouseLocation{}
+		ids = append(ids, warehouses[i].ID)
+		index[warehouses[i].ID] = i
+	}
+
+	query := `
+		SELECT l.id, l.warehouse_id, l.code, l.description, l.is_active, l.created_at, l.updated_at,
+		       (SELECT COUNT(*) FROM batches b WHERE b.location_id = l.id AND b.deleted_at IS NULL AND b.is_active = TRUE)
+		FROM warehouse_locations l
+		WHERE l.warehouse_id = ANY($1::uuid[])
+		ORDER BY l.code
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var l entity.WarehouseLocation
+		if err := rows.Scan(
+			&l.ID, &l.WarehouseID, &l.Code, &l.Description, &l.IsActive, &l.CreatedAt, &l.UpdatedAt, &l.BatchCount,
+		); err != nil {
+			return errors.DatabaseError(err)
+		}
+		if i, ok := index[l.WarehouseID]; ok {
+			warehouses[i].Locations = append(warehouses[i].Locations, l)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) Update(ctx context.Context, warehouse *entity.Warehouse) error {
+	query := `
+		UPDATE warehouses
+		SET code = $1, name = $2, address = $3, city = $4, state = $5, is_active = $6,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $7
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		warehouse.Code, warehouse.Name, warehouse.Address, warehouse.City, warehouse.State,
+		warehouse.IsActive, warehouse.ID,
+	).Scan(&warehouse.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("Depósito")
+	}
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Código de depósito já cadastrado")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM warehouses WHERE id = $1`, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Depósito")
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) ExistsByCode(ctx context.Context, industryID, code, excludeID string) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM warehouses
+			WHERE industry_id = $1 AND code = $2 AND ($3 = '' OR id::text <> $3)
+		)
+	`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, industryID, code, excludeID).Scan(&exists); err != nil {
+		return false, errors.DatabaseError(err)
+	}
+
+	return exists, nil
+}
+
+func (r *warehouseRepository) CountBatches(ctx context.Context, id string) (int, error) {
+	query := `SELECT COUNT(*) FROM batches WHERE warehouse_id = $1 AND deleted_at IS NULL`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&count); err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return count, nil
+}
+
+func (r *warehouseRepository) CreateLocation(ctx context.Context, location *entity.WarehouseLocation) error {
+	query := `
+		INSERT INTO warehouse_locations (id, warehouse_id, code, description, is_active)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		location.ID, location.WarehouseID, location.Code, location.Description, location.IsActive,
+	).Scan(&location.CreatedAt, &location.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Código de posição já cadastrado neste depósito")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) FindLocationByID(ctx context.Context, id string) (*entity.WarehouseLocation, error) {
+	query := `
+		SELECT l.id, l.warehouse_id, l.code, l.description, l.is_active, l.created_at, l.updated_at,
+		       (SELECT COUNT(*) FROM batches b WHERE b.location_id = l.id AND b.deleted_at IS NULL AND b.is_active = TRUE)
+		FROM warehouse_locations l
+		WHERE l.id = $1
+	`
+
+	var l entity.WarehouseLocation
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&l.ID, &l.WarehouseID, &l.Code, &l.Description, &l.IsActive, &l.CreatedAt, &l.UpdatedAt, &l.BatchCount,
+	)
+	if err == sql.ErrNoRows {
+		return nil, errors.NewNotFoundError("Posição")
+	}
+	if err != nil {
+		return nil, errors.DatabaseError(err)
+	}
+
+	return &l, nil
+}
+
+func (r *warehouseRepository) UpdateLocation(ctx context.Context, location *entity.WarehouseLocation) error {
+	query := `
+		UPDATE warehouse_locations
+		SET code = $1, description = $2, is_active = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query,
+		location.Code, location.Description, location.IsActive, location.ID,
+	).Scan(&location.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return errors.NewNotFoundError("Posição")
+	}
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return errors.NewConflictError("Código de posição já cadastrado neste depósito")
+		}
+		return errors.DatabaseError(err)
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) DeleteLocation(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM warehouse_locations WHERE id = $1`, id)
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.DatabaseError(err)
+	}
+
+	if rows == 0 {
+		return errors.NewNotFoundError("Posição")
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) ExistsLocationCode(ctx context.Context, warehouseID, code, excludeID string) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM warehouse_locations
+			WHERE warehouse_id = $1 AND code = $2 AND ($3 = '' OR id::text <> $3)
+		)
+	`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, warehouseID, code, excludeID).Scan(&exists); err != nil {
+		return false, errors.DatabaseError(err)
+	}
+
+	return exists, nil
+}
+
+func (r *warehouseRepository) CountLocationBatches(ctx context.Context, id string) (int, error) {
+	query := `SELECT COUNT(*) FROM batches WHERE location_id = $1 AND deleted_at IS NULL`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, id).Scan(&count); err != nil {
+		return 0, errors.DatabaseError(err)
+	}
+
+	return count, nil
+}
+
+func scanWarehouse(row rowScanner) (*entity.Warehouse, error) {
+	var w entity.Warehouse
+	if err := row.Scan(
+		&w.ID, &w.IndustryID, &w.Code, &w.Name, &w.Address, &w.City, &w.State,
+		&w.IsActive, &w.CreatedAt, &w.UpdatedAt, &w.BatchCount,
+	); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	"github.com/thiagomes07/CAVA/backend/pkg/label"
+	"go.uber.org/zap"
+)
+
+// maxLabelsPerRequest limita o total de etiquetas (lotes × cópias) de um PDF
+const maxLabelsPerRequest = 2000
+
+type batchLabelService struct {
+	batchRepo   repository.BatchRepository
+	frontendURL string
+	logger      *zap.Logger
+}
+
+func NewBatchLabelService(
+	batchRepo repository.BatchRepository,
+	frontendURL string,
+	logger *zap.Logger,
+) *batchLabelService {
+	return &batchLabelService{
+		batchRepo:   batchRepo,
+		frontendURL: frontendURL,
+		logger:      logger,
+	}
+}
+
+func (s *batchLabelService) Layouts() []entity.LabelLayout {
+	layouts := label.Layouts()
+	result := make([]entity.LabelLayout, 0, len(layouts))
+	for _, l := range layouts {
+		result = append(result, entity.LabelLayout{
+			Name:        l.Name,
+			Description: l.Description,
+			PageWidth:   l.PageWidth,
+			PageHeight:  l.PageHeight,
+			Columns:     l.Columns,
+			Rows:        l.Rows,
+			LabelWidth:  l.LabelWidth,
+			LabelHeight: l.LabelHeight,
+			PerPage:     l.PerPage(),
+		})
+	}
+	return result
+}
+
+func (s *batchLabelService) Generate(ctx context.Context, industryID string, input entity.GenerateBatchLabelsInput) ([]byte, error) {
+	layoutName := input.Layout
+	if layoutName == "" {
+		layoutName = label.DefaultLayout
+	}
+	layout, ok := label.FindLayout(layoutName)
+	if !ok {
+		return nil, domainErrors.ValidationError("Layout de etiqueta inválido")
+	}
+
+	copies := input.Copies
+	if copies == 0 {
+		copies = 1
+	}
+
+	// Mantém a ordem informada, ignorando IDs repetidos
+	ids := make([]string, 0, len(input.BatchIDs))
+	seen := make(map[string]bool, len(input.BatchIDs))
+	for _, id := range input.BatchIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids)*copies > maxLabelsPerRequest {
+		return nil, domainErrors.ValidationError(fmt.Sprintf("Máximo de %d etiquetas por impressão", maxLabelsPerRequest))
+	}
+
+	batches := make(map[string]entity.Batch, len(ids))
+	filters := entity.BatchFilters{IDs: ids, IncludeArchived: true}
+	err := s.batchRepo.Stream(ctx, industryID, filters, func(b *entity.Batch) error {
+		batches[b.ID] = *b
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("erro ao buscar lotes para etiquetas",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	labels := make([]label.Label, 0, len(ids)*copies)
+	for _, id := range ids {
+		batch, ok := batches[id]
+		if !ok {
+			// Lotes de outra indústria ou excluídos não são revelados
+			return nil, domainErrors.NewNotFoundError("Lote")
+		}
+		l := s.batchLabel(&batch)
+		for i := 0; i < copies; i++ {
+			labels = append(labels, l)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := label.Render(&buf, layout, labels, label.Options{
+		Start:  input.StartPosition,
+		Border: input.ShowBorder,
+	}); err != nil {
+		s.logger.Error("erro ao gerar PDF de etiquetas",
+			zap.String("industryId", industryID),
+			zap.String("layout", layout.Name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("etiquetas de lotes geradas",
+		zap.String("industryId", industryID),
+		zap.String("layout", layout.Name),
+		zap.Int("batches", len(ids)),
+		zap.Int("labels", len(labels)),
+	)
+
+	return buf.Bytes(), nil
+}
+
+// batchLabel monta o conteúdo da etiqueta; o QR code abre a página do lote
+func (s *batchLabelService) batchLabel(b *entity.Batch) label.Label {
+	lines := make([]string, 0, 4)
+	if b.Product != nil {
+		product := b.Product.Name
+		if b.Product.SKU != nil && *b.Product.SKU != "" {
+			product += " · " + *b.Product.SKU
+		}
+		lines = append(lines, product)
+
+		details := []string{}
+		if b.Product.Material != "" {
+			details = append(details, string(b.Product.Material))
+		}
+		if b.Product.Finish != "" {
+			finish := strings.ToLower(string(b.Product.Finish))
+			details = append(details, strings.ToUpper(finish[:1])+finish[1:])
+		}
+		if len(details) > 0 {
+			lines = append(lines, strings.Join(details, " · "))
+		}
+	}
+
+	lines = append(lines,
+		fmt.Sprintf("%s × %s × %s cm", formatLabelNumber(b.Height), formatLabelNumber(b.Width), formatLabelNumber(b.Thickness)),
+		fmt.Sprintf("%d chapa(s) · %s m²", b.QuantitySlabs, formatLabelNumber(b.TotalArea)),
+	)
+
+	return label.Label{
+		Title:     b.BatchCode,
+		Lines:     lines,
+		QRContent: s.frontendURL + "/inventory/" + b.ID,
+	}
+}
+
+// formatLabelNumber formata medidas no padrão brasileiro (vírgula decimal, até 2 casas)
+func formatLabelNumber(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	return strings.Replace(s, ".", ",", 1)
+}
@@ -0,0 +1,453 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"go.uber.org/zap"
+)
+
+func (s *batchService) Split(ctx context.Context, industryID, id, userID string, input entity.SplitBatchInput) (*entity.BatchSplitResponse, error) {
+	if input.Quantity <= 0 {
+		return nil, domainErrors.ValidationError("Quantidade deve ser maior que 0")
+	}
+	if len(input.SlabNumbers) > 0 && len(input.SlabNumbers) != input.Quantity {
+		return nil, domainErrors.ValidationError("Quantidade deve corresponder às chapas informadas")
+	}
+
+	var manualCode string
+	if strings.TrimSpace(input.BatchCode) != "" {
+		code, err := entity.NewBatchCode(input.BatchCode)
+		if err != nil {
+			return nil, domainErrors.ValidationError(err.Error())
+		}
+		manualCode = code.String()
+	}
+
+	var child *entity.Batch
+
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		parent, err := s.batchRepo.FindByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if parent.IndustryID != industryID {
+			return domainErrors.ForbiddenError()
+		}
+		if !parent.IsActive {
+			return domainErrors.ValidationError("Lote arquivado não pode ser dividido")
+		}
+		if input.Quantity > parent.AvailableSlabs {
+			return domainErrors.InsufficientSlabsError(input.Quantity, parent.AvailableSlabs)
+		}
+		if input.Quantity >= parent.QuantitySlabs {
+			return domainErrors.ValidationError("A divisão deve manter ao menos uma chapa no lote de origem")
+		}
+
+		child = &entity.Batch{
+			ID:             uuid.New().String(),
+			ProductID:      parent.ProductID,
+			IndustryID:     parent.IndustryID,
+			BatchCode:      manualCode,
+			Height:         parent.Height,
+			Width:          parent.Width,
+			Thickness:      parent.Thickness,
+			QuantitySlabs:  input.Quantity,
+			AvailableSlabs: input.Quantity,
+			IndustryPrice:  parent.IndustryPrice,
+			PriceUnit:      parent.PriceUnit,
+			Currency:       parent.Currency,
+			PriceOverride:  parent.PriceOverride,
+			OriginQuarry:   parent.OriginQuarry,
+			BlockID:        parent.BlockID,
+			WarehouseID:    parent.WarehouseID,
+			LocationID:     parent.LocationID,
+			EntryDate:      parent.EntryDate,
+			Status:         entity.BatchStatusDisponivel,
+			IsActive:       true,
+			IsPublic:       parent.IsPublic,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		}
+
+		if manualCode != "" {
+			exists, err := s.batchRepo.ExistsByCode(ctx, tx, parent.IndustryID, manualCode)
+			if err != nil {
+				return err
+			}
+			if exists {
+				return domainErrors.BatchCodeExistsError(manualCode)
+			}
+		} else {
+			product, err := s.productRepo.FindByID(ctx, parent.ProductID)
+			if err != nil {
+				return err
+			}
+			prefix, err := s.batchCodePrefix(ctx, parent.IndustryID, product.Name, product.Material)
+			if err != nil {
+				return err
+			}
+			code, err := s.nextBatchCode(ctx, tx, parent.IndustryID, prefix)
+			if err != nil {
+				return err
+			}
+			child.BatchCode = code.String()
+		}
+
+		if err := s.batchRepo.Create(ctx, tx, child); err != nil {
+			return err
+		}
+
+		// Chapas disponíveis mudam de lote e são renumeradas a partir de 1 no novo lote
+		slabs, err := s.transferableSlabs(ctx, tx, parent.ID, entity.BatchStatusDisponivel, input.SlabNumbers, input.Quantity)
+		if err != nil {
+			return err
+		}
+		oldNumbers, newNumbers, err := s.reassignSlabs(ctx, tx, slabs, child.ID, 1)
+		if err != nil {
+			return err
+		}
+
+		available := entity.BatchStatusDisponivel
+		movements := []entity.BatchMovement{
+			{BatchID: parent.ID, FromStatus: &available, Quantity: input.Quantity, SlabNumbers: oldNumbers},
+			{BatchID: child.ID, ToStatus: &available, Quantity: input.Quantity, SlabNumbers: newNumbers},
+		}
+		for _, m := range movements {
+			m.Reason = entity.MovementReasonDivisaoLote
+			m.ActorUserID = &userID
+			m.Notes = input.Notes
+			if err := s.slabs.record(ctx, tx, m); err != nil {
+				return err
+			}
+		}
+
+		if err := s.updateSlabTotals(ctx, tx, parent,
+			parent.AvailableSlabs-input.Quantity, parent.ReservedSlabs, parent.SoldSlabs, parent.InactiveSlabs,
+		); err != nil {
+			return err
+		}
+
+		if err := s.lineageRepo.CopyReferences(ctx, tx, parent.ID, child.ID, input.Quantity); err != nil {
+			return err
+		}
+
+		return s.lineageRepo.Create(ctx, tx, &entity.BatchLineage{
+			ID:            uuid.New().String(),
+			ParentBatchID: parent.ID,
+			ChildBatchID:  child.ID,
+			Operation:     entity.BatchLineageDivisao,
+			Quantity:      input.Quantity,
+			SlabNumbers:   oldNumbers,
+			Notes:         input.Notes,
+			ActorUserID:   &userID,
+		})
+	})
+	if err != nil {
+		s.logger.Error("erro ao dividir lote",
+			zap.String("batchId", id),
+			zap.Int("quantity", input.Quantity),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("lote dividido",
+		zap.String("batchId", id),
+		zap.String("childBatchId", child.ID),
+		zap.String("childBatchCode", child.BatchCode),
+		zap.Int("quantity", input.Quantity),
+	)
+
+	parent, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.GetByID(ctx, child.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.BatchSplitResponse{Parent: parent, Child: created}, nil
+}
+
+func (s *batchService) Merge(ctx context.Context, industryID, userID string, input entity.MergeBatchesInput) (*entity.Batch, error) {
+	seen := map[string]bool{input.TargetBatchID: true}
+	for _, sourceID := range input.SourceBatchIDs {
+		if seen[sourceID] {
+			return nil, domainErrors.ValidationError("Lotes de origem devem ser distintos entre si e do lote de destino")
+		}
+		seen[sourceID] = true
+	}
+
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		// Lock em ordem fixa de ID para evitar deadlock entre unificações concorrentes
+		ids := append([]string{input.TargetBatchID}, input.SourceBatchIDs...)
+		sort.Strings(ids)
+		locked := make(map[string]*entity.Batch, len(ids))
+		for _, batchID := range ids {
+			batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, batchID)
+			if err != nil {
+				return err
+			}
+			if batch.IndustryID != industryID {
+				return domainErrors.ForbiddenError()
+			}
+			if !batch.IsActive {
+				return domainErrors.ValidationError(fmt.Sprintf("Lote %s está arquivado", batch.BatchCode))
+			}
+			locked[batchID] = batch
+		}
+
+		target := locked[input.TargetBatchID]
+		targetTracked, err := s.slabs.tracked(ctx, tx, target.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, sourceID := range input.SourceBatchIDs {
+			source := locked[sourceID]
+			if !source.IsMergeCompatible(target) {
+				return domainErrors.ValidationError(fmt.Sprintf("Lote %s não é compatível com o lote de destino (produto, bloco, dimensões e unidade de preço devem ser iguais)", source.BatchCode))
+			}
+			if source.ReservedSlabs > 0 {
+				return domainErrors.ValidationError(fmt.Sprintf("Lote %s possui chapas reservadas", source.BatchCode))
+			}
+			if source.AvailableSlabs+source.InactiveSlabs == 0 {
+				return domainErrors.ValidationError(fmt.Sprintf("Lote %s não possui chapas para unificar", source.BatchCode))
+			}
+
+			if err := s.mergeInto(ctx, tx, source, target, targetTracked, userID, input.Notes); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("erro ao unificar lotes",
+			zap.String("targetBatchId", input.TargetBatchID),
+			zap.Strings("sourceBatchIds", input.SourceBatchIDs),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("lotes unificados",
+		zap.String("targetBatchId", input.TargetBatchID),
+		zap.Strings("sourceBatchIds", input.SourceBatchIDs),
+	)
+
+	// Chapas disponíveis recebidas das origens atendem a fila de espera do destino
+	s.waitlist.ProcessWaitlist(ctx, input.TargetBatchID)
+
+	return s.GetByID(ctx, input.TargetBatchID)
+}
+
+// mergeInto transfere as chapas disponíveis e inativas do lote de origem para o destino.
+// Chapas vendidas permanecem na origem (histórico de vendas), que é arquivada.
+func (s *batchService) mergeInto(ctx context.Context, tx *sql.Tx, source, target *entity.Batch, targetTracked bool, userID string, notes *string) error {
+	sourceTracked, err := s.slabs.tracked(ctx, tx, source.ID)
+	if err != nil {
+		return err
+	}
+	if sourceTracked && !targetTracked {
+		return domainErrors.ValidationError("Lote de destino não possui rastreamento por chapa. Inicialize as chapas antes de unificar")
+	}
+
+	groups := []struct {
+		status   entity.BatchStatus
+		quantity int
+	}{
+		{entity.BatchStatusDisponivel, source.AvailableSlabs},
+		{entity.BatchStatusInativo, source.InactiveSlabs},
+	}
+
+	var lineageNumbers []int
+	for _, g := range groups {
+		if g.quantity == 0 {
+			continue
+		}
+
+		var oldNumbers, newNumbers []int
+		if targetTracked {
+			next, err := s.slabRepo.MaxSlabNumber(ctx, tx, target.ID)
+			if err != nil {
+				return err
+			}
+			if sourceTracked {
+				slabs, err := s.transferableSlabs(ctx, tx, source.ID, g.status, nil, g.quantity)
+				if err != nil {
+					return err
+				}
+				oldNumbers, newNumbers, err = s.reassignSlabs(ctx, tx, slabs, target.ID, next+1)
+				if err != nil {
+					return err
+				}
+			} else {
+				// Origem legada: as chapas são criadas no destino com as dimensões do lote
+				if err := s.slabs.generate(ctx, tx, target, next+1, g.quantity, g.status); err != nil {
+					return err
+				}
+				for i := 0; i < g.quantity; i++ {
+					newNumbers = append(newNumbers, next+1+i)
+				}
+			}
+		}
+		lineageNumbers = append(lineageNumbers, oldNumbers...)
+
+		status := g.status
+		movements := []entity.BatchMovement{
+			{BatchID: source.ID, FromStatus: &status, Quantity: g.quantity, SlabNumbers: oldNumbers},
+			{BatchID: target.ID, ToStatus: &status, Quantity: g.quantity, SlabNumbers: newNumbers},
+		}
+		for _, m := range movements {
+			m.Reason = entity.MovementReasonUnificacaoLote
+			m.ActorUserID = &userID
+			m.Notes = notes
+			if err := s.slabs.record(ctx, tx, m); err != nil {
+				return err
+			}
+		}
+	}
+
+	moved := source.AvailableSlabs + source.InactiveSlabs
+	if err := s.updateSlabTotals(ctx, tx, target,
+		target.AvailableSlabs+source.AvailableSlabs, target.ReservedSlabs, target.SoldSlabs, target.InactiveSlabs+source.InactiveSlabs,
+	); err != nil {
+		return err
+	}
+	if err := s.updateSlabTotals(ctx, tx, source, 0, 0, source.SoldSlabs, 0); err != nil {
+		return err
+	}
+
+	if err := s.lineageRepo.MoveReferences(ctx, tx, source.ID, target.ID); err != nil {
+		return err
+	}
+
+	if err := s.lineageRepo.Create(ctx, tx, &entity.BatchLineage{
+		ID:            uuid.New().String(),
+		ParentBatchID: source.ID,
+		ChildBatchID:  target.ID,
+		Operation:     entity.BatchLineageUnificacao,
+		Quantity:      moved,
+		SlabNumbers:   lineageNumbers,
+		Notes:         notes,
+		ActorUserID:   &userID,
+	}); err != nil {
+		return err
+	}
+
+	return s.batchRepo.Archive(ctx, tx, source.ID)
+}
+
+// transferableSlabs seleciona (com lock) as chapas do lote no status informado, pelos números
+// ou pelas de menor número. Lotes sem rastreamento por chapa retornam lista vazia.
+func (s *batchService) transferableSlabs(ctx context.Context, tx *sql.Tx, batchID string, status entity.BatchStatus, numbers []int, quantity int) ([]entity.Slab, error) {
+	tracked, err := s.slabs.tracked(ctx, tx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if !tracked {
+		if len(numbers) > 0 {
+			return nil, domainErrors.ValidationError("Lote não possui rastreamento por chapa")
+		}
+		return nil, nil
+	}
+
+	if len(numbers) == 0 {
+		slabs, err := s.slabRepo.FindByStatusForUpdate(ctx, tx, batchID, status, nil, nil, quantity)
+		if err != nil {
+			return nil, err
+		}
+		if len(slabs) < quantity {
+			return nil, domainErrors.InsufficientSlabsError(quantity, len(slabs))
+		}
+		return slabs, nil
+	}
+
+	seen := make(map[int]bool, len(numbers))
+	for _, n := range numbers {
+		if seen[n] {
+			return nil, domainErrors.ValidationError(fmt.Sprintf("Chapa %d informada mais de uma vez", n))
+		}
+		seen[n] = true
+	}
+
+	slabs, err := s.slabRepo.FindByNumbersForUpdate(ctx, tx, batchID, numbers)
+	if err != nil {
+		return nil, err
+	}
+	if len(slabs) != len(numbers) {
+		return nil, domainErrors.ValidationError("Uma ou mais chapas não pertencem ao lote")
+	}
+	for _, slab := range slabs {
+		if slab.Status != status {
+			return nil, domainErrors.ValidationError(fmt.Sprintf("Chapa %d não está com status %s", slab.SlabNumber, status))
+		}
+	}
+
+	return slabs, nil
+}
+
+// reassignSlabs move as chapas para o lote de destino e retorna os números antigos e novos
+func (s *batchService) reassignSlabs(ctx context.Context, tx *sql.Tx, slabs []entity.Slab, batchID string, start int) ([]int, []int, error) {
+	if len(slabs) == 0 {
+		return nil, nil, nil
+	}
+
+	ids := make([]string, len(slabs))
+	oldNumbers := make([]int, len(slabs))
+	for i, slab := range slabs {
+		ids[i] = slab.ID
+		oldNumbers[i] = slab.SlabNumber
+	}
+
+	newNumbers, err := s.slabRepo.Reassign(ctx, tx, ids, batchID, start)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return oldNumbers, newNumbers, nil
+}
+
+// updateSlabTotals grava quantidade e distribuição de chapas (derivadas das chapas quando rastreado)
+// e ajusta o status do lote
+func (s *batchService) updateSlabTotals(ctx context.Context, tx *sql.Tx, batch *entity.Batch, available, reserved, sold, inactive int) error {
+	available, reserved, sold, inactive, err := s.slabs.derive(ctx, tx, batch.ID, available, reserved, sold, inactive)
+	if err != nil {
+		return err
+	}
+
+	quantity := available + reserved + sold + inactive
+	if err := s.batchRepo.UpdateSlabTotals(ctx, tx, batch.ID, quantity, available, reserved, sold, inactive); err != nil {
+		return err
+	}
+
+	newStatus := deriveBatchStatus(available, reserved, sold, inactive)
+	if newStatus != batch.Status {
+		if err := s.batchRepo.UpdateStatus(ctx, tx, batch.ID, newStatus); err != nil {
+			return err
+		}
+	}
+
+	batch.QuantitySlabs = quantity
+	batch.AvailableSlabs, batch.ReservedSlabs, batch.SoldSlabs, batch.InactiveSlabs = available, reserved, sold, inactive
+	batch.Status = newStatus
+	return nil
+}
+
+func (s *batchService) GetLineage(ctx context.Context, id string) (*entity.BatchLineageResponse, error) {
+	if _, err := s.batchRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.lineageRepo.FindByBatchID(ctx, id)
+}
@@ -3,12 +3,15 @@ package service
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
 	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
 	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	domainService "github.com/thiagomes07/CAVA/backend/internal/domain/service"
 	"go.uber.org/zap"
 )
 
@@ -17,14 +20,31 @@ type BatchDB interface {
 	ExecuteInTx(ctx context.Context, fn func(*sql.Tx) error) error
 }
 
+// BatchAvailabilityAdjuster ajusta a disponibilidade do lote dentro da transação do chamador
+type BatchAvailabilityAdjuster interface {
+	UpdateAvailabilityInTx(ctx context.Context, tx *sql.Tx, id, userID string, input entity.UpdateBatchAvailabilityInput) (int, error)
+}
+
 type batchService struct {
-	batchRepo   repository.BatchRepository
-	productRepo repository.ProductRepository
-	mediaRepo   repository.MediaRepository
-	salesRepo   repository.SalesHistoryRepository
-	clienteRepo repository.ClienteRepository
-	db          BatchDB
-	logger      *zap.Logger
+	batchRepo          repository.BatchRepository
+	productRepo        repository.ProductRepository
+	mediaRepo          repository.MediaRepository
+	salesRepo          repository.SalesHistoryRepository
+	clienteRepo        repository.ClienteRepository
+	slabRepo           repository.SlabRepository
+	moveRepo           repository.BatchMovementRepository
+	industryRepo       repository.IndustryRepository
+	codeSeqRepo        repository.BatchCodeSequenceRepository
+	lineageRepo        repository.BatchLineageRepository
+	blockRepo          repository.BlockRepository
+	warehouseRepo      repository.WarehouseRepository
+	transferRepo       repository.BatchTransferRepository
+	priceRepo          repository.PriceHistoryRepository
+	packingListService domainService.PackingListService
+	waitlist           WaitlistProcessor
+	slabs              slabTracker
+	db                 BatchDB
+	logger             *zap.Logger
 }
 
 func NewBatchService(
@@ -33,26 +53,100 @@ func NewBatchService(
 	mediaRepo repository.MediaRepository,
 	salesRepo repository.SalesHistoryRepository,
 	clienteRepo repository.ClienteRepository,
+	slabRepo repository.SlabRepository,
+	moveRepo repository.BatchMovementRepository,
+	industryRepo repository.IndustryRepository,
+	codeSeqRepo repository.BatchCodeSequenceRepository,
+	lineageRepo repository.BatchLineageRepository,
+	blockRepo repository.BlockRepository,
+	warehouseRepo repository.WarehouseRepository,
+	transferRepo repository.BatchTransferRepository,
+	priceRepo repository.PriceHistoryRepository,
+	packingListService domainService.PackingListService,
+	waitlist WaitlistProcessor,
 	db BatchDB,
 	logger *zap.Logger,
 ) *batchService {
 	return &batchService{
-		batchRepo:   batchRepo,
-		productRepo: productRepo,
-		mediaRepo:   mediaRepo,
-		salesRepo:   salesRepo,
-		clienteRepo: clienteRepo,
-		db:          db,
-		logger:      logger,
+		batchRepo:          batchRepo,
+		productRepo:        productRepo,
+		mediaRepo:          mediaRepo,
+		salesRepo:          salesRepo,
+		clienteRepo:        clienteRepo,
+		slabRepo:           slabRepo,
+		moveRepo:           moveRepo,
+		industryRepo:       industryRepo,
+		codeSeqRepo:        codeSeqRepo,
+		lineageRepo:        lineageRepo,
+		blockRepo:          blockRepo,
+		warehouseRepo:      warehouseRepo,
+		transferRepo:       transferRepo,
+		priceRepo:          priceRepo,
+		packingListService: packingListService,
+		waitlist:           waitlist,
+		slabs:              slabTracker{slabRepo: slabRepo, movementRepo: moveRepo},
+		db:                 db,
+		logger:             logger,
 	}
 }
 
 func (s *batchService) Create(ctx context.Context, industryID string, input entity.CreateBatchInput) (*entity.Batch, error) {
-	var productID string
+	draft, err := s.prepareBatch(ctx, industryID, input)
+	if err != nil {
+		return nil, err
+	}
+	batch, product := draft.batch, draft.product
+
+	// Produto inline, código automático, lote, chapas e entrada no razão são gravados atomicamente
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		if product != nil {
+			if err := s.productRepo.Create(ctx, tx, product); err != nil {
+				s.logger.Error("erro ao criar produto inline", zap.Error(err))
+				return err
+			}
+			s.logger.Info("produto criado inline",
+				zap.String("productId", product.ID),
+				zap.String("productName", product.Name),
+			)
+		}
+		return s.persistBatch(ctx, tx, draft)
+	})
+	if err != nil {
+		s.logger.Error("erro ao criar lote",
+			zap.String("industryId", industryID),
+			zap.String("batchCode", batch.BatchCode),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("lote criado com sucesso",
+		zap.String("batchId", batch.ID),
+		zap.String("batchCode", batch.BatchCode),
+		zap.Float64("totalArea", batch.TotalArea),
+		zap.String("priceUnit", string(batch.PriceUnit)),
+		zap.Int("quantitySlabs", batch.QuantitySlabs),
+	)
+
+	return batch, nil
+}
+
+// batchDraft é um lote validado e pronto para ser gravado
+type batchDraft struct {
+	batch      *entity.Batch
+	product    *entity.Product // produto inline a criar (nil = produto existente)
+	codePrefix string          // prefixo do código automático quando BatchCode não foi informado
+}
 
-	// Se NewProduct é fornecido, cria o produto inline
+// prepareBatch valida o input e monta o lote (e o produto inline, quando houver) sem persistir
+func (s *batchService) prepareBatch(ctx context.Context, industryID string, input entity.CreateBatchInput) (*batchDraft, error) {
+	var product *entity.Product
+	var productID, productName string
+	var material entity.MaterialType
+
+	// Se NewProduct é fornecido, o produto será criado inline
 	if input.NewProduct != nil {
-		product := &entity.Product{
+		product = &entity.Product{
 			ID:          uuid.New().String(),
 			IndustryID:  industryID,
 			Name:        input.NewProduct.Name,
@@ -65,42 +159,71 @@ func (s *batchService) Create(ctx context.Context, industryID string, input enti
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
 		}
-
-		if err := s.productRepo.Create(ctx, product); err != nil {
-			s.logger.Error("erro ao criar produto inline", zap.Error(err))
-			return nil, err
-		}
 		productID = product.ID
-		s.logger.Info("produto criado inline",
-			zap.String("productId", product.ID),
-			zap.String("productName", product.Name),
-		)
+		productName, material = product.Name, product.Material
 	} else {
+		if input.ProductID == nil {
+			return nil, domainErrors.ValidationError("ProductID é obrigatório quando não há novo produto")
+		}
 		// Validar que produto existe e pertence à indústria
-		product, err := s.productRepo.FindByID(ctx, *input.ProductID)
+		existing, err := s.productRepo.FindByID(ctx, *input.ProductID)
 		if err != nil {
 			return nil, err
 		}
-		if product.IndustryID != industryID {
+		if existing.IndustryID != industryID {
 			return nil, domainErrors.ForbiddenError()
 		}
 		productID = *input.ProductID
+		productName, material = existing.Name, existing.Material
 	}
 
-	// Validar e formatar batch code
-	batchCode, err := entity.NewBatchCode(input.BatchCode)
-	if err != nil {
-		return nil, domainErrors.ValidationError(err.Error())
+	draft := &batchDraft{product: product}
+
+	// Bloco de origem: a pedreira do bloco preenche a origem quando não informada
+	originQuarry := input.OriginQuarry
+	if input.BlockID != nil {
+		block, err := s.findBlock(ctx, industryID, *input.BlockID)
+		if err != nil {
+			return nil, err
+		}
+		if originQuarry == nil {
+			originQuarry = &block.QuarryName
+		}
 	}
 
-	// Verificar se código de lote já existe na indústria
-	exists, err := s.batchRepo.ExistsByCode(ctx, industryID, batchCode.String())
-	if err != nil {
-		s.logger.Error("erro ao verificar código de lote existente", zap.Error(err))
-		return nil, domainErrors.InternalError(err)
+	// Alocação inicial em depósito/posição
+	if input.LocationID != nil && *input.LocationID == "" {
+		input.LocationID = nil
+	}
+	if _, err := s.resolveLocation(ctx, industryID, input.WarehouseID, input.LocationID); err != nil {
+		return nil, err
 	}
-	if exists {
-		return nil, domainErrors.BatchCodeExistsError(batchCode.String())
+
+	var batchCode entity.BatchCode
+	if strings.TrimSpace(input.BatchCode) != "" {
+		// Validar e formatar batch code
+		code, err := entity.NewBatchCode(input.BatchCode)
+		if err != nil {
+			return nil, domainErrors.ValidationError(err.Error())
+		}
+
+		// Verificar se código de lote já existe na indústria
+		exists, err := s.batchRepo.ExistsByCode(ctx, nil, industryID, code.String())
+		if err != nil {
+			s.logger.Error("erro ao verificar código de lote existente", zap.Error(err))
+			return nil, domainErrors.InternalError(err)
+		}
+		if exists {
+			return nil, domainErrors.BatchCodeExistsError(code.String())
+		}
+		batchCode = code
+	} else {
+		// Código gerado na gravação a partir da sequência da indústria
+		prefix, err := s.batchCodePrefix(ctx, industryID, productName, material)
+		if err != nil {
+			return nil, err
+		}
+		draft.codePrefix = prefix
 	}
 
 	// Validar dimensões
@@ -143,7 +266,6 @@ func (s *batchService) Create(ctx context.Context, industryID string, input enti
 		return nil, domainErrors.ValidationError("Data de entrada não pode ser futura")
 	}
 
-	// Criar lote
 	batch := &entity.Batch{
 		ID:             uuid.New().String(),
 		ProductID:      productID,
@@ -159,7 +281,11 @@ func (s *batchService) Create(ctx context.Context, industryID string, input enti
 		InactiveSlabs:  0,
 		IndustryPrice:  input.IndustryPrice,
 		PriceUnit:      priceUnit,
-		OriginQuarry:   input.OriginQuarry,
+		Currency:       input.Currency.OrDefault(),
+		OriginQuarry:   originQuarry,
+		BlockID:        input.BlockID,
+		WarehouseID:    input.WarehouseID,
+		LocationID:     input.LocationID,
 		EntryDate:      entryDate,
 		Status:         entity.BatchStatusDisponivel,
 		IsActive:       true,
@@ -171,24 +297,58 @@ func (s *batchService) Create(ctx context.Context, industryID string, input enti
 	// Calcular área total
 	batch.CalculateTotalArea()
 
-	if err := s.batchRepo.Create(ctx, batch); err != nil {
-		s.logger.Error("erro ao criar lote",
-			zap.String("industryId", industryID),
-			zap.String("batchCode", batch.BatchCode),
-			zap.Error(err),
-		)
-		return nil, err
+	draft.batch = batch
+	return draft, nil
+}
+
+// persistBatch gera o código automático (se necessário), grava o lote, gera as chapas
+// numeradas (1..N) e registra a entrada no razão
+func (s *batchService) persistBatch(ctx context.Context, tx *sql.Tx, draft *batchDraft) error {
+	batch := draft.batch
+	if batch.BatchCode == "" {
+		code, err := s.nextBatchCode(ctx, tx, batch.IndustryID, draft.codePrefix)
+		if err != nil {
+			return err
+		}
+		batch.BatchCode = code.String()
 	}
 
-	s.logger.Info("lote criado com sucesso",
-		zap.String("batchId", batch.ID),
-		zap.String("batchCode", batch.BatchCode),
-		zap.Float64("totalArea", batch.TotalArea),
-		zap.String("priceUnit", string(batch.PriceUnit)),
-		zap.Int("quantitySlabs", batch.QuantitySlabs),
-	)
+	if err := s.batchRepo.Create(ctx, tx, batch); err != nil {
+		return err
+	}
 
-	return batch, nil
+	if err := s.slabs.generate(ctx, tx, batch, 1, batch.QuantitySlabs, entity.BatchStatusDisponivel); err != nil {
+		return err
+	}
+
+	available := entity.BatchStatusDisponivel
+	err := s.slabs.record(ctx, tx, entity.BatchMovement{
+		BatchID:  batch.ID,
+		ToStatus: &available,
+		Quantity: batch.QuantitySlabs,
+		Reason:   entity.MovementReasonEntrada,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Preço de entrada abre o histórico de preços do lote
+	err = recordAppliedPrice(ctx, s.priceRepo, tx, &entity.PriceChange{
+		IndustryID: batch.IndustryID,
+		BatchID:    &batch.ID,
+		Price:      batch.IndustryPrice,
+		PriceUnit:  batch.PriceUnit,
+	})
+	if err != nil || batch.WarehouseID == nil {
+		return err
+	}
+
+	// Primeira alocação entra no histórico de transferências
+	return s.recordTransfer(ctx, tx, &entity.BatchTransfer{
+		BatchID:       batch.ID,
+		ToWarehouseID: batch.WarehouseID,
+		ToLocationID:  batch.LocationID,
+	}, "")
 }
 
 func (s *batchService) GetByID(ctx context.Context, id string) (*entity.Batch, error) {
@@ -220,6 +380,22 @@ func (s *batchService) GetByID(ctx context.Context, id string) (*entity.Batch, e
 	}
 	batch.Medias = medias
 
+	// Buscar chapas individuais
+	slabs, err := s.loadSlabs(ctx, id)
+	if err != nil {
+		s.logger.Warn("erro ao buscar chapas do lote",
+			zap.String("batchId", id),
+			zap.Error(err),
+		)
+		slabs = []entity.Slab{}
+	}
+	batch.Slabs = slabs
+
+	// Localização física (depósito/posição)
+	located := []entity.Batch{*batch}
+	s.locateBatches(ctx, batch.IndustryID, located)
+	batch.Location = located[0].Location
+
 	return batch, nil
 }
 
@@ -261,6 +437,7 @@ func (s *batchService) List(ctx context.Context, industryID string, filters enti
 		}
 		batches[i].Medias = medias
 	}
+	s.locateBatches(ctx, industryID, batches)
 
 	return &entity.BatchListResponse{
 		Batches: batches,
@@ -269,110 +446,284 @@ func (s *batchService) List(ctx context.Context, industryID string, filters enti
 	}, nil
 }
 
-func (s *batchService) Update(ctx context.Context, id string, input entity.UpdateBatchInput) (*entity.Batch, error) {
-	// Buscar lote
-	batch, err := s.batchRepo.FindByID(ctx, id)
+// batchCodePrefix resolve o prefixo do código automático conforme a configuração da indústria
+func (s *batchService) batchCodePrefix(ctx context.Context, industryID, productName string, material entity.MaterialType) (string, error) {
+	industry, err := s.industryRepo.FindByID(ctx, industryID)
+	if err != nil {
+		return "", err
+	}
+
+	settings := industry.BatchCodeSettings
+	if settings.PrefixSource == entity.BatchCodePrefixMaterial && material == "" {
+		return "", domainErrors.ValidationError("Informe o produto ou o material para gerar o código do lote")
+	}
+	if settings.PrefixSource == entity.BatchCodePrefixProduto && productName == "" && material == "" {
+		return "", domainErrors.ValidationError("Informe o produto para gerar o código do lote")
+	}
+
+	return entity.ResolveBatchCodePrefix(settings, productName, material), nil
+}
+
+// nextBatchCode consome o próximo número da sequência do prefixo, pulando códigos já
+// cadastrados manualmente
+func (s *batchService) nextBatchCode(ctx context.Context, tx *sql.Tx, industryID, prefix string) (entity.BatchCode, error) {
+	for {
+		sequence, err := s.codeSeqRepo.Next(ctx, tx, industryID, prefix)
+		if err != nil {
+			return "", err
+		}
+
+		code := entity.FormatBatchCode(prefix, sequence)
+		exists, err := s.batchRepo.ExistsByCode(ctx, tx, industryID, code.String())
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return code, nil
+		}
+	}
+}
+
+func (s *batchService) PreviewNextCode(ctx context.Context, industryID string, input entity.NextBatchCodeInput) (*entity.NextBatchCodeResponse, error) {
+	var productName string
+	var material entity.MaterialType
+
+	if input.ProductID != nil {
+		product, err := s.productRepo.FindByID(ctx, *input.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if product.IndustryID != industryID {
+			return nil, domainErrors.ForbiddenError()
+		}
+		productName, material = product.Name, product.Material
+	} else {
+		if input.ProductName != nil {
+			productName = *input.ProductName
+		}
+		if input.Material != nil {
+			material = *input.Material
+		}
+	}
+
+	industry, err := s.industryRepo.FindByID(ctx, industryID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := s.batchCodePrefix(ctx, industryID, productName, material)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := s.codeSeqRepo.Peek(ctx, industryID, prefix)
 	if err != nil {
 		return nil, err
 	}
 
+	// Pular códigos já cadastrados manualmente (mesma regra da geração)
+	for sequence <= entity.BatchCodeMaxSequence {
+		exists, err := s.batchRepo.ExistsByCode(ctx, nil, industryID, entity.FormatBatchCode(prefix, sequence).String())
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			break
+		}
+		sequence++
+	}
+	if sequence > entity.BatchCodeMaxSequence {
+		return nil, domainErrors.ValidationError("Sequência de códigos de lote esgotada para o prefixo " + prefix)
+	}
+
+	return &entity.NextBatchCodeResponse{
+		BatchCode:    entity.FormatBatchCode(prefix, sequence).String(),
+		Prefix:       prefix,
+		Sequence:     sequence,
+		PrefixSource: industry.BatchCodeSettings.PrefixSource,
+	}, nil
+}
+
+func (s *batchService) Export(ctx context.Context, industryID string, filters entity.BatchFilters, fn func(*entity.Batch) error) error {
+	if err := s.batchRepo.Stream(ctx, industryID, filters, fn); err != nil {
+		s.logger.Error("erro ao exportar lotes",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (s *batchService) Update(ctx context.Context, id string, input entity.UpdateBatchInput) (*entity.Batch, error) {
 	dimensionsChanged := false
+	quantityDelta := 0
 
-	// Atualizar campos fornecidos
-	if input.BatchCode != nil {
-		batchCode, err := entity.NewBatchCode(*input.BatchCode)
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		// Buscar lote com lock para que contadores, chapas e movimentações mudem juntos
+		batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, id)
 		if err != nil {
-			return nil, domainErrors.ValidationError(err.Error())
+			return err
 		}
 
-		// Verificar se código já existe (se mudou)
-		if batchCode.String() != batch.BatchCode {
-			exists, err := s.batchRepo.ExistsByCode(ctx, batch.IndustryID, batchCode.String())
+		// Atualizar campos fornecidos
+		if input.BatchCode != nil {
+			batchCode, err := entity.NewBatchCode(*input.BatchCode)
 			if err != nil {
-				s.logger.Error("erro ao verificar código de lote", zap.Error(err))
-				return nil, domainErrors.InternalError(err)
+				return domainErrors.ValidationError(err.Error())
 			}
-			if exists {
-				return nil, domainErrors.BatchCodeExistsError(batchCode.String())
+
+			// Verificar se código já existe (se mudou)
+			if batchCode.String() != batch.BatchCode {
+				exists, err := s.batchRepo.ExistsByCode(ctx, tx, batch.IndustryID, batchCode.String())
+				if err != nil {
+					s.logger.Error("erro ao verificar código de lote", zap.Error(err))
+					return domainErrors.InternalError(err)
+				}
+				if exists {
+					return domainErrors.BatchCodeExistsError(batchCode.String())
+				}
 			}
+
+			batch.BatchCode = batchCode.String()
 		}
 
-		batch.BatchCode = batchCode.String()
-	}
+		if input.Height != nil {
+			if *input.Height <= 0 || *input.Height > 1000 {
+				return domainErrors.ValidationError("Altura deve estar entre 0 e 1000 cm")
+			}
+			batch.Height = *input.Height
+			dimensionsChanged = true
+		}
 
-	if input.Height != nil {
-		if *input.Height <= 0 || *input.Height > 1000 {
-			return nil, domainErrors.ValidationError("Altura deve estar entre 0 e 1000 cm")
+		if input.Width != nil {
+			if *input.Width <= 0 || *input.Width > 1000 {
+				return domainErrors.ValidationError("Largura deve estar entre 0 e 1000 cm")
+			}
+			batch.Width = *input.Width
+			dimensionsChanged = true
 		}
-		batch.Height = *input.Height
-		dimensionsChanged = true
-	}
 
-	if input.Width != nil {
-		if *input.Width <= 0 || *input.Width > 1000 {
-			return nil, domainErrors.ValidationError("Largura deve estar entre 0 e 1000 cm")
+		if input.Thickness != nil {
+			if *input.Thickness <= 0 || *input.Thickness > 100 {
+				return domainErrors.ValidationError("Espessura deve estar entre 0 e 100 cm")
+			}
+			batch.Thickness = *input.Thickness
+			dimensionsChanged = true
 		}
-		batch.Width = *input.Width
-		dimensionsChanged = true
-	}
 
-	if input.Thickness != nil {
-		if *input.Thickness <= 0 || *input.Thickness > 100 {
-			return nil, domainErrors.ValidationError("Espessura deve estar entre 0 e 100 cm")
+		if input.QuantitySlabs != nil {
+			if *input.QuantitySlabs <= 0 {
+				return domainErrors.ValidationError("Quantidade de chapas deve ser maior que 0")
+			}
+			// Ajustar available_slabs proporcionalmente se quantity_slabs mudar
+			newQuantity := *input.QuantitySlabs
+			unavailable := batch.ReservedSlabs + batch.SoldSlabs + batch.InactiveSlabs
+			if newQuantity < unavailable {
+				return domainErrors.ValidationError("Quantidade não pode ser menor que chapas já reservadas/vendidas/inativas")
+			}
+			// Recalcular disponíveis com base na nova quantidade
+			batch.AvailableSlabs = newQuantity - unavailable
+			quantityDelta = newQuantity - batch.QuantitySlabs
+			batch.QuantitySlabs = *input.QuantitySlabs
+			dimensionsChanged = true
 		}
-		batch.Thickness = *input.Thickness
-		dimensionsChanged = true
-	}
 
-	if input.QuantitySlabs != nil {
-		if *input.QuantitySlabs <= 0 {
-			return nil, domainErrors.ValidationError("Quantidade de chapas deve ser maior que 0")
+		previousPrice, previousUnit := batch.IndustryPrice, batch.PriceUnit
+
+		if input.IndustryPrice != nil {
+			if *input.IndustryPrice <= 0 {
+				return domainErrors.ValidationError("Preço deve ser maior que 0")
+			}
+			batch.IndustryPrice = *input.IndustryPrice
 		}
-		// Ajustar available_slabs proporcionalmente se quantity_slabs mudar
-		newQuantity := *input.QuantitySlabs
-		unavailable := batch.ReservedSlabs + batch.SoldSlabs + batch.InactiveSlabs
-		if newQuantity < unavailable {
-			return nil, domainErrors.ValidationError("Quantidade não pode ser menor que chapas já reservadas/vendidas/inativas")
+
+		if input.PriceUnit != nil {
+			if !input.PriceUnit.IsValid() {
+				return domainErrors.ValidationError("Unidade de preço inválida. Use M2 ou FT2")
+			}
+			batch.PriceUnit = *input.PriceUnit
 		}
-		// Recalcular disponíveis com base na nova quantidade
-		batch.AvailableSlabs = newQuantity - unavailable
-		batch.QuantitySlabs = *input.QuantitySlabs
-		dimensionsChanged = true
-	}
 
-	if input.IndustryPrice != nil {
-		if *input.IndustryPrice <= 0 {
-			return nil, domainErrors.ValidationError("Preço deve ser maior que 0")
+		if input.Currency != nil {
+			batch.Currency = *input.Currency
 		}
-		batch.IndustryPrice = *input.IndustryPrice
-	}
 
-	if input.PriceUnit != nil {
-		if !input.PriceUnit.IsValid() {
-			return nil, domainErrors.ValidationError("Unidade de preço inválida. Use M2 ou FT2")
+		if input.OriginQuarry != nil {
+			batch.OriginQuarry = input.OriginQuarry
 		}
-		batch.PriceUnit = *input.PriceUnit
-	}
 
-	if input.OriginQuarry != nil {
-		batch.OriginQuarry = input.OriginQuarry
-	}
+		if input.BlockID != nil {
+			if *input.BlockID == "" {
+				batch.BlockID = nil
+			} else {
+				if _, err := s.findBlock(ctx, batch.IndustryID, *input.BlockID); err != nil {
+					return err
+				}
+				batch.BlockID = input.BlockID
+			}
+		}
 
-	if input.IsPublic != nil {
-		batch.IsPublic = *input.IsPublic
-	}
+		if input.IsPublic != nil {
+			batch.IsPublic = *input.IsPublic
+		}
 
-	// Recalcular área se dimensões mudaram
-	if dimensionsChanged {
-		batch.CalculateTotalArea()
-	}
+		// Recalcular área se dimensões mudaram
+		if dimensionsChanged {
+			batch.CalculateTotalArea()
+		}
+
+		batch.UpdatedAt = time.Now()
+
+		// Salvar alterações
+		if err := s.batchRepo.Update(ctx, tx, batch); err != nil {
+			return err
+		}
 
-	batch.UpdatedAt = time.Now()
+		// Alteração direta de preço entra no histórico com vigência imediata
+		if batch.IndustryPrice != previousPrice || batch.PriceUnit != previousUnit {
+			err := recordAppliedPrice(ctx, s.priceRepo, tx, &entity.PriceChange{
+				IndustryID:        batch.IndustryID,
+				BatchID:           &batch.ID,
+				Price:             batch.IndustryPrice,
+				PriceUnit:         batch.PriceUnit,
+				PreviousPrice:     &previousPrice,
+				PreviousPriceUnit: &previousUnit,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if quantityDelta == 0 {
+			return nil
+		}
+
+		// Registrar o ajuste no livro de movimentações junto com a mudança dos contadores
+		available := entity.BatchStatusDisponivel
+		movement := entity.BatchMovement{
+			BatchID: batch.ID,
+			Reason:  entity.MovementReasonAjusteQuantidade,
+		}
+		if quantityDelta > 0 {
+			movement.ToStatus = &available
+			movement.Quantity = quantityDelta
+		} else {
+			movement.FromStatus = &available
+			movement.Quantity = -quantityDelta
+		}
+		if err := s.slabs.record(ctx, tx, movement); err != nil {
+			return err
+		}
 
-	// Salvar alterações
-	if err := s.batchRepo.Update(ctx, batch); err != nil {
+		// Ajustar chapas individuais à nova quantidade
+		return s.resizeSlabs(ctx, tx, batch, quantityDelta)
+	})
+	if err != nil {
 		s.logger.Error("erro ao atualizar lote",
 			zap.String("batchId", id),
+			zap.Int("quantityDelta", quantityDelta),
 			zap.Error(err),
 		)
 		return nil, err
@@ -383,6 +734,11 @@ func (s *batchService) Update(ctx context.Context, id string, input entity.Updat
 		zap.Bool("dimensionsChanged", dimensionsChanged),
 	)
 
+	// Chapas novas atendem primeiro a fila de espera
+	if quantityDelta > 0 {
+		s.waitlist.ProcessWaitlist(ctx, id)
+	}
+
 	// Retornar lote atualizado com dados relacionados
 	return s.GetByID(ctx, id)
 }
@@ -412,20 +768,57 @@ func (s *batchService) UpdateStatus(ctx context.Context, id string, status entit
 	return s.GetByID(ctx, id)
 }
 
-func (s *batchService) UpdateAvailability(ctx context.Context, id string, status entity.BatchStatus, fromStatus *entity.BatchStatus, quantity int) (*entity.Batch, error) {
+func (s *batchService) UpdateAvailability(ctx context.Context, id, userID string, input entity.UpdateBatchAvailabilityInput) (*entity.Batch, error) {
+	var newAvailable int
+
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		newAvailable, err = s.UpdateAvailabilityInTx(ctx, tx, id, userID, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("lote ajustado por quantidade",
+		zap.String("batchId", id),
+		zap.String("status", string(input.Status)),
+		zap.Int("quantity", input.Quantity),
+		zap.Int("availableSlabs", newAvailable),
+	)
+
+	if input.Status == entity.BatchStatusDisponivel {
+		s.waitlist.ProcessWaitlist(ctx, id)
+	}
+
+	return s.GetByID(ctx, id)
+}
+
+// UpdateAvailabilityInTx move chapas entre estados na transação do chamador e retorna as chapas disponíveis resultantes
+func (s *batchService) UpdateAvailabilityInTx(ctx context.Context, tx *sql.Tx, id, userID string, input entity.UpdateBatchAvailabilityInput) (int, error) {
+	status, fromStatus, quantity := input.Status, input.FromStatus, input.Quantity
+
+	reason := entity.MovementReasonAjusteManual
+	if input.Reason != nil {
+		if !input.Reason.IsManual() {
+			return 0, domainErrors.ValidationError("Motivo de ajuste inválido")
+		}
+		reason = *input.Reason
+	}
+
 	if !status.IsValid() {
-		return nil, domainErrors.ValidationError("Status inválido")
+		return 0, domainErrors.ValidationError("Status inválido")
 	}
 	if fromStatus != nil && !fromStatus.IsValid() {
-		return nil, domainErrors.ValidationError("Status de origem inválido")
+		return 0, domainErrors.ValidationError("Status de origem inválido")
 	}
 	if quantity <= 0 {
-		return nil, domainErrors.ValidationError("Quantidade deve ser maior que 0")
+		return 0, domainErrors.ValidationError("Quantidade deve ser maior que 0")
 	}
 
-	batch, err := s.batchRepo.FindByID(ctx, id)
+	batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, id)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	newAvailable := batch.AvailableSlabs
@@ -433,13 +826,15 @@ func (s *batchService) UpdateAvailability(ctx context.Context, id string, status
 	newSold := batch.SoldSlabs
 	newInactive := batch.InactiveSlabs
 
+	var moves []slabMove
+
 	if fromStatus != nil {
 		origin := *fromStatus
 		if origin == status {
-			return nil, domainErrors.ValidationError("Origem e destino são iguais")
+			return 0, domainErrors.ValidationError("Origem e destino são iguais")
 		}
 		if origin == entity.BatchStatusVendido {
-			return nil, domainErrors.ValidationError("Para remover itens vendidos, utiliza a tela de Vendas para desfazer a venda.")
+			return 0, domainErrors.ValidationError("Para remover itens vendidos, utiliza a tela de Vendas para desfazer a venda.")
 		}
 
 		getCount := func(st entity.BatchStatus) int {
@@ -458,7 +853,7 @@ func (s *batchService) UpdateAvailability(ctx context.Context, id string, status
 		}
 
 		if quantity > getCount(origin) {
-			return nil, domainErrors.ValidationError("Quantidade excede chapas na origem")
+			return 0, domainErrors.ValidationError("Quantidade excede chapas na origem")
 		}
 
 		switch origin {
@@ -482,13 +877,15 @@ func (s *batchService) UpdateAvailability(ctx context.Context, id string, status
 		case entity.BatchStatusInativo:
 			newInactive += quantity
 		}
+
+		moves = append(moves, slabMove{From: origin, To: status, Quantity: quantity})
 	} else if status == entity.BatchStatusDisponivel {
 		totalNonAvailable := batch.ReservedSlabs + batch.SoldSlabs + batch.InactiveSlabs
 		if totalNonAvailable <= 0 {
-			return nil, domainErrors.ValidationError("Não há chapas indisponíveis para liberar")
+			return 0, domainErrors.ValidationError("Não há chapas indisponíveis para liberar")
 		}
 		if quantity > totalNonAvailable {
-			return nil, domainErrors.ValidationError("Quantidade excede chapas indisponíveis")
+			return 0, domainErrors.ValidationError("Quantidade excede chapas indisponíveis")
 		}
 		newAvailable = batch.AvailableSlabs + quantity
 		remaining := quantity
@@ -496,20 +893,23 @@ func (s *batchService) UpdateAvailability(ctx context.Context, id string, status
 			take := minInt(remaining, newInactive)
 			newInactive -= take
 			remaining -= take
+			moves = append(moves, slabMove{From: entity.BatchStatusInativo, To: status, Quantity: take})
 		}
 		if remaining > 0 {
 			take := minInt(remaining, newReserved)
 			newReserved -= take
 			remaining -= take
+			moves = append(moves, slabMove{From: entity.BatchStatusReservado, To: status, Quantity: take})
 		}
 		if remaining > 0 {
 			take := minInt(remaining, newSold)
 			newSold -= take
 			remaining -= take
+			moves = append(moves, slabMove{From: entity.BatchStatusVendido, To: status, Quantity: take})
 		}
 	} else {
 		if quantity > batch.AvailableSlabs {
-			return nil, domainErrors.ValidationError("Quantidade excede chapas disponíveis")
+			return 0, domainErrors.ValidationError("Quantidade excede chapas disponíveis")
 		}
 		newAvailable = batch.AvailableSlabs - quantity
 		switch status {
@@ -520,37 +920,45 @@ func (s *batchService) UpdateAvailability(ctx context.Context, id string, status
 		case entity.BatchStatusInativo:
 			newInactive += quantity
 		}
+		moves = append(moves, slabMove{From: entity.BatchStatusDisponivel, To: status, Quantity: quantity})
 	}
 
-	newStatus := deriveBatchStatus(newAvailable, newReserved, newSold, newInactive)
+	// Movimentar chapas individuais e registrar no razão
+	for _, m := range moves {
+		m.Reason = reason
+		m.ActorID = &userID
+		m.Notes = input.Notes
+		if _, err := s.slabs.move(ctx, tx, id, m); err != nil {
+			return 0, err
+		}
+	}
 
-	if err := s.batchRepo.UpdateSlabCounts(ctx, nil, id, newAvailable, newReserved, newSold, newInactive); err != nil {
+	newAvailable, newReserved, newSold, newInactive, err = s.slabs.derive(ctx, tx, id, newAvailable, newReserved, newSold, newInactive)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.batchRepo.UpdateSlabCounts(ctx, tx, id, newAvailable, newReserved, newSold, newInactive); err != nil {
 		s.logger.Error("erro ao ajustar chapas do lote",
 			zap.String("batchId", id),
 			zap.Error(err),
 		)
-		return nil, err
+		return 0, err
 	}
 
+	newStatus := deriveBatchStatus(newAvailable, newReserved, newSold, newInactive)
 	if newStatus != batch.Status {
-		if err := s.batchRepo.UpdateStatus(ctx, nil, id, newStatus); err != nil {
+		if err := s.batchRepo.UpdateStatus(ctx, tx, id, newStatus); err != nil {
 			s.logger.Error("erro ao ajustar status do lote",
 				zap.String("batchId", id),
 				zap.String("status", string(newStatus)),
 				zap.Error(err),
 			)
-			return nil, err
+			return 0, err
 		}
 	}
 
-	s.logger.Info("lote ajustado por quantidade",
-		zap.String("batchId", id),
-		zap.String("status", string(status)),
-		zap.Int("quantity", quantity),
-		zap.Int("availableSlabs", newAvailable),
-	)
-
-	return s.GetByID(ctx, id)
+	return newAvailable, nil
 }
 
 func (s *batchService) CheckAvailability(ctx context.Context, id string) (bool, error) {
@@ -604,6 +1012,18 @@ func (s *batchService) AddMedias(ctx context.Context, batchID string, medias []e
 	return nil
 }
 
+// findBlock busca o bloco de pedreira garantindo que pertence à indústria do lote
+func (s *batchService) findBlock(ctx context.Context, industryID, blockID string) (*entity.Block, error) {
+	block, err := s.blockRepo.FindByID(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	if block.IndustryID != industryID {
+		return nil, domainErrors.ValidationError("Bloco não pertence à indústria")
+	}
+	return block, nil
+}
+
 func minInt(a, b int) int {
 	if a < b {
 		return a
@@ -662,6 +1082,7 @@ func (s *batchService) Sell(ctx context.Context, userID string, input entity.Cre
 	}
 
 	var updatedBatch *entity.Batch
+	var saleID string
 
 	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
 		// 1. Lock no Batch
@@ -671,6 +1092,9 @@ func (s *batchService) Sell(ctx context.Context, userID string, input entity.Cre
 		}
 
 		// 2. Verificar disponibilidade
+		if len(input.SlabNumbers) > 0 && len(input.SlabNumbers) != input.QuantitySlabsSold {
+			return domainErrors.ValidationError("Quantidade vendida deve corresponder às chapas informadas")
+		}
 		if !batch.HasAvailableSlabs(input.QuantitySlabsSold) {
 			return domainErrors.InsufficientSlabsError(input.QuantitySlabsSold, batch.AvailableSlabs)
 		}
@@ -687,25 +1111,25 @@ func (s *batchService) Sell(ctx context.Context, userID string, input entity.Cre
 			if input.NewClient.Email != "" {
 				email = &input.NewClient.Email
 			}
-		if input.NewClient.Phone != "" {
-			phone = &input.NewClient.Phone
-		}
-		newCliente := &entity.Cliente{
-			ID:             uuid.New().String(),
-			SalesLinkID:    "", // Será convertido para NULL pelo NULLIF no repositório
-			Name:           input.NewClient.Name,
-			Email:          email,
-			Phone:          phone,
-			MarketingOptIn: false,
-			CreatedAt:      time.Now(),
-			UpdatedAt:      time.Now(),
-		}
+			if input.NewClient.Phone != "" {
+				phone = &input.NewClient.Phone
+			}
+			newCliente := &entity.Cliente{
+				ID:             uuid.New().String(),
+				SalesLinkID:    "", // Será convertido para NULL pelo NULLIF no repositório
+				Name:           input.NewClient.Name,
+				Email:          email,
+				Phone:          phone,
+				MarketingOptIn: false,
+				CreatedAt:      time.Now(),
+				UpdatedAt:      time.Now(),
+			}
 
-		if err := s.clienteRepo.Create(ctx, tx, newCliente); err != nil {
-			s.logger.Error("erro ao criar cliente na venda", zap.Error(err))
-			return err
-		}
-		clienteID = &newCliente.ID
+			if err := s.clienteRepo.Create(ctx, tx, newCliente); err != nil {
+				s.logger.Error("erro ao criar cliente na venda", zap.Error(err))
+				return err
+			}
+			clienteID = &newCliente.ID
 			customerName = newCliente.Name
 			if newCliente.Email != nil && *newCliente.Email != "" {
 				customerContact = *newCliente.Email
@@ -721,6 +1145,12 @@ func (s *batchService) Sell(ctx context.Context, userID string, input entity.Cre
 			soldByUserIDForSale = input.SoldByUserID
 		}
 
+		// Moeda da venda: informada ou a do preço do lote
+		currency := input.Currency
+		if currency == "" {
+			currency = batch.Currency.OrDefault()
+		}
+
 		sale := &entity.Sale{
 			ID:                uuid.New().String(),
 			BatchID:           batch.ID,
@@ -735,6 +1165,7 @@ func (s *batchService) Sell(ctx context.Context, userID string, input entity.Cre
 			PricePerUnit:      input.PricePerUnit,
 			PriceUnit:         input.PriceUnit,
 			SalePrice:         input.SalePrice,
+			Currency:          currency,
 			BrokerCommission:  input.BrokerCommission,
 			NetIndustryValue:  input.NetIndustryValue,
 			InvoiceURL:        input.InvoiceURL,
@@ -747,17 +1178,34 @@ func (s *batchService) Sell(ctx context.Context, userID string, input entity.Cre
 			s.logger.Error("erro ao criar registro de venda", zap.Error(err))
 			return err
 		}
+		saleID = sale.ID
+
+		// 5. Marcar chapas vendidas (específicas ou as de menor número)
+		if _, err := s.slabs.move(ctx, tx, batch.ID, slabMove{
+			From:     entity.BatchStatusDisponivel,
+			To:       entity.BatchStatusVendido,
+			Quantity: input.QuantitySlabsSold,
+			Numbers:  input.SlabNumbers,
+			SaleID:   &sale.ID,
+			Reason:   entity.MovementReasonVendaDireta,
+			ActorID:  &userID,
+		}); err != nil {
+			return err
+		}
 
-		// 5. Atualizar Lote (Chapas)
-		newAvailable := batch.AvailableSlabs - input.QuantitySlabsSold
-		newSold := batch.SoldSlabs + input.QuantitySlabsSold
+		// 6. Atualizar Lote (Chapas)
+		newAvailable, newReserved, newSold, newInactive, err := s.slabs.derive(ctx, tx, batch.ID,
+			batch.AvailableSlabs-input.QuantitySlabsSold, batch.ReservedSlabs, batch.SoldSlabs+input.QuantitySlabsSold, batch.InactiveSlabs)
+		if err != nil {
+			return err
+		}
 
-		if err := s.batchRepo.UpdateSlabCounts(ctx, tx, batch.ID, newAvailable, batch.ReservedSlabs, newSold, batch.InactiveSlabs); err != nil {
+		if err := s.batchRepo.UpdateSlabCounts(ctx, tx, batch.ID, newAvailable, newReserved, newSold, newInactive); err != nil {
 			return err
 		}
 
-		// 6. Atualizar Status se necessário
-		newStatus := deriveBatchStatus(newAvailable, batch.ReservedSlabs, newSold, batch.InactiveSlabs)
+		// 7. Atualizar Status se necessário
+		newStatus := deriveBatchStatus(newAvailable, newReserved, newSold, newInactive)
 
 		if newStatus != batch.Status {
 			if err := s.batchRepo.UpdateStatus(ctx, tx, batch.ID, newStatus); err != nil {
@@ -779,8 +1227,14 @@ func (s *batchService) Sell(ctx context.Context, userID string, input entity.Cre
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 8. Romaneio da venda (fora da transação)
+	generatePackingListAfterSale(ctx, s.packingListService, s.logger, updatedBatch.IndustryID, saleID, userID, input.Carrier)
 
-	return updatedBatch, err
+	return updatedBatch, nil
 }
 
 func (s *batchService) Archive(ctx context.Context, id string) error {
@@ -793,7 +1247,7 @@ func (s *batchService) Archive(ctx context.Context, id string) error {
 		return domainErrors.ValidationError("Lote já está arquivado")
 	}
 
-	if err := s.batchRepo.Archive(ctx, id); err != nil {
+	if err := s.batchRepo.Archive(ctx, nil, id); err != nil {
 		s.logger.Error("erro ao arquivar lote",
 			zap.String("batchId", id),
 			zap.Error(err),
@@ -839,3 +1293,458 @@ func (s *batchService) Delete(ctx context.Context, id string) error {
 	s.logger.Info("lote deletado permanentemente", zap.String("batchId", id))
 	return nil
 }
+
+// loadSlabs busca as chapas do lote com suas mídias
+func (s *batchService) loadSlabs(ctx context.Context, batchID string) ([]entity.Slab, error) {
+	slabs, err := s.slabRepo.FindByBatchID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range slabs {
+		medias, err := s.mediaRepo.FindSlabMedias(ctx, slabs[i].ID)
+		if err != nil {
+			s.logger.Warn("erro ao buscar mídias da chapa",
+				zap.String("slabId", slabs[i].ID),
+				zap.Error(err),
+			)
+			medias = []entity.Media{}
+		}
+		slabs[i].Medias = medias
+	}
+
+	return slabs, nil
+}
+
+// resizeSlabs ajusta as chapas disponíveis individuais quando a quantidade do lote muda
+func (s *batchService) resizeSlabs(ctx context.Context, tx *sql.Tx, batch *entity.Batch, delta int) error {
+	tracked, err := s.slabs.tracked(ctx, tx, batch.ID)
+	if err != nil || !tracked {
+		return err
+	}
+
+	if delta > 0 {
+		maxNumber, err := s.slabRepo.MaxSlabNumber(ctx, tx, batch.ID)
+		if err != nil {
+			return err
+		}
+		return s.slabs.generate(ctx, tx, batch, maxNumber+1, delta, entity.BatchStatusDisponivel)
+	}
+
+	// Remover as chapas disponíveis de maior número
+	slabs, err := s.slabRepo.FindByBatchIDForUpdate(ctx, tx, batch.ID)
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for i := len(slabs) - 1; i >= 0 && len(ids) < -delta; i-- {
+		if slabs[i].Status == entity.BatchStatusDisponivel {
+			ids = append(ids, slabs[i].ID)
+		}
+	}
+	if len(ids) < -delta {
+		return domainErrors.ValidationError("Quantidade não pode ser menor que chapas já reservadas/vendidas/inativas")
+	}
+	return s.slabRepo.DeleteByIDs(ctx, tx, ids)
+}
+
+func (s *batchService) ListSlabs(ctx context.Context, batchID string) ([]entity.Slab, error) {
+	if _, err := s.batchRepo.FindByID(ctx, batchID); err != nil {
+		return nil, err
+	}
+
+	return s.loadSlabs(ctx, batchID)
+}
+
+func (s *batchService) InitializeSlabs(ctx context.Context, batchID string) ([]entity.Slab, error) {
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, batchID)
+		if err != nil {
+			return err
+		}
+
+		tracked, err := s.slabs.tracked(ctx, tx, batchID)
+		if err != nil {
+			return err
+		}
+		if tracked {
+			return domainErrors.NewConflictError("Lote já possui chapas cadastradas")
+		}
+
+		// Numeração segue a distribuição atual: disponíveis, reservadas, vendidas e inativas
+		next := 1
+		groups := []struct {
+			status   entity.BatchStatus
+			quantity int
+		}{
+			{entity.BatchStatusDisponivel, batch.AvailableSlabs},
+			{entity.BatchStatusReservado, batch.ReservedSlabs},
+			{entity.BatchStatusVendido, batch.SoldSlabs},
+			{entity.BatchStatusInativo, batch.InactiveSlabs},
+		}
+		for _, g := range groups {
+			if err := s.slabs.generate(ctx, tx, batch, next, g.quantity, g.status); err != nil {
+				return err
+			}
+			next += g.quantity
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("erro ao inicializar chapas do lote",
+			zap.String("batchId", batchID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("chapas do lote inicializadas", zap.String("batchId", batchID))
+
+	return s.loadSlabs(ctx, batchID)
+}
+
+func (s *batchService) UpdateSlab(ctx context.Context, batchID, slabID string, input entity.UpdateSlabInput) (*entity.Slab, error) {
+	slab, err := s.slabRepo.FindByID(ctx, slabID)
+	if err != nil {
+		return nil, err
+	}
+	if slab.BatchID != batchID {
+		return nil, domainErrors.NewNotFoundError("Chapa")
+	}
+
+	if input.Height != nil {
+		slab.Height = *input.Height
+	}
+	if input.Width != nil {
+		slab.Width = *input.Width
+	}
+	if input.Thickness != nil {
+		slab.Thickness = *input.Thickness
+	}
+	if input.Defects != nil {
+		slab.Defects = input.Defects
+	}
+
+	if err := s.slabRepo.Update(ctx, slab); err != nil {
+		s.logger.Error("erro ao atualizar chapa",
+			zap.String("slabId", slabID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	medias, err := s.mediaRepo.FindSlabMedias(ctx, slabID)
+	if err != nil {
+		medias = []entity.Media{}
+	}
+	slab.Medias = medias
+
+	s.logger.Info("chapa atualizada",
+		zap.String("batchId", batchID),
+		zap.String("slabId", slabID),
+		zap.Int("slabNumber", slab.SlabNumber),
+	)
+
+	return slab, nil
+}
+
+func (s *batchService) GetSlab(ctx context.Context, slabID string) (*entity.Slab, error) {
+	return s.slabRepo.FindByID(ctx, slabID)
+}
+
+func (s *batchService) AddSlabMedias(ctx context.Context, slabID string, medias []entity.CreateMediaInput) error {
+	if _, err := s.slabRepo.FindByID(ctx, slabID); err != nil {
+		return err
+	}
+
+	for _, media := range medias {
+		if err := s.mediaRepo.CreateSlabMedia(ctx, slabID, &media); err != nil {
+			s.logger.Error("erro ao adicionar mídia à chapa",
+				zap.String("slabId", slabID),
+				zap.String("url", media.URL),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+
+	s.logger.Info("mídias adicionadas à chapa",
+		zap.String("slabId", slabID),
+		zap.Int("count", len(medias)),
+	)
+
+	return nil
+}
+
+func (s *batchService) ListMovements(ctx context.Context, batchID string, filters entity.BatchMovementFilters) (*entity.BatchMovementListResponse, error) {
+	batch, err := s.batchRepo.FindByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	movements, total, err := s.moveRepo.ListByBatchID(ctx, batchID, filters)
+	if err != nil {
+		s.logger.Error("erro ao listar movimentações do lote",
+			zap.String("batchId", batchID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	ledger, err := s.moveRepo.Balance(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	counters := entity.SlabCounts{
+		Available: batch.AvailableSlabs,
+		Reserved:  batch.ReservedSlabs,
+		Sold:      batch.SoldSlabs,
+		Inactive:  batch.InactiveSlabs,
+	}
+
+	return &entity.BatchMovementListResponse{
+		Movements: movements,
+		Total:     total,
+		Page:      filters.Page,
+		Ledger:    ledger,
+		Counters:  counters,
+		Balanced:  ledger == counters,
+	}, nil
+}
+
+func (s *batchService) Import(ctx context.Context, industryID string, rows []entity.BatchImportRow, dryRun bool) (*entity.BatchImportResult, error) {
+	plans := make([]*batchDraft, len(rows))
+	codeLines := make(map[string]int)
+	newProducts := make(map[string]*entity.Product)
+
+	for i := range rows {
+		row := &rows[i]
+		if !row.IsValid() {
+			continue
+		}
+
+		input := row.Input
+		if err := s.resolveImportProduct(ctx, industryID, row, &input); err != nil {
+			if !addImportError(row, err) {
+				return nil, err
+			}
+			continue
+		}
+
+		// Código repetido dentro da própria planilha (vazio = gerado automaticamente)
+		if code := strings.ToUpper(strings.TrimSpace(input.BatchCode)); code != "" {
+			if line, ok := codeLines[code]; ok {
+				row.AddError(fmt.Sprintf("Código de lote repetido na planilha (linha %d)", line))
+				continue
+			}
+			codeLines[code] = row.Line
+		}
+
+		draft, err := s.prepareBatch(ctx, industryID, input)
+		if err != nil {
+			if !addImportError(row, err) {
+				return nil, err
+			}
+			continue
+		}
+		batch, product := draft.batch, draft.product
+
+		// Produtos novos repetidos na planilha são criados uma única vez
+		if product != nil {
+			key := strings.ToLower(strings.TrimSpace(product.Name))
+			if product.SKU != nil && *product.SKU != "" {
+				key = "sku:" + strings.ToLower(*product.SKU)
+			}
+			if existing, ok := newProducts[key]; ok {
+				product = existing
+			} else {
+				newProducts[key] = product
+			}
+			batch.ProductID = product.ID
+			draft.product = product
+		}
+
+		row.ProductID = &batch.ProductID
+		plans[i] = draft
+	}
+
+	// Prévia dos códigos automáticos (os definitivos são gerados na gravação)
+	if dryRun {
+		if err := s.previewImportCodes(ctx, industryID, rows, plans, codeLines); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &entity.BatchImportResult{
+		DryRun:    dryRun,
+		TotalRows: len(rows),
+		Rows:      rows,
+	}
+	for _, row := range rows {
+		if row.IsValid() {
+			result.ValidRows++
+		} else {
+			result.InvalidRows++
+		}
+	}
+
+	if dryRun {
+		result.CreatedProducts = len(newProducts)
+		return result, nil
+	}
+
+	if result.InvalidRows > 0 {
+		return nil, domainErrors.NewValidationError("Importação contém linhas inválidas", map[string]interface{}{
+			"invalidRows": result.InvalidRows,
+			"rows":        invalidImportRows(rows),
+		})
+	}
+
+	// Todas as linhas são gravadas na mesma transação: ou importa tudo ou nada
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		createdProducts := make(map[string]bool)
+		for i, plan := range plans {
+			if plan == nil {
+				continue
+			}
+			if plan.product != nil && !createdProducts[plan.product.ID] {
+				if err := s.productRepo.Create(ctx, tx, plan.product); err != nil {
+					return err
+				}
+				createdProducts[plan.product.ID] = true
+			}
+
+			if err := s.persistBatch(ctx, tx, plan); err != nil {
+				return err
+			}
+			rows[i].BatchID = &plan.batch.ID
+			rows[i].BatchCode = plan.batch.BatchCode
+		}
+		result.CreatedProducts = len(createdProducts)
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("erro ao importar lotes",
+			zap.String("industryId", industryID),
+			zap.Int("rows", len(rows)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result.Committed = true
+
+	s.logger.Info("lotes importados com sucesso",
+		zap.String("industryId", industryID),
+		zap.Int("batches", result.ValidRows),
+		zap.Int("createdProducts", result.CreatedProducts),
+	)
+
+	return result, nil
+}
+
+// previewImportCodes preenche a prévia dos códigos automáticos das linhas sem código na simulação
+func (s *batchService) previewImportCodes(ctx context.Context, industryID string, rows []entity.BatchImportRow, plans []*batchDraft, taken map[string]int) error {
+	next := make(map[string]int)
+	for i, plan := range plans {
+		if plan == nil || plan.batch.BatchCode != "" {
+			continue
+		}
+
+		sequence, ok := next[plan.codePrefix]
+		if !ok {
+			peek, err := s.codeSeqRepo.Peek(ctx, industryID, plan.codePrefix)
+			if err != nil {
+				return err
+			}
+			sequence = peek
+		}
+
+		for ; sequence <= entity.BatchCodeMaxSequence; sequence++ {
+			code := entity.FormatBatchCode(plan.codePrefix, sequence).String()
+			if _, inFile := taken[code]; inFile {
+				continue
+			}
+			exists, err := s.batchRepo.ExistsByCode(ctx, nil, industryID, code)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				break
+			}
+		}
+		if sequence > entity.BatchCodeMaxSequence {
+			rows[i].AddError("Sequência de códigos de lote esgotada para o prefixo " + plan.codePrefix)
+			continue
+		}
+
+		rows[i].BatchCode = entity.FormatBatchCode(plan.codePrefix, sequence).String()
+		next[plan.codePrefix] = sequence + 1
+	}
+	return nil
+}
+
+// resolveImportProduct decide entre produto existente (por ID ou SKU) e produto novo da planilha
+func (s *batchService) resolveImportProduct(ctx context.Context, industryID string, row *entity.BatchImportRow, input *entity.CreateBatchInput) error {
+	if input.ProductID != nil {
+		return nil
+	}
+
+	if row.ProductSKU != nil {
+		product, err := s.productRepo.FindBySKU(ctx, industryID, *row.ProductSKU)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+		if err == nil {
+			input.ProductID = &product.ID
+			input.NewProduct = nil
+			row.NewProduct = false
+			return nil
+		}
+		if input.NewProduct == nil {
+			return domainErrors.ValidationError(fmt.Sprintf("Produto com SKU %s não encontrado. Informe o nome do produto para criá-lo", *row.ProductSKU))
+		}
+	}
+
+	if input.NewProduct == nil {
+		return domainErrors.ValidationError("Informe productId, productSku ou productName")
+	}
+	if !input.NewProduct.Material.IsValid() {
+		return domainErrors.ValidationError("Material do produto inválido")
+	}
+	if !input.NewProduct.Finish.IsValid() {
+		return domainErrors.ValidationError("Acabamento do produto inválido")
+	}
+
+	return nil
+}
+
+// addImportError registra erros de negócio na linha; retorna false para erros internos
+func addImportError(row *entity.BatchImportRow, err error) bool {
+	appErr, ok := err.(*domainErrors.AppError)
+	if !ok || appErr.StatusCode >= 500 {
+		return false
+	}
+
+	switch appErr.Code {
+	case "BATCH_CODE_EXISTS":
+		row.AddError(fmt.Sprintf("Código de lote %s já existe", row.Input.BatchCode))
+	case "FORBIDDEN":
+		row.AddError("Produto não pertence à indústria")
+	default:
+		row.AddError(appErr.Message)
+	}
+	return true
+}
+
+func invalidImportRows(rows []entity.BatchImportRow) []entity.BatchImportRow {
+	invalid := []entity.BatchImportRow{}
+	for _, row := range rows {
+		if !row.IsValid() {
+			invalid = append(invalid, row)
+		}
+	}
+	return invalid
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"go.uber.org/zap"
+)
+
+func (s *batchService) Transfer(ctx context.Context, id, userID string, input entity.TransferBatchInput) (*entity.Batch, error) {
+	if input.LocationID != nil && *input.LocationID == "" {
+		input.LocationID = nil
+	}
+
+	var transfer *entity.BatchTransfer
+
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if _, err := s.resolveLocation(ctx, batch.IndustryID, &input.WarehouseID, input.LocationID); err != nil {
+			return err
+		}
+
+		if sameID(batch.WarehouseID, &input.WarehouseID) && sameID(batch.LocationID, input.LocationID) {
+			return domainErrors.ValidationError("Lote já está nesta localização")
+		}
+
+		if err := s.batchRepo.UpdateLocation(ctx, tx, batch.ID, &input.WarehouseID, input.LocationID); err != nil {
+			return err
+		}
+
+		transfer = &entity.BatchTransfer{
+			BatchID:         batch.ID,
+			FromWarehouseID: batch.WarehouseID,
+			FromLocationID:  batch.LocationID,
+			ToWarehouseID:   &input.WarehouseID,
+			ToLocationID:    input.LocationID,
+			Notes:           input.Notes,
+		}
+		return s.recordTransfer(ctx, tx, transfer, userID)
+	})
+	if err != nil {
+		s.logger.Error("erro ao transferir lote",
+			zap.String("batchId", id),
+			zap.String("warehouseId", input.WarehouseID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("lote transferido",
+		zap.String("batchId", id),
+		zap.String("transferId", transfer.ID),
+		zap.String("warehouseId", input.WarehouseID),
+	)
+
+	return s.GetByID(ctx, id)
+}
+
+func (s *batchService) ListTransfers(ctx context.Context, id string) ([]entity.BatchTransfer, error) {
+	if _, err := s.batchRepo.FindByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return s.transferRepo.FindByBatchID(ctx, id)
+}
+
+// resolveLocation valida que o depósito pertence à indústria e que a posição pertence ao depósito
+func (s *batchService) resolveLocation(ctx context.Context, industryID string, warehouseID, locationID *string) (*entity.BatchLocation, error) {
+	if warehouseID == nil {
+		if locationID != nil {
+			return nil, domainErrors.ValidationError("Informe o depósito da posição")
+		}
+		return nil, nil
+	}
+
+	warehouse, err := s.warehouseRepo.FindByID(ctx, *warehouseID)
+	if err != nil {
+		return nil, err
+	}
+	if warehouse.IndustryID != industryID {
+		return nil, domainErrors.ValidationError("Depósito não pertence à indústria")
+	}
+	if !warehouse.IsActive {
+		return nil, domainErrors.ValidationError("Depósito inativo")
+	}
+
+	location := &entity.BatchLocation{
+		WarehouseID:   warehouse.ID,
+		WarehouseCode: warehouse.Code,
+		WarehouseName: warehouse.Name,
+	}
+	if locationID == nil {
+		return location, nil
+	}
+
+	for _, l := range warehouse.Locations {
+		if l.ID != *locationID {
+			continue
+		}
+		if !l.IsActive {
+			return nil, domainErrors.ValidationError("Posição inativa")
+		}
+		location.LocationID, location.LocationCode = &l.ID, &l.Code
+		return location, nil
+	}
+
+	return nil, domainErrors.ValidationError("Posição não pertence ao depósito")
+}
+
+// recordTransfer grava a movimentação no histórico de transferências
+func (s *batchService) recordTransfer(ctx context.Context, tx *sql.Tx, transfer *entity.BatchTransfer, userID string) error {
+	transfer.ID = uuid.New().String()
+	if userID != "" {
+		transfer.ActorUserID = &userID
+	}
+	return s.transferRepo.Create(ctx, tx, transfer)
+}
+
+// locateBatches preenche depósito e posição dos lotes a partir dos depósitos da indústria
+func (s *batchService) locateBatches(ctx context.Context, industryID string, batches []entity.Batch) {
+	located := false
+	for i := range batches {
+		if batches[i].WarehouseID != nil {
+			located = true
+			break
+		}
+	}
+	if !located {
+		return
+	}
+
+	warehouses, err := s.warehouseRepo.List(ctx, industryID, true)
+	if err != nil {
+		s.logger.Warn("erro ao buscar depósitos dos lotes",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	byID := make(map[string]*entity.Warehouse, len(warehouses))
+	for i := range warehouses {
+		byID[warehouses[i].ID] = &warehouses[i]
+	}
+
+	for i := range batches {
+		if batches[i].WarehouseID == nil {
+			continue
+		}
+		warehouse, ok := byID[*batches[i].WarehouseID]
+		if !ok {
+			continue
+		}
+		location := &entity.BatchLocation{
+			WarehouseID:   warehouse.ID,
+			WarehouseCode: warehouse.Code,
+			WarehouseName: warehouse.Name,
+		}
+		if batches[i].LocationID != nil {
+			for j := range warehouse.Locations {
+				if warehouse.Locations[j].ID == *batches[i].LocationID {
+					location.LocationID = &warehouse.Locations[j].ID
+					location.LocationCode = &warehouse.Locations[j].Code
+					break
+				}
+			}
+		}
+		batches[i].Location = location
+	}
+}
+
+// sameID compara dois IDs opcionais
+func sameID(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
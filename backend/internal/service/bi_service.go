@@ -38,6 +38,13 @@ func (s *biService) GetDashboard(ctx context.Context, filters entity.BIFilters)
 		),
 	}
 
+	baseCurrency, err := s.biRepo.GetBaseCurrency(ctx, filters.IndustryID)
+	if err != nil {
+		s.logger.Error("erro ao buscar moeda base da indústria", zap.Error(err))
+		return nil, domainErrors.InternalError(err)
+	}
+	dashboard.BaseCurrency = baseCurrency
+
 	var wg sync.WaitGroup
 	var mu sync.Mutex
 	errChan := make(chan error, 7)
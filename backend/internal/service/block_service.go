@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+// maxTraceDepth limita a navegação pela origem dos lotes no rastreio de chapas
+const maxTraceDepth = 20
+
+type blockService struct {
+	blockRepo   repository.BlockRepository
+	batchRepo   repository.BatchRepository
+	slabRepo    repository.SlabRepository
+	lineageRepo repository.BatchLineageRepository
+	logger      *zap.Logger
+}
+
+func NewBlockService(
+	blockRepo repository.BlockRepository,
+	batchRepo repository.BatchRepository,
+	slabRepo repository.SlabRepository,
+	lineageRepo repository.BatchLineageRepository,
+	logger *zap.Logger,
+) *blockService {
+	return &blockService{
+		blockRepo:   blockRepo,
+		batchRepo:   batchRepo,
+		slabRepo:    slabRepo,
+		lineageRepo: lineageRepo,
+		logger:      logger,
+	}
+}
+
+func (s *blockService) Create(ctx context.Context, industryID string, input entity.CreateBlockInput) (*entity.Block, error) {
+	code := strings.ToUpper(strings.TrimSpace(input.BlockCode))
+	if code == "" {
+		return nil, domainErrors.ValidationError("Código do bloco é obrigatório")
+	}
+
+	exists, err := s.blockRepo.ExistsByCode(ctx, industryID, code, "")
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, domainErrors.NewConflictError("Código de bloco já cadastrado")
+	}
+
+	if input.Material != nil && !input.Material.IsValid() {
+		return nil, domainErrors.ValidationError("Tipo de material inválido")
+	}
+
+	arrivalDate, err := parseBlockDate(input.ArrivalDate)
+	if err != nil {
+		return nil, err
+	}
+
+	volume := entity.CalculateBlockVolume(input.Length, input.Width, input.Height)
+	if input.VolumeM3 != nil {
+		volume = *input.VolumeM3
+	}
+	if volume <= 0 {
+		return nil, domainErrors.ValidationError("Informe o volume (m³) ou as três dimensões do bloco")
+	}
+
+	block := &entity.Block{
+		ID:           uuid.New().String(),
+		IndustryID:   industryID,
+		BlockCode:    code,
+		QuarryName:   strings.TrimSpace(input.QuarryName),
+		SupplierName: input.SupplierName,
+		Material:     input.Material,
+		Length:       input.Length,
+		Width:        input.Width,
+		Height:       input.Height,
+		VolumeM3:     volume,
+		PurchaseCost: input.PurchaseCost,
+		ArrivalDate:  arrivalDate,
+		Notes:        input.Notes,
+		IsActive:     true,
+	}
+
+	if err := s.blockRepo.Create(ctx, block); err != nil {
+		s.logger.Error("erro ao criar bloco",
+			zap.String("industryId", industryID),
+			zap.String("blockCode", code),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("bloco criado",
+		zap.String("blockId", block.ID),
+		zap.String("blockCode", block.BlockCode),
+		zap.Float64("volumeM3", block.VolumeM3),
+	)
+
+	return block, nil
+}
+
+func (s *blockService) GetByID(ctx context.Context, industryID, id string) (*entity.Block, error) {
+	block, err := s.findOwned(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lotes serrados do bloco, inclusive arquivados, para compor o rendimento
+	block.Batches = []entity.Batch{}
+	filters := entity.BatchFilters{BlockID: &id, IncludeArchived: true}
+	err = s.batchRepo.Stream(ctx, industryID, filters, func(batch *entity.Batch) error {
+		block.Batches = append(block.Batches, *batch)
+		return nil
+	})
+	if err != nil {
+		s.logger.Warn("erro ao buscar lotes do bloco",
+			zap.String("blockId", id),
+			zap.Error(err),
+		)
+	}
+
+	return block, nil
+}
+
+func (s *blockService) List(ctx context.Context, industryID string, filters entity.BlockFilters) (*entity.BlockListResponse, error) {
+	blocks, total, err := s.blockRepo.List(ctx, industryID, filters)
+	if err != nil {
+		s.logger.Error("erro ao listar blocos",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &entity.BlockListResponse{
+		Blocks: blocks,
+		Total:  total,
+		Page:   filters.Page,
+	}, nil
+}
+
+func (s *blockService) Update(ctx context.Context, industryID, id string, input entity.UpdateBlockInput) (*entity.Block, error) {
+	block, err := s.findOwned(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.BlockCode != nil {
+		code := strings.ToUpper(strings.TrimSpace(*input.BlockCode))
+		if code == "" {
+			return nil, domainErrors.ValidationError("Código do bloco é obrigatório")
+		}
+		if code != block.BlockCode {
+			exists, err := s.blockRepo.ExistsByCode(ctx, industryID, code, id)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				return nil, domainErrors.NewConflictError("Código de bloco já cadastrado")
+			}
+		}
+		block.BlockCode = code
+	}
+	if input.QuarryName != nil {
+		block.QuarryName = strings.TrimSpace(*input.QuarryName)
+	}
+	if input.SupplierName != nil {
+		block.SupplierName = input.SupplierName
+	}
+	if input.Material != nil {
+		if !input.Material.IsValid() {
+			return nil, domainErrors.ValidationError("Tipo de material inválido")
+		}
+		block.Material = input.Material
+	}
+
+	dimensionsChanged := false
+	if input.Length != nil {
+		block.Length = input.Length
+		dimensionsChanged = true
+	}
+	if input.Width != nil {
+		block.Width = input.Width
+		dimensionsChanged = true
+	}
+	if input.Height != nil {
+		block.Height = input.Height
+		dimensionsChanged = true
+	}
+	if input.VolumeM3 != nil {
+		block.VolumeM3 = *input.VolumeM3
+	} else if dimensionsChanged {
+		if volume := entity.CalculateBlockVolume(block.Length, block.Width, block.Height); volume > 0 {
+			block.VolumeM3 = volume
+		}
+	}
+
+	if input.PurchaseCost != nil {
+		block.PurchaseCost = *input.PurchaseCost
+	}
+	if input.ArrivalDate != nil {
+		arrivalDate, err := parseBlockDate(*input.ArrivalDate)
+		if err != nil {
+			return nil, err
+		}
+		block.ArrivalDate = arrivalDate
+	}
+	if input.Notes != nil {
+		block.Notes = input.Notes
+	}
+	if input.IsActive != nil {
+		block.IsActive = *input.IsActive
+	}
+
+	if err := s.blockRepo.Update(ctx, block); err != nil {
+		s.logger.Error("erro ao atualizar bloco",
+			zap.String("blockId", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("bloco atualizado", zap.String("blockId", id))
+
+	return s.GetByID(ctx, industryID, id)
+}
+
+func (s *blockService) Delete(ctx context.Context, industryID, id string) error {
+	if _, err := s.findOwned(ctx, industryID, id); err != nil {
+		return err
+	}
+
+	count, err := s.blockRepo.CountBatches(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return domainErrors.NewConflictError("Não foi possível excluir este bloco pois ele possui lotes vinculados")
+	}
+
+	if err := s.blockRepo.Delete(ctx, id); err != nil {
+		s.logger.Error("erro ao excluir bloco",
+			zap.String("blockId", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	s.logger.Info("bloco excluído", zap.String("blockId", id))
+	return nil
+}
+
+func (s *blockService) TraceSlab(ctx context.Context, industryID, slabID string) (*entity.SlabTrace, error) {
+	slab, err := s.slabRepo.FindByID(ctx, slabID)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.batchRepo.FindByID(ctx, slab.BatchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+
+	trace := &entity.SlabTrace{Slab: slab, Batch: batch, Lineage: []entity.BatchLineage{}}
+
+	// Lotes gerados por divisão herdam o bloco; lotes sem bloco são percorridos até a origem
+	blockID := batch.BlockID
+	current := batch.ID
+	visited := map[string]bool{current: true}
+	for depth := 0; blockID == nil && depth < maxTraceDepth; depth++ {
+		lineage, err := s.lineageRepo.FindByBatchID(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+		if len(lineage.Parents) == 0 {
+			break
+		}
+
+		parent := lineage.Parents[0]
+		if visited[parent.ParentBatchID] {
+			break
+		}
+		visited[parent.ParentBatchID] = true
+		trace.Lineage = append(trace.Lineage, parent)
+
+		parentBatch, err := s.batchRepo.FindByID(ctx, parent.ParentBatchID)
+		if err != nil {
+			return nil, err
+		}
+		blockID = parentBatch.BlockID
+		current = parentBatch.ID
+	}
+
+	if blockID != nil {
+		block, err := s.blockRepo.FindByID(ctx, *blockID)
+		if err != nil && !isNotFoundError(err) {
+			return nil, err
+		}
+		trace.Block = block
+	}
+
+	return trace, nil
+}
+
+// findOwned busca o bloco garantindo que pertence à indústria
+func (s *blockService) findOwned(ctx context.Context, industryID, id string) (*entity.Block, error) {
+	block, err := s.blockRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if block.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return block, nil
+}
+
+// parseBlockDate aceita data ISO ou RFC3339 e rejeita datas futuras
+func parseBlockDate(value string) (time.Time, error) {
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		date, err = time.Parse("2006-01-02", value)
+	}
+	if err != nil {
+		return time.Time{}, domainErrors.ValidationError("Data de chegada inválida")
+	}
+	if date.After(time.Now()) {
+		return time.Time{}, domainErrors.ValidationError("Data de chegada não pode ser futura")
+	}
+	return date, nil
+}
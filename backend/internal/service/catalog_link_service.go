@@ -18,6 +18,7 @@ type catalogLinkService struct {
 	productRepo     repository.ProductRepository
 	mediaRepo       repository.MediaRepository
 	industryRepo    repository.IndustryRepository
+	exchangeRates   domainService.ExchangeRateService
 	publicLinkBaseURL string
 	logger          *zap.Logger
 }
@@ -28,6 +29,7 @@ func NewCatalogLinkService(
 	productRepo repository.ProductRepository,
 	mediaRepo repository.MediaRepository,
 	industryRepo repository.IndustryRepository,
+	exchangeRates domainService.ExchangeRateService,
 	publicLinkBaseURL string,
 	logger *zap.Logger,
 ) domainService.CatalogLinkService {
@@ -37,6 +39,7 @@ func NewCatalogLinkService(
 		productRepo:       productRepo,
 		mediaRepo:         mediaRepo,
 		industryRepo:      industryRepo,
+		exchangeRates:     exchangeRates,
 		publicLinkBaseURL: publicLinkBaseURL,
 		logger:            logger,
 	}
@@ -99,6 +102,7 @@ func (s *catalogLinkService) Create(ctx context.Context, industryID, userID stri
 		SlugToken:       input.SlugToken,
 		Title:           input.Title,
 		CustomMessage:   input.CustomMessage,
+		ShowPrice:       input.ShowPrice,
 		ExpiresAt:       expiresAt,
 		IsActive:        input.IsActive,
 		ViewsCount:      0,
@@ -147,7 +151,7 @@ func (s *catalogLinkService) GetBySlug(ctx context.Context, slug string) (*entit
 	return link, nil
 }
 
-func (s *catalogLinkService) GetPublicBySlug(ctx context.Context, slug string) (*entity.PublicCatalogLink, error) {
+func (s *catalogLinkService) GetPublicBySlug(ctx context.Context, slug string, currency *entity.Currency) (*entity.PublicCatalogLink, error) {
 	link, err := s.catalogLinkRepo.FindBySlug(ctx, slug)
 	if err != nil {
 		return nil, err
@@ -177,6 +181,7 @@ func (s *catalogLinkService) GetPublicBySlug(ctx context.Context, slug string) (
 	result := &entity.PublicCatalogLink{
 		Title:        link.Title,
 		CustomMessage: link.CustomMessage,
+		ShowPrice:    link.ShowPrice,
 		DepositName:  depositName,
 		DepositCity:  industry.AddressCity,
 		DepositState: industry.AddressState,
@@ -218,6 +223,19 @@ func (s *catalogLinkService) GetPublicBySlug(ctx context.Context, slug string) (
 			}
 		}
 
+		// Preço por unidade de área, convertido para a moeda solicitada
+		if link.ShowPrice {
+			price, priceCurrency, err := convertForDisplay(ctx, s.exchangeRates, link.IndustryID, batch.IndustryPrice, batch.Currency, currency)
+			if err != nil {
+				return nil, err
+			}
+			unit := batch.PriceUnit
+			publicBatch.Price = &price
+			publicBatch.PriceUnit = &unit
+			publicBatch.Currency = &priceCurrency
+			result.Currency = &priceCurrency
+		}
+
 		result.Batches = append(result.Batches, publicBatch)
 	}
 
@@ -275,6 +293,9 @@ func (s *catalogLinkService) Update(ctx context.Context, id, industryID string,
 	if input.IsActive != nil {
 		link.IsActive = *input.IsActive
 	}
+	if input.ShowPrice != nil {
+		link.ShowPrice = *input.ShowPrice
+	}
 
 	// Validar lotes se fornecidos
 	var batchIDs *[]string
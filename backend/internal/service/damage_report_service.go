@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+// maxDamagePhotos limita as fotos anexadas a um relatório de avaria
+const maxDamagePhotos = 10
+
+type damageReportService struct {
+	reportRepo   repository.DamageReportRepository
+	batchRepo    repository.BatchRepository
+	batchService BatchAvailabilityAdjuster
+	db           BatchDB
+	logger       *zap.Logger
+}
+
+func NewDamageReportService(
+	reportRepo repository.DamageReportRepository,
+	batchRepo repository.BatchRepository,
+	batchService BatchAvailabilityAdjuster,
+	db BatchDB,
+	logger *zap.Logger,
+) *damageReportService {
+	return &damageReportService{
+		reportRepo:   reportRepo,
+		batchRepo:    batchRepo,
+		batchService: batchService,
+		db:           db,
+		logger:       logger,
+	}
+}
+
+func (s *damageReportService) Create(ctx context.Context, industryID, userID string, input entity.CreateDamageReportInput) (*entity.DamageReport, error) {
+	if !input.Cause.IsValid() {
+		return nil, domainErrors.ValidationError("Causa de avaria inválida")
+	}
+
+	batch, err := s.batchRepo.FindByID(ctx, input.BatchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+
+	// Avaria só se aplica a chapas disponíveis (reservadas/vendidas seguem seus próprios fluxos)
+	if input.Quantity > batch.AvailableSlabs {
+		return nil, domainErrors.NewValidationError(
+			"Quantidade avariada excede chapas disponíveis",
+			map[string]interface{}{
+				"requested": input.Quantity,
+				"available": batch.AvailableSlabs,
+			},
+		)
+	}
+
+	report := &entity.DamageReport{
+		ID:               uuid.New().String(),
+		IndustryID:       industryID,
+		BatchID:          batch.ID,
+		Quantity:         input.Quantity,
+		Cause:            input.Cause,
+		Description:      input.Description,
+		Status:           entity.DamageReportStatusPendente,
+		ReportedByUserID: &userID,
+	}
+
+	if err := s.reportRepo.Create(ctx, report); err != nil {
+		s.logger.Error("erro ao registrar avaria",
+			zap.String("batchId", batch.ID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("avaria registrada",
+		zap.String("reportId", report.ID),
+		zap.String("batchId", batch.ID),
+		zap.String("cause", string(report.Cause)),
+		zap.Int("quantity", report.Quantity),
+	)
+
+	return s.reportRepo.FindByID(ctx, report.ID)
+}
+
+func (s *damageReportService) GetByID(ctx context.Context, industryID, id string) (*entity.DamageReport, error) {
+	report, err := s.reportRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if report.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return report, nil
+}
+
+func (s *damageReportService) List(ctx context.Context, industryID string, filters entity.DamageReportFilters) (*entity.DamageReportListResponse, error) {
+	reports, total, err := s.reportRepo.List(ctx, industryID, filters)
+	if err != nil {
+		s.logger.Error("erro ao listar avarias",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &entity.DamageReportListResponse{
+		Reports: reports,
+		Total:   total,
+		Page:    filters.Page,
+	}, nil
+}
+
+func (s *damageReportService) AddPhotos(ctx context.Context, industryID, id string, urls []string) (*entity.DamageReport, error) {
+	report, err := s.GetByID(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !report.IsPending() {
+		return nil, domainErrors.ValidationError("Relatório de avaria já foi revisado")
+	}
+	if len(report.Photos)+len(urls) > maxDamagePhotos {
+		return nil, domainErrors.ValidationError(fmt.Sprintf("Máximo de %d fotos por relatório de avaria", maxDamagePhotos))
+	}
+
+	if err := s.reportRepo.AddPhotos(ctx, id, urls); err != nil {
+		s.logger.Error("erro ao anexar fotos da avaria",
+			zap.String("reportId", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return s.reportRepo.FindByID(ctx, id)
+}
+
+func (s *damageReportService) Approve(ctx context.Context, industryID, id, userID string, input entity.ApproveDamageReportInput) (*entity.DamageReport, error) {
+	report, err := s.GetByID(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !report.IsPending() {
+		return nil, domainErrors.ValidationError("Relatório de avaria já foi revisado")
+	}
+
+	reason := entity.MovementReasonAvaria
+	disponivel := entity.BatchStatusDisponivel
+	notes := fmt.Sprintf("Avaria %s (%s)", report.ID, report.Cause)
+	if report.Description != nil && *report.Description != "" {
+		notes = fmt.Sprintf("%s: %s", notes, *report.Description)
+	}
+
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		// Revisão condicional: uma aprovação concorrente cai em conflito antes de mexer no estoque
+		if err := s.reportRepo.Review(ctx, tx, id, entity.DamageReportStatusAprovado, userID, input.Notes); err != nil {
+			return err
+		}
+
+		// Mesmo caminho do ajuste manual de disponibilidade: razão de estoque e chapas individuais
+		_, err := s.batchService.UpdateAvailabilityInTx(ctx, tx, report.BatchID, userID, entity.UpdateBatchAvailabilityInput{
+			Status:     entity.BatchStatusInativo,
+			FromStatus: &disponivel,
+			Quantity:   report.Quantity,
+			Reason:     &reason,
+			Notes:      &notes,
+		})
+		return err
+	})
+	if err != nil {
+		s.logger.Error("erro ao aprovar avaria",
+			zap.String("reportId", id),
+			zap.String("batchId", report.BatchID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("avaria aprovada",
+		zap.String("reportId", id),
+		zap.String("batchId", report.BatchID),
+		zap.Int("quantity", report.Quantity),
+	)
+
+	return s.reportRepo.FindByID(ctx, id)
+}
+
+func (s *damageReportService) Reject(ctx context.Context, industryID, id, userID string, input entity.RejectDamageReportInput) (*entity.DamageReport, error) {
+	report, err := s.GetByID(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !report.IsPending() {
+		return nil, domainErrors.ValidationError("Relatório de avaria já foi revisado")
+	}
+
+	if err := s.reportRepo.Review(ctx, nil, id, entity.DamageReportStatusRejeitado, userID, &input.Reason); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("avaria rejeitada", zap.String("reportId", id))
+
+	return s.reportRepo.FindByID(ctx, id)
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	domainService "github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"go.uber.org/zap"
+)
+
+type exchangeRateService struct {
+	rateRepo repository.ExchangeRateRepository
+	db       BatchDB
+	logger   *zap.Logger
+}
+
+func NewExchangeRateService(
+	rateRepo repository.ExchangeRateRepository,
+	db BatchDB,
+	logger *zap.Logger,
+) *exchangeRateService {
+	return &exchangeRateService{
+		rateRepo: rateRepo,
+		db:       db,
+		logger:   logger,
+	}
+}
+
+func (s *exchangeRateService) List(ctx context.Context, industryID string, filters entity.ExchangeRateFilters) ([]entity.ExchangeRate, error) {
+	return s.rateRepo.List(ctx, industryID, filters)
+}
+
+func (s *exchangeRateService) Upsert(ctx context.Context, industryID, userID string, input entity.UpsertExchangeRateInput) (*entity.ExchangeRate, error) {
+	rate, err := newExchangeRate(industryID, userID, input, entity.ExchangeRateSourceManual)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.rateRepo.Upsert(ctx, nil, rate); err != nil {
+		s.logger.Error("erro ao salvar cotação",
+			zap.String("industryId", industryID),
+			zap.String("from", string(rate.FromCurrency)),
+			zap.String("to", string(rate.ToCurrency)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("cotação salva",
+		zap.String("exchangeRateId", rate.ID),
+		zap.String("from", string(rate.FromCurrency)),
+		zap.String("to", string(rate.ToCurrency)),
+		zap.Float64("rate", rate.Rate),
+	)
+
+	return rate, nil
+}
+
+func (s *exchangeRateService) Import(ctx context.Context, industryID, userID string, rows []entity.ExchangeRateImportRow) (*entity.ExchangeRateImportResult, error) {
+	result := &entity.ExchangeRateImportResult{
+		TotalRows: len(rows),
+		Rows:      []entity.ExchangeRateImportRow{},
+	}
+
+	rates := make([]*entity.ExchangeRate, 0, len(rows))
+	for i := range rows {
+		row := &rows[i]
+		if row.IsValid() {
+			rate, err := newExchangeRate(industryID, userID, row.Input, entity.ExchangeRateSourceArquivo)
+			if err != nil {
+				if appErr, ok := err.(*domainErrors.AppError); ok {
+					row.AddError(appErr.Message)
+				} else {
+					return nil, err
+				}
+			} else {
+				rates = append(rates, rate)
+			}
+		}
+		if !row.IsValid() {
+			result.Rows = append(result.Rows, *row)
+		}
+	}
+	result.InvalidRows = len(result.Rows)
+
+	if len(rates) == 0 {
+		return result, nil
+	}
+
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		for _, rate := range rates {
+			if err := s.rateRepo.Upsert(ctx, tx, rate); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("erro ao importar cotações",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	result.Imported = len(rates)
+
+	s.logger.Info("cotações importadas",
+		zap.String("industryId", industryID),
+		zap.Int("imported", result.Imported),
+		zap.Int("invalid", result.InvalidRows),
+	)
+
+	return result, nil
+}
+
+func (s *exchangeRateService) Delete(ctx context.Context, industryID, id string) error {
+	rate, err := s.rateRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rate.IndustryID != industryID {
+		return domainErrors.NewNotFoundError("Cotação")
+	}
+
+	if err := s.rateRepo.Delete(ctx, id); err != nil {
+		s.logger.Error("erro ao excluir cotação",
+			zap.String("exchangeRateId", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (s *exchangeRateService) Convert(ctx context.Context, industryID string, amount float64, from, to entity.Currency, date time.Time) (float64, error) {
+	from, to = from.OrDefault(), to.OrDefault()
+	if from == to {
+		return amount, nil
+	}
+
+	rate, err := s.rateRepo.GetRate(ctx, industryID, from, to, date)
+	if err != nil {
+		return 0, err
+	}
+	if rate == nil {
+		return 0, domainErrors.ValidationError(fmt.Sprintf("Cotação de %s para %s não cadastrada", from, to))
+	}
+
+	converted := amount * *rate
+	return math.Round(converted*100) / 100, nil
+}
+
+// newExchangeRate valida o par e a data de vigência da cotação
+func newExchangeRate(industryID, userID string, input entity.UpsertExchangeRateInput, source entity.ExchangeRateSource) (*entity.ExchangeRate, error) {
+	if !input.FromCurrency.IsValid() || !input.ToCurrency.IsValid() {
+		return nil, domainErrors.ValidationError("Moeda inválida. Use BRL, USD ou EUR")
+	}
+	if input.FromCurrency == input.ToCurrency {
+		return nil, domainErrors.ValidationError("Moedas de origem e destino devem ser diferentes")
+	}
+	if input.Rate <= 0 {
+		return nil, domainErrors.ValidationError("Cotação deve ser maior que 0")
+	}
+
+	effectiveDate, err := time.Parse("2006-01-02", input.EffectiveDate)
+	if err != nil {
+		parsed, rfcErr := time.Parse(time.RFC3339, input.EffectiveDate)
+		if rfcErr != nil {
+			return nil, domainErrors.ValidationError("Data de vigência inválida")
+		}
+		effectiveDate = parsed
+	}
+
+	rate := &entity.ExchangeRate{
+		ID:            uuid.New().String(),
+		IndustryID:    industryID,
+		FromCurrency:  input.FromCurrency,
+		ToCurrency:    input.ToCurrency,
+		Rate:          input.Rate,
+		EffectiveDate: effectiveDate,
+		Source:        source,
+	}
+	if userID != "" {
+		rate.CreatedByUserID = &userID
+	}
+
+	return rate, nil
+}
+
+// convertForDisplay converte o valor para a moeda solicitada na exibição pública (nil mantém a moeda original)
+func convertForDisplay(ctx context.Context, rates domainService.ExchangeRateService, industryID string, amount float64, from entity.Currency, target *entity.Currency) (float64, entity.Currency, error) {
+	from = from.OrDefault()
+	if target == nil || *target == from {
+		return amount, from, nil
+	}
+
+	converted, err := rates.Convert(ctx, industryID, amount, from, *target, time.Now())
+	if err != nil {
+		return 0, "", err
+	}
+
+	return converted, *target, nil
+}
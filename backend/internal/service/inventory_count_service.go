@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+type inventoryCountService struct {
+	countRepo    repository.InventoryCountRepository
+	productRepo  repository.ProductRepository
+	batchService BatchAvailabilityAdjuster
+	waitlist     WaitlistProcessor
+	db           BatchDB
+	logger       *zap.Logger
+}
+
+func NewInventoryCountService(
+	countRepo repository.InventoryCountRepository,
+	productRepo repository.ProductRepository,
+	batchService BatchAvailabilityAdjuster,
+	waitlist WaitlistProcessor,
+	db BatchDB,
+	logger *zap.Logger,
+) *inventoryCountService {
+	return &inventoryCountService{
+		countRepo:    countRepo,
+		productRepo:  productRepo,
+		batchService: batchService,
+		waitlist:     waitlist,
+		db:           db,
+		logger:       logger,
+	}
+}
+
+func (s *inventoryCountService) Create(ctx context.Context, industryID, userID string, input entity.CreateInventoryCountInput) (*entity.InventoryCount, error) {
+	if !input.Scope.IsValid() {
+		return nil, domainErrors.ValidationError("Escopo de inventário inválido")
+	}
+
+	count := &entity.InventoryCount{
+		ID:              uuid.New().String(),
+		IndustryID:      industryID,
+		Scope:           input.Scope,
+		Status:          entity.InventoryCountStatusAberta,
+		Notes:           input.Notes,
+		CreatedByUserID: &userID,
+	}
+
+	switch input.Scope {
+	case entity.InventoryCountScopeProduto:
+		if input.ProductID == nil {
+			return nil, domainErrors.ValidationError("Informe o produto do inventário")
+		}
+		product, err := s.productRepo.FindByID(ctx, *input.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if product.IndustryID != industryID {
+			return nil, domainErrors.ForbiddenError()
+		}
+		count.ProductID, count.ProductName = &product.ID, &product.Name
+	case entity.InventoryCountScopeMaterial:
+		if input.Material == nil || !input.Material.IsValid() {
+			return nil, domainErrors.ValidationError("Informe um material válido para o inventário")
+		}
+		count.Material = input.Material
+	}
+
+	// Sessão e itens congelados são gravados atomicamente
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		if err := s.countRepo.Create(ctx, tx, count); err != nil {
+			return err
+		}
+		frozen, err := s.countRepo.FreezeScope(ctx, tx, count)
+		if err != nil {
+			return err
+		}
+		if frozen == 0 {
+			return domainErrors.ValidationError("Nenhum lote ativo no escopo informado")
+		}
+		count.Summary.TotalItems = frozen
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("erro ao abrir inventário",
+			zap.String("industryId", industryID),
+			zap.String("scope", string(input.Scope)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("inventário aberto",
+		zap.String("countId", count.ID),
+		zap.String("scope", string(count.Scope)),
+		zap.Int("items", count.Summary.TotalItems),
+	)
+
+	return s.GetByID(ctx, industryID, count.ID)
+}
+
+func (s *inventoryCountService) GetByID(ctx context.Context, industryID, id string) (*entity.InventoryCount, error) {
+	count, err := s.findOwned(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.countRepo.FindItems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	count.Items = items
+
+	return count, nil
+}
+
+func (s *inventoryCountService) List(ctx context.Context, industryID string, filters entity.InventoryCountFilters) (*entity.InventoryCountListResponse, error) {
+	counts, total, err := s.countRepo.List(ctx, industryID, filters)
+	if err != nil {
+		s.logger.Error("erro ao listar inventários",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &entity.InventoryCountListResponse{
+		Counts: counts,
+		Total:  total,
+		Page:   filters.Page,
+	}, nil
+}
+
+func (s *inventoryCountService) RecordCounts(ctx context.Context, industryID, id, userID string, input entity.RecordInventoryCountInput) (*entity.InventoryCount, error) {
+	count, err := s.findOwned(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !count.IsOpen() {
+		return nil, domainErrors.ValidationError("Inventário já foi encerrado")
+	}
+
+	for _, entry := range input.Items {
+		if entry.CountedSlabs < 0 {
+			return nil, domainErrors.ValidationError("Quantidade contada não pode ser negativa")
+		}
+		if err := s.countRepo.RecordItem(ctx, id, entry, userID); err != nil {
+			s.logger.Error("erro ao registrar contagem",
+				zap.String("countId", id),
+				zap.String("batchId", entry.BatchID),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+	}
+
+	s.logger.Info("contagens registradas",
+		zap.String("countId", id),
+		zap.Int("items", len(input.Items)),
+	)
+
+	return s.GetByID(ctx, industryID, id)
+}
+
+func (s *inventoryCountService) Approve(ctx context.Context, industryID, id, userID string, input entity.ApproveInventoryCountInput) (*entity.InventoryCount, error) {
+	reason := entity.MovementReasonInventario
+	if input.Reason != nil {
+		if !input.Reason.IsManual() {
+			return nil, domainErrors.ValidationError("Motivo de ajuste inválido")
+		}
+		reason = *input.Reason
+	}
+
+	count, err := s.findOwned(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !count.IsOpen() {
+		return nil, domainErrors.ValidationError("Inventário já foi encerrado")
+	}
+
+	notes := fmt.Sprintf("Inventário %s", count.ID)
+	if input.Notes != nil && *input.Notes != "" {
+		notes = fmt.Sprintf("%s: %s", notes, *input.Notes)
+	}
+
+	adjusted := 0
+	var released []string
+
+	// Encerrar a sessão primeiro reivindica a aprovação: uma aprovação concorrente cai em conflito
+	// e os ajustes dos lotes são aplicados na mesma transação
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		if err := s.countRepo.Close(ctx, tx, id, entity.InventoryCountStatusAprovada, userID, &reason); err != nil {
+			return err
+		}
+
+		items, err := s.countRepo.FindItemsForUpdate(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		// Sobras só podem voltar a disponível a partir de chapas inativas; valida tudo antes de ajustar
+		pending := []entity.InventoryCountItem{}
+		invalid := map[string]interface{}{}
+		for _, item := range items {
+			if item.Variance == nil || item.AdjustedAt != nil {
+				continue
+			}
+			if *item.Variance > item.InactiveSlabs {
+				invalid[item.BatchCode] = fmt.Sprintf("sobra de %d chapa(s) excede as %d inativa(s) do lote", *item.Variance, item.InactiveSlabs)
+				continue
+			}
+			pending = append(pending, item)
+		}
+		if len(invalid) > 0 {
+			return domainErrors.NewValidationError("Divergências não podem ser ajustadas pela disponibilidade; corrija a quantidade dos lotes", invalid)
+		}
+
+		// Cada divergência segue o mesmo caminho do ajuste manual de disponibilidade (razão + chapas)
+		for _, item := range pending {
+			variance := *item.Variance
+			if variance != 0 {
+				adjustment := entity.UpdateBatchAvailabilityInput{
+					Reason: &reason,
+					Notes:  &notes,
+				}
+				disponivel, inativo := entity.BatchStatusDisponivel, entity.BatchStatusInativo
+				if variance < 0 {
+					adjustment.Status, adjustment.FromStatus, adjustment.Quantity = inativo, &disponivel, -variance
+				} else {
+					adjustment.Status, adjustment.FromStatus, adjustment.Quantity = disponivel, &inativo, variance
+				}
+
+				if _, err := s.batchService.UpdateAvailabilityInTx(ctx, tx, item.BatchID, userID, adjustment); err != nil {
+					s.logger.Error("erro ao ajustar lote do inventário",
+						zap.String("countId", id),
+						zap.String("batchId", item.BatchID),
+						zap.Int("variance", variance),
+						zap.Error(err),
+					)
+					return err
+				}
+			}
+
+			if err := s.countRepo.MarkItemAdjusted(ctx, tx, item.ID, variance); err != nil {
+				return err
+			}
+		}
+
+		adjusted = len(pending)
+		for _, item := range pending {
+			if *item.Variance > 0 {
+				released = append(released, item.BatchID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("inventário aprovado",
+		zap.String("countId", id),
+		zap.Int("adjustedItems", adjusted),
+		zap.String("reason", string(reason)),
+	)
+
+	// Sobras devolvidas a disponível atendem primeiro a fila de espera dos lotes
+	for _, batchID := range released {
+		s.waitlist.ProcessWaitlist(ctx, batchID)
+	}
+
+	return s.GetByID(ctx, industryID, id)
+}
+
+func (s *inventoryCountService) Cancel(ctx context.Context, industryID, id, userID string) (*entity.InventoryCount, error) {
+	count, err := s.findOwned(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !count.IsOpen() {
+		return nil, domainErrors.ValidationError("Inventário já foi encerrado")
+	}
+
+	if err := s.countRepo.Close(ctx, nil, id, entity.InventoryCountStatusCancelada, userID, nil); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("inventário cancelado", zap.String("countId", id))
+
+	return s.GetByID(ctx, industryID, id)
+}
+
+// findOwned busca a sessão garantindo que pertence à indústria
+func (s *inventoryCountService) findOwned(ctx context.Context, industryID, id string) (*entity.InventoryCount, error) {
+	count, err := s.countRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if count.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return count, nil
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+// jobTick é a frequência com que o agendador verifica os jobs vencidos
+const jobTick = time.Minute
+
+// Job representa um job em segundo plano executado periodicamente pelo agendador
+type Job struct {
+	Name        string
+	Description string
+	Interval    time.Duration
+	Run         func(ctx context.Context) (int, error) // retorna a quantidade de registros processados
+}
+
+// JobLocker define o lock distribuído que garante uma única réplica executando cada job
+type JobLocker interface {
+	TryAdvisoryLock(ctx context.Context, key string) (release func(), acquired bool, err error)
+}
+
+type jobScheduler struct {
+	runRepo  repository.JobRunRepository
+	locker   JobLocker
+	jobs     []Job
+	instance string
+	baseCtx  context.Context
+	logger   *zap.Logger
+}
+
+func NewJobScheduler(runRepo repository.JobRunRepository, locker JobLocker, logger *zap.Logger, jobs ...Job) *jobScheduler {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+
+	return &jobScheduler{
+		runRepo:  runRepo,
+		locker:   locker,
+		jobs:     jobs,
+		instance: fmt.Sprintf("%s-%d", host, os.Getpid()),
+		baseCtx:  context.Background(),
+		logger:   logger,
+	}
+}
+
+// Start inicia o loop do agendador até o contexto ser cancelado.
+// O intervalo de cada job é contado a partir da última execução registrada no banco,
+// então réplicas diferentes não repetem execuções dentro do mesmo intervalo
+func (s *jobScheduler) Start(ctx context.Context) {
+	s.baseCtx = ctx
+
+	for _, job := range s.jobs {
+		s.logger.Info("job agendado",
+			zap.String("job", job.Name),
+			zap.Duration("interval", job.Interval),
+		)
+	}
+
+	go func() {
+		ticker := time.NewTicker(jobTick)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("agendador de jobs encerrado")
+				return
+			case <-ticker.C:
+				for _, job := range s.jobs {
+					go s.runIfDue(ctx, job)
+				}
+			}
+		}
+	}()
+}
+
+func (s *jobScheduler) ListJobs(ctx context.Context) ([]entity.ScheduledJob, error) {
+	jobs := make([]entity.ScheduledJob, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		info := entity.ScheduledJob{
+			Name:            job.Name,
+			Description:     job.Description,
+			IntervalSeconds: int(job.Interval.Seconds()),
+		}
+
+		lastRun, err := s.runRepo.FindLast(ctx, job.Name)
+		if err != nil && !isNotFoundError(err) {
+			return nil, err
+		}
+		if lastRun != nil {
+			info.LastRun = lastRun
+			next := lastRun.StartedAt.Add(job.Interval)
+			info.NextRunAt = &next
+		}
+
+		jobs = append(jobs, info)
+	}
+	return jobs, nil
+}
+
+func (s *jobScheduler) ListRuns(ctx context.Context, name string, limit int) ([]entity.JobRun, error) {
+	if _, err := s.findJob(name); err != nil {
+		return nil, err
+	}
+	return s.runRepo.ListByJob(ctx, name, limit)
+}
+
+func (s *jobScheduler) Trigger(ctx context.Context, name, userID string) (*entity.JobRun, error) {
+	job, err := s.findJob(name)
+	if err != nil {
+		return nil, err
+	}
+
+	release, acquired, err := s.locker.TryAdvisoryLock(ctx, jobLockKey(job.Name))
+	if err != nil {
+		return nil, domainErrors.InternalError(err)
+	}
+	if !acquired {
+		return nil, domainErrors.NewConflictError("Job já está em execução")
+	}
+
+	run, err := s.startRun(ctx, job, entity.JobTriggerManual, &userID)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	s.logger.Info("execução manual de job disparada",
+		zap.String("job", job.Name),
+		zap.String("runId", run.ID),
+		zap.String("userId", userID),
+	)
+
+	// A execução segue após a resposta da requisição
+	started := *run
+	go func() {
+		defer release()
+		s.execute(s.baseCtx, job, run)
+	}()
+
+	return &started, nil
+}
+
+// runIfDue executa o job nesta réplica se ninguém o estiver executando e o intervalo já passou
+func (s *jobScheduler) runIfDue(ctx context.Context, job Job) {
+	release, acquired, err := s.locker.TryAdvisoryLock(ctx, jobLockKey(job.Name))
+	if err != nil {
+		s.logger.Error("erro ao obter lock do job", zap.String("job", job.Name), zap.Error(err))
+		return
+	}
+	if !acquired {
+		return
+	}
+	defer release()
+
+	// Com o lock em mãos, execuções ainda em andamento são de réplicas que caíram
+	if n, err := s.runRepo.FailInterrupted(ctx, job.Name); err != nil {
+		s.logger.Error("erro ao encerrar execuções interrompidas", zap.String("job", job.Name), zap.Error(err))
+		return
+	} else if n > 0 {
+		s.logger.Warn("execuções interrompidas de job marcadas como falha", zap.String("job", job.Name), zap.Int("count", n))
+	}
+
+	lastRun, err := s.runRepo.FindLast(ctx, job.Name)
+	if err != nil && !isNotFoundError(err) {
+		s.logger.Error("erro ao buscar última execução do job", zap.String("job", job.Name), zap.Error(err))
+		return
+	}
+	if lastRun != nil && time.Since(lastRun.StartedAt) < job.Interval {
+		return
+	}
+
+	run, err := s.startRun(ctx, job, entity.JobTriggerAgendado, nil)
+	if err != nil {
+		s.logger.Error("erro ao registrar execução do job", zap.String("job", job.Name), zap.Error(err))
+		return
+	}
+
+	s.execute(ctx, job, run)
+}
+
+func (s *jobScheduler) startRun(ctx context.Context, job Job, trigger entity.JobTrigger, userID *string) (*entity.JobRun, error) {
+	run := &entity.JobRun{
+		ID:                uuid.New().String(),
+		JobName:           job.Name,
+		Trigger:           trigger,
+		Status:            entity.JobRunStatusExecutando,
+		TriggeredByUserID: userID,
+		Instance:          s.instance,
+	}
+	if err := s.runRepo.Create(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// execute roda o job e registra o resultado; deve ser chamado com o lock do job em mãos
+func (s *jobScheduler) execute(ctx context.Context, job Job, run *entity.JobRun) {
+	start := time.Now()
+	affected, err := s.runSafely(ctx, job)
+
+	run.Affected = &affected
+	run.Status = entity.JobRunStatusSucesso
+	if err != nil {
+		msg := err.Error()
+		run.Status = entity.JobRunStatusFalha
+		run.Error = &msg
+		s.logger.Error("erro ao executar job",
+			zap.String("job", job.Name),
+			zap.String("runId", run.ID),
+			zap.Error(err),
+		)
+	} else {
+		s.logger.Info("job concluído",
+			zap.String("job", job.Name),
+			zap.String("runId", run.ID),
+			zap.Int("affected", affected),
+			zap.Duration("duration", time.Since(start)),
+		)
+	}
+
+	// O resultado é registrado mesmo se o agendador estiver encerrando
+	if err := s.runRepo.Finish(context.WithoutCancel(ctx), run); err != nil {
+		s.logger.Error("erro ao registrar resultado do job", zap.String("job", job.Name), zap.String("runId", run.ID), zap.Error(err))
+	}
+}
+
+// runSafely executa o job convertendo panics em erro
+func (s *jobScheduler) runSafely(ctx context.Context, job Job) (affected int, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	return job.Run(ctx)
+}
+
+func (s *jobScheduler) findJob(name string) (Job, error) {
+	for _, job := range s.jobs {
+		if job.Name == name {
+			return job, nil
+		}
+	}
+	return Job{}, domainErrors.NewNotFoundError("Job")
+}
+
+func jobLockKey(name string) string {
+	return "cava:job:" + name
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	domainService "github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	"github.com/thiagomes07/CAVA/backend/internal/infra/document"
+	"go.uber.org/zap"
+)
+
+type packingListService struct {
+	packingListRepo repository.PackingListRepository
+	salesRepo       repository.SalesHistoryRepository
+	batchRepo       repository.BatchRepository
+	productRepo     repository.ProductRepository
+	slabRepo        repository.SlabRepository
+	remnantRepo     repository.RemnantRepository
+	industryRepo    repository.IndustryRepository
+	storageService  domainService.StorageService
+	logger          *zap.Logger
+}
+
+func NewPackingListService(
+	packingListRepo repository.PackingListRepository,
+	salesRepo repository.SalesHistoryRepository,
+	batchRepo repository.BatchRepository,
+	productRepo repository.ProductRepository,
+	slabRepo repository.SlabRepository,
+	remnantRepo repository.RemnantRepository,
+	industryRepo repository.IndustryRepository,
+	storageService domainService.StorageService,
+	logger *zap.Logger,
+) *packingListService {
+	return &packingListService{
+		packingListRepo: packingListRepo,
+		salesRepo:       salesRepo,
+		batchRepo:       batchRepo,
+		productRepo:     productRepo,
+		slabRepo:        slabRepo,
+		remnantRepo:     remnantRepo,
+		industryRepo:    industryRepo,
+		storageService:  storageService,
+		logger:          logger,
+	}
+}
+
+func (s *packingListService) Generate(ctx context.Context, industryID, saleID string, userID *string, input entity.GeneratePackingListInput) (*entity.PackingList, error) {
+	sale, err := s.salesRepo.FindByID(ctx, saleID)
+	if err != nil {
+		return nil, err
+	}
+	if sale.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+
+	// Sem transportadora/observações informadas, mantém as do romaneio anterior
+	carrier, notes := input.Carrier, input.Notes
+	if carrier == nil || notes == nil {
+		previous, err := s.packingListRepo.FindBySaleID(ctx, saleID)
+		if err != nil && !isNotFoundError(err) {
+			return nil, err
+		}
+		if previous != nil {
+			if carrier == nil {
+				carrier = previous.Document.Carrier
+			}
+			if notes == nil {
+				notes = previous.Document.Notes
+			}
+		}
+	}
+
+	doc, err := s.buildDocument(ctx, sale)
+	if err != nil {
+		return nil, err
+	}
+	doc.Carrier = carrier
+	doc.Notes = notes
+
+	pdfBytes, err := document.RenderPackingListPDF(*doc)
+	if err != nil {
+		s.logger.Error("erro ao gerar PDF do romaneio", zap.String("saleId", saleID), zap.Error(err))
+		return nil, domainErrors.InternalError(err)
+	}
+
+	jsonBytes, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, domainErrors.InternalError(err)
+	}
+
+	pdfURL, err := s.storageService.UploadPackingList(ctx, saleID, bytes.NewReader(pdfBytes), "romaneio.pdf", "application/pdf", int64(len(pdfBytes)))
+	if err != nil {
+		return nil, err
+	}
+
+	jsonURL, err := s.storageService.UploadPackingList(ctx, saleID, bytes.NewReader(jsonBytes), "romaneio.json", "application/json", int64(len(jsonBytes)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Na regeneração o upsert mantém o ID do romaneio existente
+	packingList := &entity.PackingList{
+		ID:                uuid.New().String(),
+		SaleID:            saleID,
+		IndustryID:        industryID,
+		Number:            doc.Number,
+		Document:          *doc,
+		PDFURL:            pdfURL,
+		JSONURL:           jsonURL,
+		GeneratedByUserID: userID,
+		GeneratedAt:       doc.GeneratedAt,
+	}
+
+	if err := s.packingListRepo.Upsert(ctx, packingList); err != nil {
+		s.logger.Error("erro ao salvar romaneio", zap.String("saleId", saleID), zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("romaneio gerado",
+		zap.String("saleId", saleID),
+		zap.String("number", packingList.Number),
+		zap.Int("items", len(doc.Items)),
+	)
+
+	return packingList, nil
+}
+
+func (s *packingListService) GetBySaleID(ctx context.Context, industryID, saleID string) (*entity.PackingList, error) {
+	packingList, err := s.packingListRepo.FindBySaleID(ctx, saleID)
+	if err != nil {
+		return nil, err
+	}
+	if packingList.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return packingList, nil
+}
+
+// buildDocument monta o conteúdo do romaneio a partir da venda, do lote e das chapas vendidas
+func (s *packingListService) buildDocument(ctx context.Context, sale *entity.Sale) (*entity.PackingListDocument, error) {
+	industry, err := s.industryRepo.FindByID(ctx, sale.IndustryID)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.batchRepo.FindByID(ctx, sale.BatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.productRepo.FindByID(ctx, batch.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	base := entity.PackingListItem{
+		BatchID:     batch.ID,
+		BatchCode:   batch.BatchCode,
+		ProductName: product.Name,
+		Material:    string(product.Material),
+		Finish:      string(product.Finish),
+	}
+
+	var items []entity.PackingListItem
+	switch {
+	case sale.RemnantID != nil:
+		remnant, err := s.remnantRepo.FindByID(ctx, *sale.RemnantID)
+		if err != nil {
+			return nil, err
+		}
+		item := base
+		item.RemnantCode = &remnant.Code
+		item.Quantity = 1
+		item.Height, item.Width, item.Thickness = remnant.Height, remnant.Width, remnant.Thickness
+		item.Area = remnant.Area
+		items = append(items, item)
+
+	default:
+		slabs, err := s.slabRepo.FindBySaleID(ctx, sale.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, slab := range slabs {
+			item := base
+			number := slab.SlabNumber
+			item.SlabNumber = &number
+			item.Quantity = 1
+			item.Height, item.Width, item.Thickness = slab.Height, slab.Width, slab.Thickness
+			item.Area = slab.Area
+			items = append(items, item)
+		}
+
+		// Lote sem rastreamento por chapa: uma linha agregada com as dimensões do lote
+		if len(slabs) == 0 {
+			item := base
+			item.Quantity = sale.QuantitySlabsSold
+			item.Height, item.Width, item.Thickness = batch.Height, batch.Width, batch.Thickness
+			item.Area = sale.TotalAreaSold
+			items = append(items, item)
+		}
+	}
+
+	doc := &entity.PackingListDocument{
+		Number:      entity.PackingListNumber(sale.ID),
+		SaleID:      sale.ID,
+		SaleDate:    sale.SaleDate,
+		Issuer:      industryParty(industry),
+		Customer:    entity.PackingListParty{Name: sale.CustomerName},
+		Items:       items,
+		GeneratedAt: time.Now(),
+	}
+	if sale.CustomerContact != "" {
+		doc.Customer.Contact = &sale.CustomerContact
+	}
+
+	for i := range doc.Items {
+		item := &doc.Items[i]
+		item.Weight = entity.EstimateSlabWeight(item.Area, item.Thickness)
+		doc.TotalSlabs += item.Quantity
+		doc.TotalArea += item.Area
+		doc.TotalWeight += item.Weight
+	}
+
+	return doc, nil
+}
+
+// industryParty monta o emitente do romaneio com os dados cadastrais da indústria
+func industryParty(industry *entity.Industry) entity.PackingListParty {
+	party := entity.PackingListParty{Document: industry.CNPJ}
+	if industry.Name != nil {
+		party.Name = *industry.Name
+	}
+
+	var address []string
+	street := strings.TrimSpace(stringValue(industry.AddressStreet) + ", " + stringValue(industry.AddressNumber))
+	if street = strings.Trim(street, ", "); street != "" {
+		address = append(address, street)
+	}
+	city := stringValue(industry.AddressCity)
+	if city == "" {
+		city = stringValue(industry.City)
+	}
+	state := stringValue(industry.AddressState)
+	if state == "" {
+		state = stringValue(industry.State)
+	}
+	if location := strings.Trim(city+" - "+state, " -"); location != "" {
+		address = append(address, location)
+	}
+	if zip := stringValue(industry.AddressZipCode); zip != "" {
+		address = append(address, "CEP "+zip)
+	}
+	if len(address) > 0 {
+		joined := strings.Join(address, " · ")
+		party.Address = &joined
+	}
+
+	var contact []string
+	for _, c := range []*string{industry.ContactPhone, industry.ContactEmail} {
+		if v := stringValue(c); v != "" {
+			contact = append(contact, v)
+		}
+	}
+	if len(contact) > 0 {
+		joined := strings.Join(contact, " · ")
+		party.Contact = &joined
+	}
+
+	return party
+}
+
+// generatePackingListAfterSale gera o romaneio após a venda confirmada; falhas não desfazem a venda
+// e o romaneio pode ser regerado depois pelo endpoint da venda
+func generatePackingListAfterSale(ctx context.Context, packingListService domainService.PackingListService, logger *zap.Logger, industryID, saleID, userID string, carrier *entity.PackingListCarrier) {
+	if packingListService == nil || saleID == "" {
+		return
+	}
+
+	if _, err := packingListService.Generate(ctx, industryID, saleID, &userID, entity.GeneratePackingListInput{Carrier: carrier}); err != nil {
+		logger.Warn("erro ao gerar romaneio da venda",
+			zap.String("saleId", saleID),
+			zap.Error(err),
+		)
+	}
+}
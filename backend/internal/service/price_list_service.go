@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+type priceListService struct {
+	priceListRepo repository.PriceListRepository
+	productRepo   repository.ProductRepository
+	userRepo      repository.UserRepository
+	clienteRepo   repository.ClienteRepository
+	db            BatchDB
+	logger        *zap.Logger
+}
+
+func NewPriceListService(
+	priceListRepo repository.PriceListRepository,
+	productRepo repository.ProductRepository,
+	userRepo repository.UserRepository,
+	clienteRepo repository.ClienteRepository,
+	db BatchDB,
+	logger *zap.Logger,
+) *priceListService {
+	return &priceListService{
+		priceListRepo: priceListRepo,
+		productRepo:   productRepo,
+		userRepo:      userRepo,
+		clienteRepo:   clienteRepo,
+		db:            db,
+		logger:        logger,
+	}
+}
+
+func (s *priceListService) Create(ctx context.Context, industryID string, input entity.CreatePriceListInput) (*entity.PriceList, error) {
+	name := strings.TrimSpace(input.Name)
+	if err := s.ensureUniqueName(ctx, industryID, name, ""); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.buildRules(ctx, industryID, input.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &entity.PriceList{
+		ID:          uuid.New().String(),
+		IndustryID:  industryID,
+		Name:        name,
+		Description: input.Description,
+		IsActive:    true,
+	}
+
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		if err := s.priceListRepo.Create(ctx, tx, list); err != nil {
+			return err
+		}
+		return s.priceListRepo.ReplaceRules(ctx, tx, list.ID, rules)
+	})
+	if err != nil {
+		s.logger.Error("erro ao criar tabela de preço",
+			zap.String("industryId", industryID),
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("tabela de preço criada",
+		zap.String("priceListId", list.ID),
+		zap.String("name", list.Name),
+		zap.Int("rules", len(rules)),
+	)
+
+	return s.priceListRepo.FindByID(ctx, list.ID)
+}
+
+func (s *priceListService) GetByID(ctx context.Context, industryID, id string) (*entity.PriceList, error) {
+	return s.findOwned(ctx, industryID, id)
+}
+
+func (s *priceListService) List(ctx context.Context, industryID string) ([]entity.PriceList, error) {
+	lists, err := s.priceListRepo.List(ctx, industryID)
+	if err != nil {
+		s.logger.Error("erro ao listar tabelas de preço",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return lists, nil
+}
+
+func (s *priceListService) Update(ctx context.Context, industryID, id string, input entity.UpdatePriceListInput) (*entity.PriceList, error) {
+	list, err := s.findOwned(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if !strings.EqualFold(name, list.Name) {
+			if err := s.ensureUniqueName(ctx, industryID, name, list.ID); err != nil {
+				return nil, err
+			}
+		}
+		list.Name = name
+	}
+	if input.Description != nil {
+		list.Description = input.Description
+	}
+	if input.IsActive != nil {
+		list.IsActive = *input.IsActive
+	}
+
+	var rules []entity.PriceListRule
+	if input.Rules != nil {
+		rules, err = s.buildRules(ctx, industryID, *input.Rules)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		if err := s.priceListRepo.Update(ctx, tx, list); err != nil {
+			return err
+		}
+		if input.Rules != nil {
+			return s.priceListRepo.ReplaceRules(ctx, tx, list.ID, rules)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("erro ao atualizar tabela de preço",
+			zap.String("priceListId", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("tabela de preço atualizada", zap.String("priceListId", id))
+
+	return s.priceListRepo.FindByID(ctx, id)
+}
+
+func (s *priceListService) Delete(ctx context.Context, industryID, id string) error {
+	if _, err := s.findOwned(ctx, industryID, id); err != nil {
+		return err
+	}
+
+	if err := s.priceListRepo.Delete(ctx, id); err != nil {
+		s.logger.Error("erro ao remover tabela de preço",
+			zap.String("priceListId", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	s.logger.Info("tabela de preço removida", zap.String("priceListId", id))
+
+	return nil
+}
+
+func (s *priceListService) ListAssignments(ctx context.Context, industryID, id string) ([]entity.PriceListAssignment, error) {
+	if _, err := s.findOwned(ctx, industryID, id); err != nil {
+		return nil, err
+	}
+	return s.priceListRepo.ListAssignments(ctx, id)
+}
+
+func (s *priceListService) Assign(ctx context.Context, industryID, id string, input entity.AssignPriceListInput) (*entity.PriceListAssignment, error) {
+	if _, err := s.findOwned(ctx, industryID, id); err != nil {
+		return nil, err
+	}
+
+	if (input.UserID == nil) == (input.ClienteID == nil) {
+		return nil, domainErrors.ValidationError("Informe userId ou clienteId")
+	}
+
+	if input.UserID != nil {
+		user, err := s.userRepo.FindByID(ctx, *input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		switch user.Role {
+		case entity.RoleBroker:
+			// Brokers podem atender várias indústrias
+		case entity.RoleVendedorInterno:
+			if user.IndustryID == nil || *user.IndustryID != industryID {
+				return nil, domainErrors.ForbiddenError()
+			}
+		default:
+			return nil, domainErrors.ValidationError("Tabela de preço só pode ser atribuída a brokers, vendedores internos ou clientes")
+		}
+	} else {
+		cliente, err := s.clienteRepo.FindByID(ctx, *input.ClienteID)
+		if err != nil {
+			return nil, err
+		}
+		if cliente.IndustryID != nil && *cliente.IndustryID != industryID {
+			return nil, domainErrors.ForbiddenError()
+		}
+	}
+
+	assignment := &entity.PriceListAssignment{
+		ID:          uuid.New().String(),
+		IndustryID:  industryID,
+		PriceListID: id,
+		UserID:      input.UserID,
+		ClienteID:   input.ClienteID,
+	}
+
+	if err := s.priceListRepo.Assign(ctx, assignment); err != nil {
+		s.logger.Error("erro ao atribuir tabela de preço",
+			zap.String("priceListId", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("tabela de preço atribuída",
+		zap.String("priceListId", id),
+		zap.String("assignmentId", assignment.ID),
+	)
+
+	return s.priceListRepo.FindAssignmentByID(ctx, assignment.ID)
+}
+
+func (s *priceListService) Unassign(ctx context.Context, industryID, assignmentID string) error {
+	assignment, err := s.priceListRepo.FindAssignmentByID(ctx, assignmentID)
+	if err != nil {
+		return err
+	}
+	if assignment.IndustryID != industryID {
+		return domainErrors.ForbiddenError()
+	}
+
+	if err := s.priceListRepo.DeleteAssignment(ctx, assignmentID); err != nil {
+		return err
+	}
+
+	s.logger.Info("atribuição de tabela de preço removida", zap.String("assignmentId", assignmentID))
+
+	return nil
+}
+
+func (s *priceListService) ResolveBatchPrice(ctx context.Context, batch *entity.Batch, userID string, clienteID *string) (*entity.ResolvedPrice, error) {
+	resolved := &entity.ResolvedPrice{Price: batch.IndustryPrice, PriceUnit: batch.PriceUnit, Currency: batch.Currency.OrDefault()}
+
+	list, err := s.assignedList(ctx, batch.IndustryID, userID, clienteID)
+	if err != nil || list == nil {
+		return resolved, err
+	}
+
+	product := batch.Product
+	if product == nil {
+		product, err = s.productRepo.FindByID(ctx, batch.ProductID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rule := list.RuleFor(product.ID, product.Material); rule != nil {
+		resolved.Price = rule.Apply(batch.IndustryPrice, batch.PriceUnit)
+		resolved.PriceListID, resolved.PriceListName = &list.ID, &list.Name
+	}
+
+	return resolved, nil
+}
+
+func (s *priceListService) ResolveProductPrice(ctx context.Context, product *entity.Product, userID string) (*entity.ResolvedPrice, error) {
+	if product.BasePrice == nil {
+		return nil, nil
+	}
+
+	unit := product.PriceUnit
+	if unit == "" {
+		unit = entity.PriceUnitM2
+	}
+	resolved := &entity.ResolvedPrice{Price: *product.BasePrice, PriceUnit: unit, Currency: product.Currency.OrDefault()}
+
+	list, err := s.assignedList(ctx, product.IndustryID, userID, nil)
+	if err != nil || list == nil {
+		return resolved, err
+	}
+
+	if rule := list.RuleFor(product.ID, product.Material); rule != nil {
+		resolved.Price = rule.Apply(*product.BasePrice, unit)
+		resolved.PriceListID, resolved.PriceListName = &list.ID, &list.Name
+	}
+
+	return resolved, nil
+}
+
+// assignedList retorna a tabela do cliente, com fallback para a do usuário
+func (s *priceListService) assignedList(ctx context.Context, industryID, userID string, clienteID *string) (*entity.PriceList, error) {
+	if clienteID != nil {
+		list, err := s.priceListRepo.FindActiveForCliente(ctx, industryID, *clienteID)
+		if err != nil || list != nil {
+			return list, err
+		}
+	}
+	if userID == "" {
+		return nil, nil
+	}
+	return s.priceListRepo.FindActiveForUser(ctx, industryID, userID)
+}
+
+func (s *priceListService) buildRules(ctx context.Context, industryID string, inputs []entity.PriceListRuleInput) ([]entity.PriceListRule, error) {
+	rules := make([]entity.PriceListRule, 0, len(inputs))
+	seen := make(map[string]bool, len(inputs))
+
+	for _, input := range inputs {
+		if !input.RuleType.IsValid() {
+			return nil, domainErrors.ValidationError("Tipo de regra inválido. Use PERCENTUAL ou FIXO")
+		}
+		if input.ProductID != nil && input.Material != nil {
+			return nil, domainErrors.ValidationError("Regra deve ser por produto ou por material, não ambos")
+		}
+
+		key := "*"
+		if input.ProductID != nil {
+			product, err := s.productRepo.FindByID(ctx, *input.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			if product.IndustryID != industryID {
+				return nil, domainErrors.ForbiddenError()
+			}
+			key = "product:" + product.ID
+		}
+		if input.Material != nil {
+			if !input.Material.IsValid() {
+				return nil, domainErrors.ValidationError("Material inválido")
+			}
+			key = "material:" + string(*input.Material)
+		}
+		if seen[key] {
+			return nil, domainErrors.ValidationError("Regras duplicadas para o mesmo produto, material ou regra geral")
+		}
+		seen[key] = true
+
+		rule := entity.PriceListRule{
+			ID:        uuid.New().String(),
+			ProductID: input.ProductID,
+			Material:  input.Material,
+			RuleType:  input.RuleType,
+			Value:     input.Value,
+		}
+
+		switch input.RuleType {
+		case entity.PriceRuleTypePercentual:
+			if input.Value <= -100 {
+				return nil, domainErrors.ValidationError("Ajuste percentual deve ser maior que -100")
+			}
+		case entity.PriceRuleTypeFixo:
+			if input.Value <= 0 {
+				return nil, domainErrors.ValidationError("Preço fixo deve ser maior que 0")
+			}
+			unit := entity.PriceUnitM2
+			if input.PriceUnit != nil {
+				unit = *input.PriceUnit
+			}
+			rule.PriceUnit = &unit
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (s *priceListService) ensureUniqueName(ctx context.Context, industryID, name, excludeID string) error {
+	exists, err := s.priceListRepo.ExistsByName(ctx, industryID, name, excludeID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return domainErrors.NewConflictError("Já existe uma tabela de preço com este nome")
+	}
+	return nil
+}
+
+func (s *priceListService) findOwned(ctx context.Context, industryID, id string) (*entity.PriceList, error) {
+	list, err := s.priceListRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if list.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return list, nil
+}
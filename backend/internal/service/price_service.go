@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+// scheduledPriceBatchSize limita as alterações aplicadas por execução do job
+const scheduledPriceBatchSize = 200
+
+type priceService struct {
+	priceRepo   repository.PriceHistoryRepository
+	productRepo repository.ProductRepository
+	batchRepo   repository.BatchRepository
+	db          BatchDB
+	logger      *zap.Logger
+}
+
+func NewPriceService(
+	priceRepo repository.PriceHistoryRepository,
+	productRepo repository.ProductRepository,
+	batchRepo repository.BatchRepository,
+	db BatchDB,
+	logger *zap.Logger,
+) *priceService {
+	return &priceService{
+		priceRepo:   priceRepo,
+		productRepo: productRepo,
+		batchRepo:   batchRepo,
+		db:          db,
+		logger:      logger,
+	}
+}
+
+func (s *priceService) GetProductHistory(ctx context.Context, industryID, productID string) ([]entity.PriceChange, error) {
+	if _, err := s.findProduct(ctx, industryID, productID); err != nil {
+		return nil, err
+	}
+	return s.priceRepo.FindByProductID(ctx, productID)
+}
+
+func (s *priceService) GetBatchHistory(ctx context.Context, industryID, batchID string) ([]entity.PriceChange, error) {
+	if _, err := s.findBatch(ctx, industryID, batchID); err != nil {
+		return nil, err
+	}
+	return s.priceRepo.FindByBatchID(ctx, batchID)
+}
+
+func (s *priceService) ScheduleProductPrice(ctx context.Context, industryID, productID, userID string, input entity.SchedulePriceChangeInput) (*entity.PriceChange, error) {
+	product, err := s.findProduct(ctx, industryID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := newScheduledChange(industryID, userID, input, product.PriceUnit)
+	if err != nil {
+		return nil, err
+	}
+	change.ProductID = &product.ID
+
+	return s.schedule(ctx, change)
+}
+
+func (s *priceService) ScheduleBatchPrice(ctx context.Context, industryID, batchID, userID string, input entity.SchedulePriceChangeInput) (*entity.PriceChange, error) {
+	batch, err := s.findBatch(ctx, industryID, batchID)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := newScheduledChange(industryID, userID, input, batch.PriceUnit)
+	if err != nil {
+		return nil, err
+	}
+	change.BatchID = &batch.ID
+
+	return s.schedule(ctx, change)
+}
+
+func (s *priceService) CancelScheduled(ctx context.Context, industryID, id string) error {
+	change, err := s.priceRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if change.IndustryID != industryID {
+		return domainErrors.ForbiddenError()
+	}
+	if !change.IsScheduled() {
+		return domainErrors.ValidationError("Apenas alterações agendadas podem ser canceladas")
+	}
+
+	if err := s.priceRepo.Cancel(ctx, nil, id); err != nil {
+		return err
+	}
+
+	s.logger.Info("alteração de preço cancelada", zap.String("priceChangeId", id))
+
+	return nil
+}
+
+func (s *priceService) ApplyScheduled(ctx context.Context) (int, error) {
+	applied := 0
+
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		due, err := s.priceRepo.FindDueForUpdate(ctx, tx, time.Now(), scheduledPriceBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range due {
+			var previousPrice *float64
+			var previousUnit *entity.PriceUnit
+
+			if change.BatchID != nil {
+				batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, *change.BatchID)
+				if err != nil {
+					if isNotFoundError(err) {
+						s.cancelOrphan(ctx, tx, change)
+						continue
+					}
+					return err
+				}
+				previousPrice, previousUnit = &batch.IndustryPrice, &batch.PriceUnit
+				err = s.batchRepo.UpdatePrice(ctx, tx, batch.ID, change.Price, change.PriceUnit)
+				if err != nil {
+					return err
+				}
+			} else if change.ProductID != nil {
+				product, err := s.productRepo.FindByID(ctx, *change.ProductID)
+				if err != nil {
+					if isNotFoundError(err) {
+						s.cancelOrphan(ctx, tx, change)
+						continue
+					}
+					return err
+				}
+				if product.BasePrice != nil {
+					previousPrice, previousUnit = product.BasePrice, &product.PriceUnit
+				}
+				if err := s.productRepo.UpdatePrice(ctx, tx, product.ID, change.Price, change.PriceUnit); err != nil {
+					return err
+				}
+			}
+
+			if err := s.priceRepo.MarkApplied(ctx, tx, change.ID, previousPrice, previousUnit); err != nil {
+				return err
+			}
+			applied++
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("erro ao aplicar preços agendados", zap.Error(err))
+		return 0, err
+	}
+
+	if applied > 0 {
+		s.logger.Info("preços agendados aplicados", zap.Int("count", applied))
+	}
+
+	return applied, nil
+}
+
+func (s *priceService) schedule(ctx context.Context, change *entity.PriceChange) (*entity.PriceChange, error) {
+	if err := s.priceRepo.Create(ctx, nil, change); err != nil {
+		s.logger.Error("erro ao agendar alteração de preço", zap.Error(err))
+		return nil, err
+	}
+
+	s.logger.Info("alteração de preço agendada",
+		zap.String("priceChangeId", change.ID),
+		zap.Float64("price", change.Price),
+		zap.Time("effectiveFrom", change.EffectiveFrom),
+	)
+
+	return s.priceRepo.FindByID(ctx, change.ID)
+}
+
+// cancelOrphan cancela agendamentos cujo produto/lote foi removido
+func (s *priceService) cancelOrphan(ctx context.Context, tx *sql.Tx, change entity.PriceChange) {
+	s.logger.Warn("alvo da alteração de preço não encontrado, cancelando agendamento",
+		zap.String("priceChangeId", change.ID),
+	)
+	if err := s.priceRepo.Cancel(ctx, tx, change.ID); err != nil {
+		s.logger.Error("erro ao cancelar agendamento órfão",
+			zap.String("priceChangeId", change.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *priceService) findProduct(ctx context.Context, industryID, productID string) (*entity.Product, error) {
+	product, err := s.productRepo.FindByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return product, nil
+}
+
+func (s *priceService) findBatch(ctx context.Context, industryID, batchID string) (*entity.Batch, error) {
+	batch, err := s.batchRepo.FindByID(ctx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return batch, nil
+}
+
+// newScheduledChange valida o agendamento e mantém a unidade atual quando não informada
+func newScheduledChange(industryID, userID string, input entity.SchedulePriceChangeInput, currentUnit entity.PriceUnit) (*entity.PriceChange, error) {
+	if input.Price <= 0 {
+		return nil, domainErrors.ValidationError("Preço deve ser maior que 0")
+	}
+
+	unit := currentUnit
+	if input.PriceUnit != "" {
+		if !input.PriceUnit.IsValid() {
+			return nil, domainErrors.ValidationError("Unidade de preço inválida. Use M2 ou FT2")
+		}
+		unit = input.PriceUnit
+	}
+	if unit == "" {
+		unit = entity.PriceUnitM2
+	}
+
+	effectiveFrom, err := time.Parse(time.RFC3339, input.EffectiveFrom)
+	if err != nil {
+		return nil, domainErrors.ValidationError("Data de vigência inválida")
+	}
+	if !effectiveFrom.After(time.Now()) {
+		return nil, domainErrors.ValidationError("Data de vigência deve ser futura")
+	}
+
+	return &entity.PriceChange{
+		ID:              uuid.New().String(),
+		IndustryID:      industryID,
+		Price:           input.Price,
+		PriceUnit:       unit,
+		EffectiveFrom:   effectiveFrom,
+		Status:          entity.PriceChangeStatusAgendado,
+		Notes:           input.Notes,
+		CreatedByUserID: &userID,
+	}, nil
+}
+
+// recordAppliedPrice registra no histórico um preço que passa a valer imediatamente
+func recordAppliedPrice(ctx context.Context, repo repository.PriceHistoryRepository, tx *sql.Tx, change *entity.PriceChange) error {
+	now := time.Now()
+	change.ID = uuid.New().String()
+	change.Status = entity.PriceChangeStatusAplicado
+	change.EffectiveFrom = now
+	change.AppliedAt = &now
+	if change.PriceUnit == "" {
+		change.PriceUnit = entity.PriceUnitM2
+	}
+	return repo.Create(ctx, tx, change)
+}
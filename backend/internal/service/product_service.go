@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,17 +15,23 @@ import (
 type productService struct {
 	productRepo repository.ProductRepository
 	mediaRepo   repository.MediaRepository
+	priceRepo   repository.PriceHistoryRepository
+	db          BatchDB
 	logger      *zap.Logger
 }
 
 func NewProductService(
 	productRepo repository.ProductRepository,
 	mediaRepo repository.MediaRepository,
+	priceRepo repository.PriceHistoryRepository,
+	db BatchDB,
 	logger *zap.Logger,
 ) *productService {
 	return &productService{
 		productRepo: productRepo,
 		mediaRepo:   mediaRepo,
+		priceRepo:   priceRepo,
+		db:          db,
 		logger:      logger,
 	}
 }
@@ -63,6 +70,7 @@ func (s *productService) Create(ctx context.Context, industryID string, input en
 		Description:     input.Description,
 		BasePrice:       input.BasePrice,
 		PriceUnit:       input.PriceUnit,
+		Currency:        input.Currency.OrDefault(),
 		IsPublic:        input.IsPublic,
 		IsPublicCatalog: input.IsPublicCatalog,
 		IsActive:        true,
@@ -75,7 +83,22 @@ func (s *productService) Create(ctx context.Context, industryID string, input en
 		product.PriceUnit = entity.PriceUnitM2
 	}
 
-	if err := s.productRepo.Create(ctx, product); err != nil {
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		if err := s.productRepo.Create(ctx, tx, product); err != nil {
+			return err
+		}
+
+		if product.BasePrice == nil {
+			return nil
+		}
+		return recordAppliedPrice(ctx, s.priceRepo, tx, &entity.PriceChange{
+			IndustryID: industryID,
+			ProductID:  &product.ID,
+			Price:      *product.BasePrice,
+			PriceUnit:  product.PriceUnit,
+		})
+	})
+	if err != nil {
 		s.logger.Error("erro ao criar produto",
 			zap.String("industryId", industryID),
 			zap.Error(err),
@@ -213,6 +236,8 @@ func (s *productService) Update(ctx context.Context, id string, input entity.Upd
 		product.Description = input.Description
 	}
 
+	previousPrice, previousUnit := product.BasePrice, product.PriceUnit
+
 	if input.BasePrice != nil {
 		product.BasePrice = input.BasePrice
 	}
@@ -221,6 +246,10 @@ func (s *productService) Update(ctx context.Context, id string, input entity.Upd
 		product.PriceUnit = *input.PriceUnit
 	}
 
+	if input.Currency != nil {
+		product.Currency = *input.Currency
+	}
+
 	if input.IsPublic != nil {
 		product.IsPublic = *input.IsPublic
 	}
@@ -231,8 +260,29 @@ func (s *productService) Update(ctx context.Context, id string, input entity.Upd
 
 	product.UpdatedAt = time.Now()
 
-	// Salvar alterações
-	if err := s.productRepo.Update(ctx, product); err != nil {
+	// Salvar alterações e histórico de preço juntos
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		if err := s.productRepo.Update(ctx, tx, product); err != nil {
+			return err
+		}
+
+		// Alteração direta do preço base entra no histórico com vigência imediata
+		if product.BasePrice == nil || (previousPrice != nil && *previousPrice == *product.BasePrice && previousUnit == product.PriceUnit) {
+			return nil
+		}
+		change := &entity.PriceChange{
+			IndustryID:    product.IndustryID,
+			ProductID:     &product.ID,
+			Price:         *product.BasePrice,
+			PriceUnit:     product.PriceUnit,
+			PreviousPrice: previousPrice,
+		}
+		if previousPrice != nil {
+			change.PreviousPriceUnit = &previousUnit
+		}
+		return recordAppliedPrice(ctx, s.priceRepo, tx, change)
+	})
+	if err != nil {
 		s.logger.Error("erro ao atualizar produto",
 			zap.String("productId", id),
 			zap.Error(err),
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+// maxRemnantPhotos limita as fotos anexadas a um retalho
+const maxRemnantPhotos = 10
+
+type remnantService struct {
+	remnantRepo repository.RemnantRepository
+	batchRepo   repository.BatchRepository
+	db          BatchDB
+	logger      *zap.Logger
+}
+
+func NewRemnantService(
+	remnantRepo repository.RemnantRepository,
+	batchRepo repository.BatchRepository,
+	db BatchDB,
+	logger *zap.Logger,
+) *remnantService {
+	return &remnantService{
+		remnantRepo: remnantRepo,
+		batchRepo:   batchRepo,
+		db:          db,
+		logger:      logger,
+	}
+}
+
+func (s *remnantService) Create(ctx context.Context, industryID, userID string, input entity.CreateRemnantInput) (*entity.Remnant, error) {
+	var remnant *entity.Remnant
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		// Lock no lote de origem serializa o sequencial dos retalhos
+		batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, input.SourceBatchID)
+		if err != nil {
+			return err
+		}
+		if batch.IndustryID != industryID {
+			return domainErrors.ForbiddenError()
+		}
+		if batch.DeletedAt != nil {
+			return domainErrors.ValidationError("Lote de origem foi excluído")
+		}
+
+		// Valores não informados seguem o lote de origem
+		remnant = &entity.Remnant{
+			ID:              uuid.New().String(),
+			IndustryID:      industryID,
+			SourceBatchID:   batch.ID,
+			BatchCode:       batch.BatchCode,
+			Height:          input.Height,
+			Width:           input.Width,
+			Thickness:       batch.Thickness,
+			Area:            truncateArea(entity.BoundingArea(input.Height, input.Width)),
+			IndustryPrice:   batch.IndustryPrice,
+			PriceUnit:       batch.PriceUnit,
+			Currency:        batch.Currency.OrDefault(),
+			Status:          entity.BatchStatusDisponivel,
+			Notes:           input.Notes,
+			CreatedByUserID: &userID,
+		}
+		if input.Thickness != nil {
+			remnant.Thickness = *input.Thickness
+		}
+		if input.Area != nil {
+			remnant.Area = truncateArea(*input.Area)
+		}
+		if input.IndustryPrice != nil {
+			remnant.IndustryPrice = *input.IndustryPrice
+		}
+		if input.PriceUnit != nil {
+			remnant.PriceUnit = *input.PriceUnit
+		}
+		if input.Currency != nil {
+			remnant.Currency = *input.Currency
+		}
+
+		if err := validateRemnantArea(remnant); err != nil {
+			return err
+		}
+
+		return s.remnantRepo.Create(ctx, tx, remnant)
+	})
+	if err != nil {
+		s.logger.Error("erro ao cadastrar retalho",
+			zap.String("batchId", input.SourceBatchID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("retalho cadastrado",
+		zap.String("remnantId", remnant.ID),
+		zap.String("code", remnant.Code),
+		zap.String("batchId", remnant.SourceBatchID),
+		zap.Float64("area", remnant.Area),
+	)
+
+	return s.remnantRepo.FindByID(ctx, remnant.ID)
+}
+
+func (s *remnantService) GetByID(ctx context.Context, industryID, id string) (*entity.Remnant, error) {
+	remnant, err := s.remnantRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if remnant.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return remnant, nil
+}
+
+func (s *remnantService) List(ctx context.Context, industryID string, filters entity.RemnantFilters) (*entity.RemnantListResponse, error) {
+	remnants, total, err := s.remnantRepo.List(ctx, industryID, filters)
+	if err != nil {
+		s.logger.Error("erro ao listar retalhos",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &entity.RemnantListResponse{
+		Remnants: remnants,
+		Total:    total,
+		Page:     filters.Page,
+	}, nil
+}
+
+func (s *remnantService) Update(ctx context.Context, industryID, id string, input entity.UpdateRemnantInput) (*entity.Remnant, error) {
+	remnant, err := s.GetByID(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reservados e vendidos seguem o fluxo de reservas
+	if remnant.Status == entity.BatchStatusReservado || remnant.Status == entity.BatchStatusVendido {
+		return nil, domainErrors.ValidationError("Retalhos reservados ou vendidos não podem ser alterados")
+	}
+
+	dimensionsChanged := input.Height != nil || input.Width != nil
+	if input.Height != nil {
+		remnant.Height = *input.Height
+	}
+	if input.Width != nil {
+		remnant.Width = *input.Width
+	}
+	if input.Thickness != nil {
+		remnant.Thickness = *input.Thickness
+	}
+	if input.Area != nil {
+		remnant.Area = truncateArea(*input.Area)
+	} else if dimensionsChanged {
+		// Sem área informada, a peça passa a ocupar o novo retângulo
+		remnant.Area = truncateArea(entity.BoundingArea(remnant.Height, remnant.Width))
+	}
+	if input.IndustryPrice != nil {
+		remnant.IndustryPrice = *input.IndustryPrice
+	}
+	if input.PriceUnit != nil {
+		remnant.PriceUnit = *input.PriceUnit
+	}
+	if input.Currency != nil {
+		remnant.Currency = *input.Currency
+	}
+	if input.Status != nil {
+		remnant.Status = *input.Status
+	}
+	if input.Notes != nil {
+		remnant.Notes = input.Notes
+	}
+
+	if err := validateRemnantArea(remnant); err != nil {
+		return nil, err
+	}
+
+	if err := s.remnantRepo.Update(ctx, remnant); err != nil {
+		s.logger.Error("erro ao atualizar retalho",
+			zap.String("remnantId", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return s.remnantRepo.FindByID(ctx, id)
+}
+
+func (s *remnantService) Delete(ctx context.Context, industryID, id string) error {
+	remnant, err := s.GetByID(ctx, industryID, id)
+	if err != nil {
+		return err
+	}
+
+	hasSales, err := s.remnantRepo.HasSales(ctx, remnant.ID)
+	if err != nil {
+		return err
+	}
+	if hasSales {
+		return domainErrors.NewConflictError("Não foi possível excluir este retalho pois ele possui reservas ou vendas. Inative-o")
+	}
+
+	if err := s.remnantRepo.Delete(ctx, id); err != nil {
+		s.logger.Error("erro ao excluir retalho",
+			zap.String("remnantId", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	s.logger.Info("retalho excluído", zap.String("remnantId", id))
+	return nil
+}
+
+func (s *remnantService) AddPhotos(ctx context.Context, industryID, id string, urls []string) (*entity.Remnant, error) {
+	remnant, err := s.GetByID(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(remnant.Photos)+len(urls) > maxRemnantPhotos {
+		return nil, domainErrors.ValidationError(fmt.Sprintf("Máximo de %d fotos por retalho", maxRemnantPhotos))
+	}
+
+	if err := s.remnantRepo.AddPhotos(ctx, id, urls); err != nil {
+		s.logger.Error("erro ao anexar fotos do retalho",
+			zap.String("remnantId", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return s.remnantRepo.FindByID(ctx, id)
+}
+
+func (s *remnantService) RemovePhoto(ctx context.Context, industryID, id, photoID string) error {
+	if _, err := s.GetByID(ctx, industryID, id); err != nil {
+		return err
+	}
+
+	return s.remnantRepo.RemovePhoto(ctx, id, photoID)
+}
+
+// validateRemnantArea garante que a área útil cabe no retângulo da peça
+func validateRemnantArea(remnant *entity.Remnant) error {
+	if remnant.Area > truncateArea(entity.BoundingArea(remnant.Height, remnant.Width)) {
+		return domainErrors.ValidationError("Área do retalho não pode exceder altura × largura")
+	}
+	return nil
+}
+
+// truncateArea trunca a área para a precisão armazenada (4 casas, em m²), sem ultrapassar altura × largura
+func truncateArea(area float64) float64 {
+	return math.Floor(area*10000+1e-6) / 10000
+}
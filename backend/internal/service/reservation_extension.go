@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"go.uber.org/zap"
+)
+
+func (s *reservationService) RequestExtension(ctx context.Context, reservationID, userID string, input entity.RequestReservationExtensionInput) (*entity.ReservationExtension, error) {
+	reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	industryID, err := s.reservationIndustryID(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	if reservation.ReservedByUserID != userID {
+		user, err := s.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user.Role != entity.RoleAdminIndustria || user.IndustryID == nil || *user.IndustryID != industryID {
+			return nil, domainErrors.ForbiddenError()
+		}
+	}
+
+	if !reservation.Status.CanBeConverted() {
+		return nil, domainErrors.ValidationError("Apenas reservas ativas ou aprovadas podem ser prorrogadas")
+	}
+
+	requestedExpiresAt, err := time.Parse(time.RFC3339, input.ExpiresAt)
+	if err != nil {
+		return nil, domainErrors.ValidationError("Data de expiração inválida")
+	}
+	if !requestedExpiresAt.After(reservation.ExpiresAt) || !requestedExpiresAt.After(time.Now()) {
+		return nil, domainErrors.ValidationError("Novo prazo deve ser posterior ao prazo atual da reserva")
+	}
+
+	industry, err := s.industryRepo.FindByID(ctx, industryID)
+	if err != nil {
+		return nil, err
+	}
+	policy := industry.ReservationPolicy
+
+	if err := s.checkExtensionLimit(ctx, nil, reservation.ID, policy); err != nil {
+		return nil, err
+	}
+	if requestedExpiresAt.Sub(reservation.ExpiresAt) > time.Duration(policy.MaxExtensionDays)*24*time.Hour {
+		return nil, domainErrors.ValidationError(fmt.Sprintf("Cada prorrogação pode adicionar no máximo %d dia(s) ao prazo", policy.MaxExtensionDays))
+	}
+
+	extension := &entity.ReservationExtension{
+		ID:                 uuid.New().String(),
+		ReservationID:      reservation.ID,
+		IndustryID:         industryID,
+		RequestedByUserID:  userID,
+		Justification:      input.Justification,
+		OldExpiresAt:       reservation.ExpiresAt,
+		RequestedExpiresAt: requestedExpiresAt,
+		Status:             entity.ReservationExtensionStatusPendente,
+	}
+
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		if err := s.extensionRepo.Create(ctx, tx, extension); err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, tx, entity.ReservationEvent{
+			ReservationID: reservation.ID,
+			Type:          entity.ReservationEventProrrogacaoSolicitada,
+			ActorUserID:   &userID,
+			OldExpiresAt:  &reservation.ExpiresAt,
+			NewExpiresAt:  &requestedExpiresAt,
+			Notes:         &input.Justification,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("prorrogação de reserva solicitada",
+		zap.String("extensionId", extension.ID),
+		zap.String("reservationId", reservation.ID),
+		zap.String("userId", userID),
+		zap.Time("requestedExpiresAt", requestedExpiresAt),
+	)
+
+	return s.extensionRepo.FindByID(ctx, extension.ID)
+}
+
+func (s *reservationService) ApproveExtension(ctx context.Context, industryID, extensionID, approverID string) (*entity.ReservationExtension, error) {
+	extension, err := s.pendingExtension(ctx, industryID, extensionID)
+	if err != nil {
+		return nil, err
+	}
+
+	industry, err := s.industryRepo.FindByID(ctx, industryID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		// Lock na reserva: aprovações concorrentes contam as prorrogações e leem o prazo atual em série
+		reservation, err := s.reservationRepo.FindByIDForUpdate(ctx, tx, extension.ReservationID)
+		if err != nil {
+			return err
+		}
+
+		if !reservation.Status.CanBeConverted() {
+			return domainErrors.ValidationError("Apenas reservas ativas ou aprovadas podem ser prorrogadas")
+		}
+		if !extension.RequestedExpiresAt.After(time.Now()) {
+			return domainErrors.ValidationError("O prazo solicitado já passou; é necessário um novo pedido de prorrogação")
+		}
+
+		// A política pode ter mudado desde o pedido
+		if err := s.checkExtensionLimit(ctx, tx, reservation.ID, industry.ReservationPolicy); err != nil {
+			return err
+		}
+
+		if err := s.reservationRepo.UpdateExpiresAt(ctx, tx, reservation.ID, extension.RequestedExpiresAt); err != nil {
+			return err
+		}
+
+		if err := s.extensionRepo.Approve(ctx, tx, extension.ID, approverID, reservation.ExpiresAt, extension.RequestedExpiresAt); err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, tx, entity.ReservationEvent{
+			ReservationID: reservation.ID,
+			Type:          entity.ReservationEventProrrogada,
+			ActorUserID:   &approverID,
+			OldExpiresAt:  &reservation.ExpiresAt,
+			NewExpiresAt:  &extension.RequestedExpiresAt,
+		})
+	})
+	if err != nil {
+		s.logger.Error("erro ao aprovar prorrogação de reserva",
+			zap.String("extensionId", extensionID),
+			zap.String("approverId", approverID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("prorrogação de reserva aprovada",
+		zap.String("extensionId", extensionID),
+		zap.String("reservationId", extension.ReservationID),
+		zap.String("approverId", approverID),
+		zap.Time("expiresAt", extension.RequestedExpiresAt),
+	)
+
+	return s.extensionRepo.FindByID(ctx, extensionID)
+}
+
+func (s *reservationService) DenyExtension(ctx context.Context, industryID, extensionID, approverID, reason string) (*entity.ReservationExtension, error) {
+	extension, err := s.pendingExtension(ctx, industryID, extensionID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		if err := s.extensionRepo.Deny(ctx, tx, extension.ID, approverID, reason); err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, tx, entity.ReservationEvent{
+			ReservationID: extension.ReservationID,
+			Type:          entity.ReservationEventProrrogacaoNegada,
+			ActorUserID:   &approverID,
+			OldExpiresAt:  &extension.OldExpiresAt,
+			Notes:         &reason,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("prorrogação de reserva negada",
+		zap.String("extensionId", extensionID),
+		zap.String("reservationId", extension.ReservationID),
+		zap.String("approverId", approverID),
+	)
+
+	return s.extensionRepo.FindByID(ctx, extensionID)
+}
+
+func (s *reservationService) ListExtensions(ctx context.Context, filters entity.ReservationExtensionFilters) ([]entity.ReservationExtension, error) {
+	extensions, err := s.extensionRepo.List(ctx, filters)
+	if err != nil {
+		s.logger.Error("erro ao listar prorrogações de reservas", zap.Error(err))
+		return nil, err
+	}
+	return extensions, nil
+}
+
+// pendingExtension busca um pedido pendente da indústria do admin
+func (s *reservationService) pendingExtension(ctx context.Context, industryID, extensionID string) (*entity.ReservationExtension, error) {
+	extension, err := s.extensionRepo.FindByID(ctx, extensionID)
+	if err != nil {
+		return nil, err
+	}
+	if extension.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	if extension.Status != entity.ReservationExtensionStatusPendente {
+		return nil, domainErrors.ValidationError("Pedido de prorrogação já foi decidido")
+	}
+	return extension, nil
+}
+
+// checkExtensionLimit verifica o limite de prorrogações aprovadas por reserva
+func (s *reservationService) checkExtensionLimit(ctx context.Context, tx *sql.Tx, reservationID string, policy entity.ReservationPolicy) error {
+	if policy.MaxExtensions == 0 {
+		return domainErrors.ValidationError("A indústria não permite prorrogação de reservas")
+	}
+
+	approved, err := s.extensionRepo.CountApproved(ctx, tx, reservationID)
+	if err != nil {
+		return err
+	}
+	if approved >= policy.MaxExtensions {
+		return domainErrors.ValidationError(fmt.Sprintf("Limite de %d prorrogação(ões) por reserva atingido", policy.MaxExtensions))
+	}
+	return nil
+}
+
+// reservationIndustryID retorna a indústria da reserva (reservas antigas não gravam industry_id)
+func (s *reservationService) reservationIndustryID(ctx context.Context, reservation *entity.Reservation) (string, error) {
+	if reservation.IndustryID != nil {
+		return *reservation.IndustryID, nil
+	}
+	batch, err := s.batchRepo.FindByID(ctx, reservation.BatchID)
+	if err != nil {
+		return "", err
+	}
+	return batch.IndustryID, nil
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	infraEmail "github.com/thiagomes07/CAVA/backend/internal/infra/email"
+	"go.uber.org/zap"
+)
+
+func (s *reservationService) ListOffers(ctx context.Context, reservationID, userID string) ([]entity.ReservationOffer, error) {
+	reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.negotiationSide(ctx, reservation, userID); err != nil {
+		return nil, err
+	}
+
+	offers, err := s.offerRepo.FindByReservation(ctx, reservationID)
+	if err != nil {
+		s.logger.Error("erro ao listar propostas da reserva",
+			zap.String("reservationId", reservationID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return offers, nil
+}
+
+func (s *reservationService) MakeOffer(ctx context.Context, reservationID, userID string, input entity.MakeReservationOfferInput) (*entity.ReservationOffer, error) {
+	var offer *entity.ReservationOffer
+	var reservation *entity.Reservation
+
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		res, err := s.reservationRepo.FindByIDForUpdate(ctx, tx, reservationID)
+		if err != nil {
+			return err
+		}
+		reservation = res
+
+		side, err := s.negotiationSide(ctx, res, userID)
+		if err != nil {
+			return err
+		}
+
+		if res.Status != entity.ReservationStatusPendenteAprovacao {
+			return domainErrors.ValidationError("Apenas reservas pendentes de aprovação podem ser negociadas")
+		}
+		// Prazo de aprovação vencido: a reserva aguarda apenas o job de expiração
+		if res.IsApprovalExpired() {
+			return domainErrors.ReservationExpiredError()
+		}
+
+		// A nova proposta substitui a que aguardava resposta (do outro lado ou a própria)
+		previous, err := s.offerRepo.FindPendingForUpdate(ctx, tx, reservationID)
+		if err != nil {
+			return err
+		}
+		previousPrice := res.ReservedPrice
+		if previous != nil {
+			previousPrice = &previous.Price
+			if err := s.offerRepo.ClosePending(ctx, tx, reservationID, entity.ReservationOfferStatusSubstituida); err != nil {
+				return err
+			}
+		}
+
+		offer = &entity.ReservationOffer{
+			ID:              uuid.New().String(),
+			ReservationID:   reservationID,
+			OfferedByUserID: userID,
+			Side:            side,
+			Price:           input.Price,
+			Notes:           input.Notes,
+			Status:          entity.ReservationOfferStatusPendente,
+		}
+		if err := s.offerRepo.Create(ctx, tx, offer); err != nil {
+			return err
+		}
+
+		return s.recordEvent(ctx, tx, entity.ReservationEvent{
+			ReservationID: reservationID,
+			Type:          entity.ReservationEventPropostaPreco,
+			ActorUserID:   &userID,
+			OldPrice:      previousPrice,
+			NewPrice:      &input.Price,
+			Notes:         input.Notes,
+		})
+	})
+	if err != nil {
+		s.logger.Error("erro ao registrar proposta de preço",
+			zap.String("reservationId", reservationID),
+			zap.String("userId", userID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("proposta de preço registrada",
+		zap.String("offerId", offer.ID),
+		zap.String("reservationId", reservationID),
+		zap.String("side", string(offer.Side)),
+		zap.Float64("price", offer.Price),
+	)
+
+	s.notifyOffer(ctx, reservation, offer)
+
+	return offer, nil
+}
+
+func (s *reservationService) AcceptOffer(ctx context.Context, reservationID, userID string) (*entity.Reservation, error) {
+	reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	side, err := s.negotiationSide(ctx, reservation, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Para a indústria, aceitar a proposta de quem reservou é aprovar a reserva
+	if side == entity.ReservationOfferSideIndustria {
+		return s.Approve(ctx, reservationID, userID)
+	}
+
+	var offer *entity.ReservationOffer
+	err = s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		res, err := s.reservationRepo.FindByIDForUpdate(ctx, tx, reservationID)
+		if err != nil {
+			return err
+		}
+
+		if res.Status != entity.ReservationStatusPendenteAprovacao {
+			return domainErrors.ValidationError("Apenas reservas pendentes de aprovação podem ser negociadas")
+		}
+		if res.IsApprovalExpired() {
+			return domainErrors.ReservationExpiredError()
+		}
+
+		offer, err = s.offerRepo.FindPendingForUpdate(ctx, tx, reservationID)
+		if err != nil {
+			return err
+		}
+		if offer == nil || offer.Side != entity.ReservationOfferSideIndustria {
+			return domainErrors.ValidationError("Não há contraproposta da indústria aguardando sua resposta")
+		}
+
+		// A contraproposta já é a concordância da indústria: quem a fez consta como aprovador
+		return s.approvePending(ctx, tx, res, offer.OfferedByUserID, userID, offer)
+	})
+	if err != nil {
+		s.logger.Error("erro ao aceitar contraproposta",
+			zap.String("reservationId", reservationID),
+			zap.String("userId", userID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	approved, err := s.GetByID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyReservationApproved(ctx, approved)
+	s.notifyOfferAccepted(ctx, approved, offer)
+
+	return approved, nil
+}
+
+// approvePending aprova a reserva pendente; o preço acordado só é registrado quando há proposta aceita.
+// O prazo segue a política da indústria a partir da aprovação
+func (s *reservationService) approvePending(ctx context.Context, tx *sql.Tx, reservation *entity.Reservation, approverID, acceptedByUserID string, offer *entity.ReservationOffer) error {
+	industryID, err := s.reservationIndustryID(ctx, reservation)
+	if err != nil {
+		return err
+	}
+	policy, err := s.reservationPolicy(ctx, industryID)
+	if err != nil {
+		return err
+	}
+	expiresAt := policy.DefaultExpiry(time.Now())
+
+	var agreedPrice *float64
+	if offer != nil {
+		if err := s.offerRepo.Accept(ctx, tx, offer.ID, acceptedByUserID); err != nil {
+			return err
+		}
+		if err := s.recordEvent(ctx, tx, entity.ReservationEvent{
+			ReservationID: reservation.ID,
+			Type:          entity.ReservationEventPropostaAceita,
+			ActorUserID:   &acceptedByUserID,
+			NewPrice:      &offer.Price,
+		}); err != nil {
+			return err
+		}
+		agreedPrice = &offer.Price
+	}
+
+	if err := s.reservationRepo.Approve(ctx, tx, reservation.ID, approverID, expiresAt, agreedPrice); err != nil {
+		return err
+	}
+
+	if err := s.recordEvent(ctx, tx, entity.ReservationEvent{
+		ReservationID: reservation.ID,
+		Type:          entity.ReservationEventAprovada,
+		FromStatus:    reservation.Status,
+		ToStatus:      entity.ReservationStatusAprovada,
+		ActorUserID:   &approverID,
+		NewPrice:      agreedPrice,
+		NewExpiresAt:  &expiresAt,
+	}); err != nil {
+		return err
+	}
+
+	s.logger.Info("reserva aprovada",
+		zap.String("reservationId", reservation.ID),
+		zap.String("approverId", approverID),
+		zap.Time("expiresAt", expiresAt),
+	)
+
+	return nil
+}
+
+// negotiationSide identifica o lado do usuário na negociação: quem reservou ou admin da indústria da reserva
+func (s *reservationService) negotiationSide(ctx context.Context, reservation *entity.Reservation, userID string) (entity.ReservationOfferSide, error) {
+	if reservation.ReservedByUserID == userID {
+		return entity.ReservationOfferSideBroker, nil
+	}
+
+	industryID, err := s.reservationIndustryID(ctx, reservation)
+	if err != nil {
+		return "", err
+	}
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if user.Role != entity.RoleAdminIndustria || user.IndustryID == nil || *user.IndustryID != industryID {
+		return "", domainErrors.ForbiddenError()
+	}
+	return entity.ReservationOfferSideIndustria, nil
+}
+
+// notifyOffer avisa o outro lado da negociação sobre a nova proposta
+func (s *reservationService) notifyOffer(ctx context.Context, reservation *entity.Reservation, offer *entity.ReservationOffer) {
+	if s.emailSender == nil {
+		return
+	}
+
+	description := s.describeReservation(ctx, reservation)
+	if offer.Side == entity.ReservationOfferSideBroker {
+		requester := "Quem reservou"
+		if user, err := s.userRepo.FindByID(ctx, offer.OfferedByUserID); err == nil {
+			requester = user.Name
+		}
+		s.notifyAdmins(ctx, reservation, "Nova proposta de preço", fmt.Sprintf(
+			"%s propôs %.2f por m² para a reserva de %s. A reserva aguarda aprovação até %s.",
+			requester, offer.Price, description, formatOptionalTime(reservation.ApprovalExpiresAt),
+		))
+		return
+	}
+
+	err := s.sendReservationEmail(ctx, reservation.ReservedByUserID, "Contraproposta de preço", infraEmail.NotificationData{
+		Title: "Contraproposta de preço",
+		Message: fmt.Sprintf("A indústria propôs %.2f por m² para sua reserva de %s. Aceite ou envie uma nova proposta até %s.",
+			offer.Price, description, formatOptionalTime(reservation.ApprovalExpiresAt)),
+	})
+	if err != nil {
+		s.logger.Warn("erro ao enviar email de contraproposta", zap.String("reservationId", reservation.ID), zap.Error(err))
+	}
+}
+
+// notifyOfferAccepted avisa o admin que fez a contraproposta que ela foi aceita
+func (s *reservationService) notifyOfferAccepted(ctx context.Context, reservation *entity.Reservation, offer *entity.ReservationOffer) {
+	if s.emailSender == nil {
+		return
+	}
+
+	err := s.sendReservationEmail(ctx, offer.OfferedByUserID, "Contraproposta aceita", infraEmail.NotificationData{
+		Title: "Contraproposta aceita",
+		Message: fmt.Sprintf("A contraproposta de %.2f por m² para a reserva de %s foi aceita e a reserva está aprovada.",
+			offer.Price, s.describeReservation(ctx, reservation)),
+	})
+	if err != nil {
+		s.logger.Warn("erro ao enviar email de contraproposta aceita", zap.String("reservationId", reservation.ID), zap.Error(err))
+	}
+}
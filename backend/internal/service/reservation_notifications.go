@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainService "github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	infraEmail "github.com/thiagomes07/CAVA/backend/internal/infra/email"
+	"go.uber.org/zap"
+)
+
+// sendReservationEmail envia uma notificação de reserva ao usuário informado
+func (s *reservationService) sendReservationEmail(ctx context.Context, userID, subject string, data infraEmail.NotificationData) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	data.UserName = user.Name
+	if data.ActionURL == "" {
+		data.ActionURL = s.frontendURL + "/reservations"
+		data.ActionLabel = "Ver reservas"
+	}
+
+	htmlBody, textBody, err := infraEmail.RenderNotificationEmail(data)
+	if err != nil {
+		return err
+	}
+
+	return s.emailSender.Send(ctx, domainService.EmailMessage{
+		To:       user.Email,
+		Subject:  subject + " - CAVA Stone Platform",
+		HTMLBody: htmlBody,
+		TextBody: textBody,
+	})
+}
+
+// describeReservation descreve o que foi reservado (ex: "3 chapa(s) do lote GRA-000123")
+func (s *reservationService) describeReservation(ctx context.Context, reservation *entity.Reservation) string {
+	if reservation.RemnantID != nil {
+		remnant := reservation.Remnant
+		if remnant == nil {
+			found, err := s.remnantRepo.FindByID(ctx, *reservation.RemnantID)
+			if err != nil {
+				return "retalho reservado"
+			}
+			remnant = found
+		}
+		return fmt.Sprintf("retalho %s", remnant.Code)
+	}
+
+	batch := reservation.Batch
+	if batch == nil {
+		found, err := s.batchRepo.FindByID(ctx, reservation.BatchID)
+		if err != nil {
+			return fmt.Sprintf("%d chapa(s)", reservation.QuantitySlabsReserved)
+		}
+		batch = found
+	}
+	return fmt.Sprintf("%d chapa(s) do lote %s", reservation.QuantitySlabsReserved, batch.BatchCode)
+}
+
+// notifyReservationApproved avisa quem reservou que a reserva foi aprovada
+func (s *reservationService) notifyReservationApproved(ctx context.Context, reservation *entity.Reservation) {
+	if s.emailSender == nil {
+		return
+	}
+
+	err := s.sendReservationEmail(ctx, reservation.ReservedByUserID, "Reserva aprovada", infraEmail.NotificationData{
+		Title: "Reserva aprovada",
+		Message: fmt.Sprintf("Sua reserva de %s foi aprovada e vence em %s.",
+			s.describeReservation(ctx, reservation), reservation.ExpiresAt.Format("02/01/2006 15:04")),
+	})
+	if err != nil {
+		s.logger.Warn("erro ao enviar email de reserva aprovada", zap.String("reservationId", reservation.ID), zap.Error(err))
+	}
+}
+
+// notifyReservationRejected avisa quem reservou que a reserva foi rejeitada, com o motivo
+func (s *reservationService) notifyReservationRejected(ctx context.Context, reservation *entity.Reservation, reason string) {
+	if s.emailSender == nil {
+		return
+	}
+
+	err := s.sendReservationEmail(ctx, reservation.ReservedByUserID, "Reserva rejeitada", infraEmail.NotificationData{
+		Title: "Reserva rejeitada",
+		Message: fmt.Sprintf("Sua reserva de %s foi rejeitada e as chapas voltaram ao estoque. Motivo: %s",
+			s.describeReservation(ctx, reservation), reason),
+	})
+	if err != nil {
+		s.logger.Warn("erro ao enviar email de reserva rejeitada", zap.String("reservationId", reservation.ID), zap.Error(err))
+	}
+}
+
+// notifyReservationExpired avisa quem reservou que a reserva expirou
+func (s *reservationService) notifyReservationExpired(ctx context.Context, reservation *entity.Reservation) {
+	if s.emailSender == nil {
+		return
+	}
+
+	message := fmt.Sprintf("Sua reserva de %s expirou e as chapas voltaram ao estoque.", s.describeReservation(ctx, reservation))
+	if reservation.Status == entity.ReservationStatusPendenteAprovacao {
+		message = fmt.Sprintf("Sua reserva de %s não foi aprovada dentro do prazo e expirou.", s.describeReservation(ctx, reservation))
+	}
+
+	err := s.sendReservationEmail(ctx, reservation.ReservedByUserID, "Reserva expirada", infraEmail.NotificationData{
+		Title:   "Reserva expirada",
+		Message: message,
+	})
+	if err != nil {
+		s.logger.Warn("erro ao enviar email de reserva expirada", zap.String("reservationId", reservation.ID), zap.Error(err))
+	}
+}
+
+// notifyAdminsPendingReservation avisa os admins da indústria sobre uma nova reserva aguardando aprovação
+func (s *reservationService) notifyAdminsPendingReservation(ctx context.Context, reservation *entity.Reservation) {
+	if s.emailSender == nil {
+		return
+	}
+
+	requester := "Um vendedor"
+	if user, err := s.userRepo.FindByID(ctx, reservation.ReservedByUserID); err == nil {
+		requester = user.Name
+	}
+
+	s.notifyAdmins(ctx, reservation, "Nova reserva aguardando aprovação", fmt.Sprintf(
+		"%s reservou %s. A reserva aguarda aprovação até %s; depois disso as chapas voltam ao estoque.",
+		requester, s.describeReservation(ctx, reservation), formatOptionalTime(reservation.ApprovalExpiresAt),
+	))
+}
+
+// notifyAdmins envia a notificação aos admins ativos da indústria da reserva
+func (s *reservationService) notifyAdmins(ctx context.Context, reservation *entity.Reservation, title, message string) {
+	industryID, err := s.reservationIndustryID(ctx, reservation)
+	if err != nil {
+		s.logger.Warn("erro ao buscar indústria da reserva", zap.String("reservationId", reservation.ID), zap.Error(err))
+		return
+	}
+
+	role := entity.RoleAdminIndustria
+	admins, err := s.userRepo.ListByIndustry(ctx, industryID, &role)
+	if err != nil {
+		s.logger.Warn("erro ao buscar admins da indústria", zap.String("industryId", industryID), zap.Error(err))
+		return
+	}
+
+	for _, admin := range admins {
+		if !admin.IsActive {
+			continue
+		}
+		err := s.sendReservationEmail(ctx, admin.ID, title, infraEmail.NotificationData{
+			Title:   title,
+			Message: message,
+		})
+		if err != nil {
+			s.logger.Warn("erro ao enviar email aos admins",
+				zap.String("reservationId", reservation.ID),
+				zap.String("adminId", admin.ID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// SendReservationReminders avisa quem reservou que a reserva (ou o prazo de aprovação) vence em breve,
+// conforme a antecedência da política da indústria. Cada lembrete é enviado uma única vez
+func (s *reservationService) SendReservationReminders(ctx context.Context) (int, error) {
+	if s.emailSender == nil {
+		return 0, nil
+	}
+
+	expiring, err := s.reservationRepo.FindDueExpiryReminders(ctx)
+	if err != nil {
+		s.logger.Error("erro ao buscar reservas a vencer", zap.Error(err))
+		return 0, err
+	}
+
+	pending, err := s.reservationRepo.FindDueApprovalReminders(ctx)
+	if err != nil {
+		s.logger.Error("erro ao buscar reservas pendentes a vencer", zap.Error(err))
+		return 0, err
+	}
+
+	count := 0
+	for i := range expiring {
+		reservation := &expiring[i]
+		err := s.sendReservationEmail(ctx, reservation.ReservedByUserID, "Sua reserva vence em breve", infraEmail.NotificationData{
+			Title: "Sua reserva vence em breve",
+			Message: fmt.Sprintf("Sua reserva de %s vence em %s. Confirme a venda ou peça uma prorrogação antes disso para não perder as chapas.",
+				s.describeReservation(ctx, reservation), reservation.ExpiresAt.Format("02/01/2006 15:04")),
+		})
+		if err != nil {
+			s.logger.Warn("erro ao enviar lembrete de vencimento", zap.String("reservationId", reservation.ID), zap.Error(err))
+			continue
+		}
+		if err := s.reservationRepo.MarkExpiryReminderSent(ctx, reservation.ID); err != nil {
+			s.logger.Warn("erro ao registrar lembrete de vencimento", zap.String("reservationId", reservation.ID), zap.Error(err))
+		}
+		count++
+	}
+
+	for i := range pending {
+		reservation := &pending[i]
+		err := s.sendReservationEmail(ctx, reservation.ReservedByUserID, "Reserva ainda aguardando aprovação", infraEmail.NotificationData{
+			Title: "Reserva ainda aguardando aprovação",
+			Message: fmt.Sprintf("Sua reserva de %s ainda não foi aprovada e expira em %s se não houver aprovação até lá.",
+				s.describeReservation(ctx, reservation), formatOptionalTime(reservation.ApprovalExpiresAt)),
+		})
+		if err != nil {
+			s.logger.Warn("erro ao enviar lembrete de aprovação", zap.String("reservationId", reservation.ID), zap.Error(err))
+			continue
+		}
+		if err := s.reservationRepo.MarkApprovalReminderSent(ctx, reservation.ID); err != nil {
+			s.logger.Warn("erro ao registrar lembrete de aprovação", zap.String("reservationId", reservation.ID), zap.Error(err))
+		}
+		count++
+	}
+
+	s.logger.Info("job de lembretes de reserva concluído",
+		zap.Int("sentCount", count),
+		zap.Int("totalFound", len(expiring)+len(pending)),
+	)
+
+	return count, nil
+}
+
+// formatOptionalTime formata a data para os emails ("-" quando ausente)
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format("02/01/2006 15:04")
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+)
+
+// reservationPolicy retorna a política de reservas da indústria (configurada em /api/industry-config)
+func (s *reservationService) reservationPolicy(ctx context.Context, industryID string) (entity.ReservationPolicy, error) {
+	industry, err := s.industryRepo.FindByID(ctx, industryID)
+	if err != nil {
+		return entity.ReservationPolicy{}, err
+	}
+	return industry.ReservationPolicy, nil
+}
+
+// reservationExpiry retorna o prazo informado (limitado ao máximo da política) ou o prazo padrão
+func reservationExpiry(policy entity.ReservationPolicy, requested *time.Time) (time.Time, error) {
+	now := time.Now()
+	if requested == nil {
+		return policy.DefaultExpiry(now), nil
+	}
+	if policy.ExceedsMaxExpiry(*requested, now) {
+		return time.Time{}, domainErrors.ValidationError(fmt.Sprintf("Prazo máximo da reserva é de %d dia(s)", policy.MaxExpiryDays))
+	}
+	return *requested, nil
+}
+
+// checkBrokerLimits verifica os limites de reservas e chapas em aberto do broker na indústria
+func (s *reservationService) checkBrokerLimits(ctx context.Context, tx *sql.Tx, userID, industryID string, slabs int, policy entity.ReservationPolicy) error {
+	violation, err := s.brokerLimitViolation(ctx, tx, userID, industryID, slabs, policy)
+	if err != nil {
+		return err
+	}
+	if violation != "" {
+		return domainErrors.ValidationError(violation)
+	}
+	return nil
+}
+
+// brokerLimitViolation descreve o limite do broker que a nova reserva ultrapassaria ("" quando dentro dos limites)
+func (s *reservationService) brokerLimitViolation(ctx context.Context, tx *sql.Tx, userID, industryID string, slabs int, policy entity.ReservationPolicy) (string, error) {
+	if policy.MaxActivePerBroker == 0 && policy.MaxSlabsPerBroker == 0 {
+		return "", nil
+	}
+
+	openReservations, openSlabs, err := s.reservationRepo.CountOpenByUser(ctx, tx, userID, industryID)
+	if err != nil {
+		return "", err
+	}
+
+	if policy.MaxActivePerBroker > 0 && openReservations >= policy.MaxActivePerBroker {
+		return fmt.Sprintf("Limite de %d reserva(s) em aberto por broker atingido", policy.MaxActivePerBroker), nil
+	}
+	if policy.MaxSlabsPerBroker > 0 && openSlabs+slabs > policy.MaxSlabsPerBroker {
+		return fmt.Sprintf("Limite de %d chapa(s) reservadas por broker excedido (em aberto: %d)", policy.MaxSlabsPerBroker, openSlabs), nil
+	}
+	return "", nil
+}
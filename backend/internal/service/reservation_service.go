@@ -3,21 +3,37 @@ package service
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
 	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
 	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	domainService "github.com/thiagomes07/CAVA/backend/internal/domain/service"
 	"go.uber.org/zap"
 )
 
 type reservationService struct {
 	reservationRepo repository.ReservationRepository
 	batchRepo       repository.BatchRepository
+	remnantRepo     repository.RemnantRepository
 	clienteRepo     repository.ClienteRepository
 	salesRepo       repository.SalesHistoryRepository
 	userRepo        repository.UserRepository
+	sharedRepo      repository.SharedInventoryRepository
+	slabRepo        repository.SlabRepository
+	moveRepo        repository.BatchMovementRepository
+	priceLists      domainService.PriceListService
+	packingLists    domainService.PackingListService
+	waitlistRepo    repository.ReservationWaitlistRepository
+	extensionRepo   repository.ReservationExtensionRepository
+	industryRepo    repository.IndustryRepository
+	eventRepo       repository.ReservationEventRepository
+	offerRepo       repository.ReservationOfferRepository
+	emailSender     domainService.EmailSender
+	frontendURL     string
+	slabs           slabTracker
 	db              ReservationDB
 	logger          *zap.Logger
 }
@@ -31,18 +47,45 @@ type ReservationDB interface {
 func NewReservationService(
 	reservationRepo repository.ReservationRepository,
 	batchRepo repository.BatchRepository,
+	remnantRepo repository.RemnantRepository,
 	clienteRepo repository.ClienteRepository,
 	salesRepo repository.SalesHistoryRepository,
 	userRepo repository.UserRepository,
+	sharedRepo repository.SharedInventoryRepository,
+	slabRepo repository.SlabRepository,
+	moveRepo repository.BatchMovementRepository,
+	priceLists domainService.PriceListService,
+	packingLists domainService.PackingListService,
+	waitlistRepo repository.ReservationWaitlistRepository,
+	extensionRepo repository.ReservationExtensionRepository,
+	industryRepo repository.IndustryRepository,
+	eventRepo repository.ReservationEventRepository,
+	offerRepo repository.ReservationOfferRepository,
+	emailSender domainService.EmailSender,
+	frontendURL string,
 	db ReservationDB,
 	logger *zap.Logger,
 ) *reservationService {
 	return &reservationService{
 		reservationRepo: reservationRepo,
 		batchRepo:       batchRepo,
+		remnantRepo:     remnantRepo,
 		clienteRepo:     clienteRepo,
 		salesRepo:       salesRepo,
 		userRepo:        userRepo,
+		sharedRepo:      sharedRepo,
+		slabRepo:        slabRepo,
+		moveRepo:        moveRepo,
+		priceLists:      priceLists,
+		packingLists:    packingLists,
+		waitlistRepo:    waitlistRepo,
+		extensionRepo:   extensionRepo,
+		industryRepo:    industryRepo,
+		eventRepo:       eventRepo,
+		offerRepo:       offerRepo,
+		emailSender:     emailSender,
+		frontendURL:     frontendURL,
+		slabs:           slabTracker{slabRepo: slabRepo, movementRepo: moveRepo},
 		db:              db,
 		logger:          logger,
 	}
@@ -51,13 +94,13 @@ func NewReservationService(
 func (s *reservationService) Create(ctx context.Context, userID string, userRole entity.UserRole, input entity.CreateReservationInput) (*entity.Reservation, error) {
 	// Cliente é opcional - pode ser associado depois ou na confirmação de venda
 
-	// Validar quantidade de chapas
-	if input.QuantitySlabsReserved <= 0 {
+	// Validar quantidade de chapas (retalhos são reservados por peça)
+	if input.RemnantID == nil && input.QuantitySlabsReserved <= 0 {
 		return nil, domainErrors.ValidationError("Quantidade de chapas deve ser maior que 0")
 	}
 
-	// Validar data de expiração
-	var expiresAt time.Time
+	// Validar data de expiração (padrão e máximo seguem a política da indústria)
+	var requestedExpiresAt *time.Time
 	if input.ExpiresAt != nil {
 		expiration, err := time.Parse(time.RFC3339, *input.ExpiresAt)
 		if err != nil {
@@ -66,10 +109,11 @@ func (s *reservationService) Create(ctx context.Context, userID string, userRole
 		if expiration.Before(time.Now()) {
 			return nil, domainErrors.ValidationError("Data de expiração deve ser futura")
 		}
-		expiresAt = expiration
-	} else {
-		// Default: +7 dias
-		expiresAt = time.Now().Add(7 * 24 * time.Hour)
+		requestedExpiresAt = &expiration
+	}
+
+	if input.RemnantID != nil {
+		return s.createRemnantReservation(ctx, userID, input, requestedExpiresAt)
 	}
 
 	// Executar em transação
@@ -81,7 +125,14 @@ func (s *reservationService) Create(ctx context.Context, userID string, userRole
 			return err
 		}
 
+		if _, err := s.checkBatchAccess(ctx, userID, batch); err != nil {
+			return err
+		}
+
 		// 2. Verificar disponibilidade de chapas
+		if len(input.SlabNumbers) > 0 && len(input.SlabNumbers) != input.QuantitySlabsReserved {
+			return domainErrors.ValidationError("Quantidade reservada deve corresponder às chapas informadas")
+		}
 		if !batch.HasAvailableSlabs(input.QuantitySlabsReserved) {
 			s.logger.Warn("tentativa de reserva com quantidade insuficiente de chapas",
 				zap.String("batchId", input.BatchID),
@@ -91,38 +142,70 @@ func (s *reservationService) Create(ctx context.Context, userID string, userRole
 			return domainErrors.InsufficientSlabsError(input.QuantitySlabsReserved, batch.AvailableSlabs)
 		}
 
-		// 3. Atualizar distribuição de chapas
-		newAvailableSlabs := batch.AvailableSlabs - input.QuantitySlabsReserved
-		newReservedSlabs := batch.ReservedSlabs + input.QuantitySlabsReserved
-		newSoldSlabs := batch.SoldSlabs
-		newInactiveSlabs := batch.InactiveSlabs
+		// Chapas liberadas pertencem primeiro a quem está na fila de espera: a reserva direta
+		// só pode usar o que sobra depois da demanda da fila
+		if s.waitlistRepo != nil {
+			demand, err := s.waitlistRepo.WaitingDemand(ctx, tx, batch.ID)
+			if err != nil {
+				return err
+			}
+			if demand > 0 && batch.AvailableSlabs-input.QuantitySlabsReserved < demand {
+				return domainErrors.ValidationError("Há pedidos na fila de espera deste lote: entre na fila para reservar")
+			}
+		}
 
-		if err := s.batchRepo.UpdateSlabCounts(ctx, tx, input.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs); err != nil {
+		// 3. Aplicar a política de reservas da indústria (prazo e limites por broker)
+		policy, err := s.reservationPolicy(ctx, batch.IndustryID)
+		if err != nil {
 			return err
 		}
 
-		newStatus := deriveBatchStatus(newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs)
-		if newStatus != batch.Status {
-			if err := s.batchRepo.UpdateStatus(ctx, tx, input.BatchID, newStatus); err != nil {
+		expiresAt, err := reservationExpiry(policy, requestedExpiresAt)
+		if err != nil {
+			return err
+		}
+
+		if userRole == entity.RoleBroker {
+			if err := s.checkBrokerLimits(ctx, tx, userID, batch.IndustryID, input.QuantitySlabsReserved, policy); err != nil {
 				return err
 			}
 		}
 
-		// 5. Reserva sempre é criada como ATIVA (broker ou indústria)
+		// Preço vigente pela tabela do cliente ou de quem reservou
+		price, err := s.priceLists.ResolveBatchPrice(ctx, batch, userID, input.ClienteID)
+		if err != nil {
+			return err
+		}
+
+		// Reservas de brokers ficam pendentes quando a política exige aprovação e nenhuma regra automática se aplica
 		initialStatus := entity.ReservationStatusAtiva
+		var approvalExpiresAt *time.Time
+		if userRole == entity.RoleBroker && policy.RequireBrokerApproval {
+			batchPricePerM2 := entity.ConvertPrice(price.Price, price.PriceUnit, entity.PriceUnitM2)
+			if !policy.AutoApprove.Matches(userID, input.QuantitySlabsReserved, input.ReservedPrice, batchPricePerM2) {
+				initialStatus = entity.ReservationStatusPendenteAprovacao
+				deadline := time.Now().Add(entity.ReservationApprovalWindow)
+				approvalExpiresAt = &deadline
+			}
+		}
 
-		// 6. Criar reserva
+		// 4. Criar reserva
 		reservation = &entity.Reservation{
 			ID:                    uuid.New().String(),
 			BatchID:               input.BatchID,
+			IndustryID:            &batch.IndustryID,
 			ClienteID:             input.ClienteID,
 			ReservedByUserID:      userID,
 			QuantitySlabsReserved: input.QuantitySlabsReserved,
 			Status:                initialStatus,
 			ReservedPrice:         input.ReservedPrice,
 			BrokerSoldPrice:       input.BrokerSoldPrice,
+			IndustryPrice:         &price.Price,
+			PriceUnit:             &price.PriceUnit,
+			Currency:              &price.Currency,
 			Notes:                 input.Notes,
 			ExpiresAt:             expiresAt,
+			ApprovalExpiresAt:     approvalExpiresAt,
 			IsActive:              true,
 			CreatedAt:             time.Now(),
 		}
@@ -131,6 +214,42 @@ func (s *reservationService) Create(ctx context.Context, userID string, userRole
 			return err
 		}
 
+		if err := s.recordCreated(ctx, tx, reservation, nil); err != nil {
+			return err
+		}
+
+		// 5. Reservar chapas individuais (específicas ou as de menor número)
+		reservation.SlabNumbers, err = s.slabs.move(ctx, tx, input.BatchID, slabMove{
+			From:          entity.BatchStatusDisponivel,
+			To:            entity.BatchStatusReservado,
+			Quantity:      input.QuantitySlabsReserved,
+			Numbers:       input.SlabNumbers,
+			ReservationID: &reservation.ID,
+			Reason:        entity.MovementReasonReserva,
+			ActorID:       &userID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// 6. Atualizar distribuição de chapas
+		newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs, err := s.slabs.derive(ctx, tx, input.BatchID,
+			batch.AvailableSlabs-input.QuantitySlabsReserved, batch.ReservedSlabs+input.QuantitySlabsReserved, batch.SoldSlabs, batch.InactiveSlabs)
+		if err != nil {
+			return err
+		}
+
+		if err := s.batchRepo.UpdateSlabCounts(ctx, tx, input.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs); err != nil {
+			return err
+		}
+
+		newStatus := deriveBatchStatus(newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs)
+		if newStatus != batch.Status {
+			if err := s.batchRepo.UpdateStatus(ctx, tx, input.BatchID, newStatus); err != nil {
+				return err
+			}
+		}
+
 		s.logger.Info("reserva criada com sucesso",
 			zap.String("reservationId", reservation.ID),
 			zap.String("batchId", input.BatchID),
@@ -153,9 +272,140 @@ func (s *reservationService) Create(ctx context.Context, userID string, userRole
 	}
 
 	// Retornar reserva com dados relacionados
+	created, err := s.GetByID(ctx, reservation.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if created.Status == entity.ReservationStatusPendenteAprovacao {
+		s.notifyAdminsPendingReservation(ctx, created)
+	}
+
+	return created, nil
+}
+
+// checkBatchAccess garante que o usuário enxerga o lote: usuários da indústria dona do lote
+// ou brokers com quem o lote foi compartilhado. Retorna o usuário para as demais verificações
+func (s *reservationService) checkBatchAccess(ctx context.Context, userID string, batch *entity.Batch) (*entity.User, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role == entity.RoleBroker {
+		shared, err := s.sharedRepo.ExistsForUser(ctx, batch.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !shared {
+			return nil, domainErrors.ForbiddenError()
+		}
+		return user, nil
+	}
+
+	if user.IndustryID == nil || *user.IndustryID != batch.IndustryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return user, nil
+}
+
+// createRemnantReservation reserva um retalho inteiro; o lote de origem não tem as chapas alteradas
+func (s *reservationService) createRemnantReservation(ctx context.Context, userID string, input entity.CreateReservationInput, requestedExpiresAt *time.Time) (*entity.Reservation, error) {
+	var reservation *entity.Reservation
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		remnant, err := s.remnantRepo.FindByIDForUpdate(ctx, tx, *input.RemnantID)
+		if err != nil {
+			return err
+		}
+
+		// Retalhos são estoque interno da indústria
+		user, err := s.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if user.IndustryID == nil || *user.IndustryID != remnant.IndustryID {
+			return domainErrors.ForbiddenError()
+		}
+
+		if !remnant.IsAvailable() {
+			return domainErrors.ValidationError("Retalho não está disponível para reserva")
+		}
+
+		policy, err := s.reservationPolicy(ctx, remnant.IndustryID)
+		if err != nil {
+			return err
+		}
+
+		expiresAt, err := reservationExpiry(policy, requestedExpiresAt)
+		if err != nil {
+			return err
+		}
+
+		reservation = &entity.Reservation{
+			ID:                    uuid.New().String(),
+			BatchID:               remnant.SourceBatchID,
+			RemnantID:             &remnant.ID,
+			IndustryID:            &remnant.IndustryID,
+			ClienteID:             input.ClienteID,
+			ReservedByUserID:      userID,
+			QuantitySlabsReserved: 1,
+			Status:                entity.ReservationStatusAtiva,
+			ReservedPrice:         input.ReservedPrice,
+			BrokerSoldPrice:       input.BrokerSoldPrice,
+			IndustryPrice:         &remnant.IndustryPrice,
+			PriceUnit:             &remnant.PriceUnit,
+			Currency:              &remnant.Currency,
+			Notes:                 input.Notes,
+			ExpiresAt:             expiresAt,
+			IsActive:              true,
+			CreatedAt:             time.Now(),
+		}
+
+		if err := s.reservationRepo.Create(ctx, tx, reservation); err != nil {
+			return err
+		}
+
+		if err := s.recordCreated(ctx, tx, reservation, nil); err != nil {
+			return err
+		}
+
+		if err := s.remnantRepo.UpdateStatus(ctx, tx, remnant.ID, entity.BatchStatusReservado); err != nil {
+			return err
+		}
+
+		s.logger.Info("reserva de retalho criada com sucesso",
+			zap.String("reservationId", reservation.ID),
+			zap.String("remnantId", remnant.ID),
+			zap.String("userId", userID),
+		)
+
+		return nil
+	})
+
+	if err != nil {
+		s.logger.Error("erro ao criar reserva de retalho",
+			zap.String("remnantId", *input.RemnantID),
+			zap.String("userId", userID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
 	return s.GetByID(ctx, reservation.ID)
 }
 
+// releaseRemnant devolve o retalho reservado ao estoque
+func (s *reservationService) releaseRemnant(ctx context.Context, tx *sql.Tx, reservation *entity.Reservation) error {
+	remnant, err := s.remnantRepo.FindByIDForUpdate(ctx, tx, *reservation.RemnantID)
+	if err != nil {
+		return err
+	}
+	if remnant.Status != entity.BatchStatusReservado {
+		return nil
+	}
+	return s.remnantRepo.UpdateStatus(ctx, tx, remnant.ID, entity.BatchStatusDisponivel)
+}
+
 func (s *reservationService) GetByID(ctx context.Context, id string) (*entity.Reservation, error) {
 	reservation, err := s.reservationRepo.FindByID(ctx, id)
 	if err != nil {
@@ -175,6 +425,19 @@ func (s *reservationService) GetByID(ctx context.Context, id string) (*entity.Re
 		}
 	}
 
+	// Buscar retalho relacionado (se houver)
+	if reservation.RemnantID != nil {
+		remnant, err := s.remnantRepo.FindByID(ctx, *reservation.RemnantID)
+		if err != nil {
+			s.logger.Warn("erro ao buscar retalho da reserva",
+				zap.String("reservationId", id),
+				zap.Error(err),
+			)
+		} else {
+			reservation.Remnant = remnant
+		}
+	}
+
 	// Buscar cliente relacionado (se houver)
 	if reservation.ClienteID != nil {
 		cliente, err := s.clienteRepo.FindByID(ctx, *reservation.ClienteID)
@@ -188,14 +451,48 @@ func (s *reservationService) GetByID(ctx context.Context, id string) (*entity.Re
 		}
 	}
 
+	// Buscar chapas vinculadas (lotes com rastreamento por chapa)
+	slabs, err := s.slabRepo.FindByReservationID(ctx, id)
+	if err != nil {
+		s.logger.Warn("erro ao buscar chapas da reserva",
+			zap.String("reservationId", id),
+			zap.Error(err),
+		)
+	}
+	for _, slab := range slabs {
+		reservation.SlabNumbers = append(reservation.SlabNumbers, slab.SlabNumber)
+	}
+
+	// Buscar histórico de prorrogações
+	extensions, err := s.extensionRepo.FindByReservation(ctx, id)
+	if err != nil {
+		s.logger.Warn("erro ao buscar prorrogações da reserva",
+			zap.String("reservationId", id),
+			zap.Error(err),
+		)
+	}
+	reservation.Extensions = extensions
+
+	// Buscar propostas da negociação de preço
+	offers, err := s.offerRepo.FindByReservation(ctx, id)
+	if err != nil {
+		s.logger.Warn("erro ao buscar propostas da reserva",
+			zap.String("reservationId", id),
+			zap.Error(err),
+		)
+	}
+	reservation.Offers = offers
+
 	return reservation, nil
 }
 
-func (s *reservationService) Cancel(ctx context.Context, id string) error {
+func (s *reservationService) Cancel(ctx context.Context, id, userID string) error {
+	var releasedBatchID string
+
 	// Executar em transação
-	return s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
-		// 1. Buscar reserva
-		reservation, err := s.reservationRepo.FindByID(ctx, id)
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		// 1. Buscar reserva (lock antes do lote, como nas demais transições)
+		reservation, err := s.reservationRepo.FindByIDForUpdate(ctx, tx, id)
 		if err != nil {
 			return err
 		}
@@ -210,6 +507,24 @@ func (s *reservationService) Cancel(ctx context.Context, id string) error {
 			return err
 		}
 
+		if err := s.recordEvent(ctx, tx, entity.ReservationEvent{
+			ReservationID: id,
+			Type:          entity.ReservationEventCancelada,
+			FromStatus:    reservation.Status,
+			ToStatus:      entity.ReservationStatusCancelada,
+			ActorUserID:   &userID,
+		}); err != nil {
+			return err
+		}
+
+		if reservation.RemnantID != nil {
+			s.logger.Info("reserva de retalho cancelada",
+				zap.String("reservationId", id),
+				zap.String("remnantId", *reservation.RemnantID),
+			)
+			return s.releaseRemnant(ctx, tx, reservation)
+		}
+
 		// 4. Devolver chapas ao lote
 		batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, reservation.BatchID)
 		if err != nil {
@@ -224,6 +539,23 @@ func (s *reservationService) Cancel(ctx context.Context, id string) error {
 			return domainErrors.ValidationError("Quantidade reservada inconsistente")
 		}
 
+		// Liberar chapas individuais da reserva
+		if _, err := s.slabs.move(ctx, tx, reservation.BatchID, slabMove{
+			From:          entity.BatchStatusReservado,
+			To:            entity.BatchStatusDisponivel,
+			Quantity:      reservation.QuantitySlabsReserved,
+			ReservationID: &reservation.ID,
+			Reason:        entity.MovementReasonCancelamentoReserva,
+			ActorID:       &userID,
+		}); err != nil {
+			return err
+		}
+
+		newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs, err = s.slabs.derive(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs)
+		if err != nil {
+			return err
+		}
+
 		if err := s.batchRepo.UpdateSlabCounts(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs); err != nil {
 			return err
 		}
@@ -241,13 +573,24 @@ func (s *reservationService) Cancel(ctx context.Context, id string) error {
 			zap.Int("slabsReturned", reservation.QuantitySlabsReserved),
 		)
 
+		releasedBatchID = reservation.BatchID
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// Chapas devolvidas atendem a fila de espera do lote
+	if releasedBatchID != "" {
+		s.ProcessWaitlist(ctx, releasedBatchID)
+	}
+
+	return nil
 }
 
 func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, userID string, input entity.ConfirmSaleInput) (*entity.Sale, error) {
-	// Validar preço
-	if input.FinalSoldPrice <= 0 {
+	// Validar preço (sem valor informado, vale o preço acordado na negociação)
+	if input.FinalSoldPrice < 0 {
 		return nil, domainErrors.ValidationError("Preço de venda deve ser maior que 0")
 	}
 
@@ -257,19 +600,23 @@ func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, use
 	}
 
 	var sale *entity.Sale
+	var releasedBatchID string
 
 	// Executar em transação
 	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
-		// 1. Buscar reserva
-		reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+		// 1. Buscar reserva (lock antes do lote, como nas demais transições)
+		reservation, err := s.reservationRepo.FindByIDForUpdate(ctx, tx, reservationID)
 		if err != nil {
 			return err
 		}
 
 		// 2. Verificar se reserva pode ser convertida em venda
-		// Apenas reservas ativas podem ser confirmadas como venda
-		if reservation.Status != entity.ReservationStatusAtiva {
-			return domainErrors.ValidationError("Apenas reservas ativas podem ser confirmadas")
+		// Apenas reservas ativas ou aprovadas podem ser confirmadas como venda
+		if !reservation.Status.CanBeConverted() {
+			return domainErrors.ValidationError("Apenas reservas ativas ou aprovadas podem ser confirmadas")
+		}
+		if input.FinalSoldPrice == 0 && reservation.AgreedPrice == nil {
+			return domainErrors.ValidationError("Preço de venda deve ser maior que 0")
 		}
 
 		// 3. Verificar se reserva não expirou
@@ -292,13 +639,39 @@ func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, use
 		slabArea := batch.CalculateSlabArea()
 		totalAreaSold := slabArea * float64(input.QuantitySlabsSold)
 
-		// Preço por unidade de área da indústria
+		// Retalho é vendido como peça única, pela sua área útil
+		var remnant *entity.Remnant
+		if reservation.RemnantID != nil {
+			remnant, err = s.remnantRepo.FindByIDForUpdate(ctx, tx, *reservation.RemnantID)
+			if err != nil {
+				return err
+			}
+			totalAreaSold = remnant.Area
+		}
+
+		// Preço por unidade de área da indústria (o vigente na criação da reserva prevalece)
 		pricePerUnit := batch.IndustryPrice
 		priceUnit := batch.PriceUnit
+		currency := batch.Currency.OrDefault()
+		if reservation.IndustryPrice != nil && reservation.PriceUnit != nil {
+			pricePerUnit = *reservation.IndustryPrice
+			priceUnit = *reservation.PriceUnit
+		}
+		if reservation.Currency != nil {
+			currency = *reservation.Currency
+		}
+
+		// Preço por m² acordado na negociação substitui o preço do lote
+		if reservation.AgreedPrice != nil {
+			pricePerUnit = *reservation.AgreedPrice
+			priceUnit = entity.PriceUnitM2
+		}
 
-		// O valor da venda é o preço final informado (sem cálculo de comissão)
-		// O preço da venda é definido pelo admin ao confirmar
+		// O preço da venda é definido pelo admin ao confirmar; sem ele, vale o preço acordado × área vendida
 		salePrice := input.FinalSoldPrice
+		if salePrice == 0 {
+			salePrice = *reservation.AgreedPrice * totalAreaSold
+		}
 
 		// Determinar quem deve ser atribuído como vendedor
 		// Se a reserva foi feita por um broker, atribuir a venda ao broker
@@ -321,6 +694,7 @@ func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, use
 		sale = &entity.Sale{
 			ID:                uuid.New().String(),
 			BatchID:           reservation.BatchID,
+			RemnantID:         reservation.RemnantID,
 			SoldByUserID:      &soldByUserID,
 			SellerName:        sellerName,
 			IndustryID:        batch.IndustryID,
@@ -332,6 +706,7 @@ func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, use
 			PricePerUnit:      pricePerUnit,
 			PriceUnit:         priceUnit,
 			SalePrice:         salePrice,
+			Currency:          currency,
 			BrokerSoldPrice:   brokerTotalSoldPrice, // Valor TOTAL que o broker vendeu para o cliente final
 			BrokerCommission:  0, // Sem comissão
 			NetIndustryValue:  salePrice, // Valor líquido = preço de venda
@@ -358,6 +733,38 @@ func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, use
 			return err
 		}
 
+		saleNote := fmt.Sprintf("%d chapa(s) vendida(s) por %.2f %s", input.QuantitySlabsSold, salePrice, currency)
+		if err := s.recordEvent(ctx, tx, entity.ReservationEvent{
+			ReservationID: reservationID,
+			Type:          entity.ReservationEventVendida,
+			FromStatus:    reservation.Status,
+			ToStatus:      entity.ReservationStatusConfirmadaVenda,
+			ActorUserID:   &userID,
+			SaleID:        &sale.ID,
+			Notes:         &saleNote,
+		}); err != nil {
+			return err
+		}
+
+		// Venda de retalho não altera as chapas do lote de origem
+		if remnant != nil {
+			if err := s.remnantRepo.UpdateStatus(ctx, tx, remnant.ID, entity.BatchStatusVendido); err != nil {
+				return err
+			}
+			if err := s.reservationRepo.UpdateStatus(ctx, tx, reservationID, reservation.Status, entity.ReservationStatusConfirmadaVenda); err != nil {
+				return err
+			}
+
+			s.logger.Info("venda de retalho confirmada com sucesso",
+				zap.String("saleId", sale.ID),
+				zap.String("reservationId", reservationID),
+				zap.String("remnantId", remnant.ID),
+				zap.Float64("salePrice", salePrice),
+				zap.Float64("totalAreaSold", totalAreaSold),
+			)
+			return nil
+		}
+
 		// 8. Atualizar distribuição de chapas
 		slabsToReturn := reservation.QuantitySlabsReserved - input.QuantitySlabsSold
 		newAvailableSlabs := batch.AvailableSlabs + slabsToReturn
@@ -368,6 +775,43 @@ func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, use
 			return domainErrors.ValidationError("Quantidade reservada inconsistente")
 		}
 
+		// Chapas vendidas recebem a venda; as restantes voltam a ficar disponíveis
+		soldNumbers, err := s.slabs.move(ctx, tx, reservation.BatchID, slabMove{
+			From:          entity.BatchStatusReservado,
+			To:            entity.BatchStatusVendido,
+			Quantity:      input.QuantitySlabsSold,
+			Numbers:       input.SlabNumbers,
+			ReservationID: &reservation.ID,
+			SaleID:        &sale.ID,
+			Reason:        entity.MovementReasonVendaReserva,
+			ActorID:       &userID,
+		})
+		if err != nil {
+			return err
+		}
+		sale.SlabNumbers = soldNumbers
+
+		if slabsToReturn > 0 {
+			returnedNote := "Chapas reservadas não vendidas devolvidas ao estoque"
+			if _, err := s.slabs.move(ctx, tx, reservation.BatchID, slabMove{
+				From:          entity.BatchStatusReservado,
+				To:            entity.BatchStatusDisponivel,
+				Quantity:      slabsToReturn,
+				ReservationID: &reservation.ID,
+				Reason:        entity.MovementReasonVendaReserva,
+				ActorID:       &userID,
+				Notes:         &returnedNote,
+			}); err != nil {
+				return err
+			}
+			releasedBatchID = reservation.BatchID
+		}
+
+		newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs, err = s.slabs.derive(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs)
+		if err != nil {
+			return err
+		}
+
 		if err := s.batchRepo.UpdateSlabCounts(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs); err != nil {
 			return err
 		}
@@ -380,7 +824,7 @@ func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, use
 		}
 
 		// 9. Atualizar status da reserva para CONFIRMADA_VENDA
-		if err := s.reservationRepo.UpdateStatus(ctx, tx, reservationID, entity.ReservationStatusConfirmadaVenda); err != nil {
+		if err := s.reservationRepo.UpdateStatus(ctx, tx, reservationID, reservation.Status, entity.ReservationStatusConfirmadaVenda); err != nil {
 			return err
 		}
 
@@ -388,7 +832,7 @@ func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, use
 			zap.String("saleId", sale.ID),
 			zap.String("reservationId", reservationID),
 			zap.String("batchId", reservation.BatchID),
-			zap.Float64("salePrice", input.FinalSoldPrice),
+			zap.Float64("salePrice", salePrice),
 			zap.Int("quantitySold", input.QuantitySlabsSold),
 			zap.Float64("totalAreaSold", totalAreaSold),
 		)
@@ -404,6 +848,14 @@ func (s *reservationService) ConfirmSale(ctx context.Context, reservationID, use
 		return nil, err
 	}
 
+	// Romaneio da venda (fora da transação)
+	generatePackingListAfterSale(ctx, s.packingLists, s.logger, sale.IndustryID, sale.ID, userID, input.Carrier)
+
+	// Chapas reservadas e não vendidas atendem a fila de espera do lote
+	if releasedBatchID != "" {
+		s.ProcessWaitlist(ctx, releasedBatchID)
+	}
+
 	return sale, nil
 }
 
@@ -484,14 +936,45 @@ func (s *reservationService) ExpireReservations(ctx context.Context) (int, error
 	}
 
 	count := 0
+	releasedBatches := make(map[string]bool)
 	for _, reservation := range expiredReservations {
+		skipped := false
+
 		// Executar cada expiração em transação
 		err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+			// Releitura com lock: venda ou prorrogação concorrente tira a reserva da expiração
+			current, err := s.reservationRepo.FindByIDForUpdate(ctx, tx, reservation.ID)
+			if err != nil {
+				return err
+			}
+			if !current.IsExpired() {
+				skipped = true
+				return nil
+			}
+			reservation = *current
+
 			// 1. Atualizar status da reserva para EXPIRADA
-			if err := s.reservationRepo.UpdateStatus(ctx, tx, reservation.ID, entity.ReservationStatusExpirada); err != nil {
+			if err := s.reservationRepo.UpdateStatus(ctx, tx, reservation.ID, reservation.Status, entity.ReservationStatusExpirada); err != nil {
 				return err
 			}
 
+			if err := s.recordEvent(ctx, tx, entity.ReservationEvent{
+				ReservationID: reservation.ID,
+				Type:          entity.ReservationEventExpirada,
+				FromStatus:    reservation.Status,
+				ToStatus:      entity.ReservationStatusExpirada,
+			}); err != nil {
+				return err
+			}
+
+			if reservation.RemnantID != nil {
+				if err := s.releaseRemnant(ctx, tx, &reservation); err != nil {
+					return err
+				}
+				count++
+				return nil
+			}
+
 			// 2. Devolver chapas ao lote
 			batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, reservation.BatchID)
 			if err != nil {
@@ -506,6 +989,22 @@ func (s *reservationService) ExpireReservations(ctx context.Context) (int, error
 				return domainErrors.ValidationError("Quantidade reservada inconsistente")
 			}
 
+			// Liberar chapas individuais da reserva
+			if _, err := s.slabs.move(ctx, tx, reservation.BatchID, slabMove{
+				From:          entity.BatchStatusReservado,
+				To:            entity.BatchStatusDisponivel,
+				Quantity:      reservation.QuantitySlabsReserved,
+				ReservationID: &reservation.ID,
+				Reason:        entity.MovementReasonExpiracaoReserva,
+			}); err != nil {
+				return err
+			}
+
+			newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs, err = s.slabs.derive(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs)
+			if err != nil {
+				return err
+			}
+
 			if err := s.batchRepo.UpdateSlabCounts(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs); err != nil {
 				return err
 			}
@@ -524,6 +1023,7 @@ func (s *reservationService) ExpireReservations(ctx context.Context) (int, error
 				zap.Int("slabsReturned", reservation.QuantitySlabsReserved),
 			)
 
+			releasedBatches[reservation.BatchID] = true
 			return nil
 		})
 
@@ -533,7 +1033,17 @@ func (s *reservationService) ExpireReservations(ctx context.Context) (int, error
 				zap.Error(err),
 			)
 			// Continuar com as próximas reservas
+			continue
 		}
+		if skipped {
+			continue
+		}
+
+		s.notifyReservationExpired(ctx, &reservation)
+	}
+
+	for batchID := range releasedBatches {
+		s.ProcessWaitlist(ctx, batchID)
 	}
 
 	s.logger.Info("job de expiração concluído",
@@ -633,42 +1143,32 @@ func (s *reservationService) ListByIndustry(ctx context.Context, industryID stri
 }
 
 func (s *reservationService) Approve(ctx context.Context, reservationID, approverID string) (*entity.Reservation, error) {
-	var reservation *entity.Reservation
-
 	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
-		// 1. Buscar reserva
-		res, err := s.reservationRepo.FindByID(ctx, reservationID)
+		// 1. Buscar reserva (lock: concorre com as propostas de preço)
+		res, err := s.reservationRepo.FindByIDForUpdate(ctx, tx, reservationID)
 		if err != nil {
 			return err
 		}
 
-		// 2. Verificar se reserva está pendente de aprovação
+		// 2. Verificar se reserva está pendente de aprovação e dentro do prazo
 		if res.Status != entity.ReservationStatusPendenteAprovacao {
 			return domainErrors.ValidationError("Apenas reservas pendentes podem ser aprovadas")
 		}
+		if res.IsApprovalExpired() {
+			return domainErrors.ReservationExpiredError()
+		}
 
-		// 3. Definir prazo de expiração (7 dias após aprovação)
-		expiresAt := time.Now().Add(7 * 24 * time.Hour)
-
-		// 4. Aprovar reserva
-		if err := s.reservationRepo.Approve(ctx, tx, reservationID, approverID, expiresAt); err != nil {
+		// 3. Contraproposta da indústria aguardando resposta impede a aprovação direta
+		offer, err := s.offerRepo.FindPendingForUpdate(ctx, tx, reservationID)
+		if err != nil {
 			return err
 		}
+		if offer != nil && offer.Side == entity.ReservationOfferSideIndustria {
+			return domainErrors.ValidationError("A contraproposta da indústria aguarda a resposta de quem reservou")
+		}
 
-		reservation = res
-		reservation.Status = entity.ReservationStatusAprovada
-		reservation.ApprovedBy = &approverID
-		now := time.Now()
-		reservation.ApprovedAt = &now
-		reservation.ExpiresAt = expiresAt
-
-		s.logger.Info("reserva aprovada",
-			zap.String("reservationId", reservationID),
-			zap.String("approverId", approverID),
-			zap.Time("expiresAt", expiresAt),
-		)
-
-		return nil
+		// 4. Aprovar pelo preço proposto por quem reservou
+		return s.approvePending(ctx, tx, res, approverID, approverID, offer)
 	})
 
 	if err != nil {
@@ -680,13 +1180,22 @@ func (s *reservationService) Approve(ctx context.Context, reservationID, approve
 		return nil, err
 	}
 
-	return s.GetByID(ctx, reservationID)
+	approved, err := s.GetByID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyReservationApproved(ctx, approved)
+
+	return approved, nil
 }
 
 func (s *reservationService) Reject(ctx context.Context, reservationID, approverID, reason string) (*entity.Reservation, error) {
+	var releasedBatchID string
+
 	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
-		// 1. Buscar reserva
-		reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+		// 1. Buscar reserva (lock: concorre com aprovação, propostas e expiração)
+		reservation, err := s.reservationRepo.FindByIDForUpdate(ctx, tx, reservationID)
 		if err != nil {
 			return err
 		}
@@ -696,11 +1205,30 @@ func (s *reservationService) Reject(ctx context.Context, reservationID, approver
 			return domainErrors.ValidationError("Apenas reservas pendentes podem ser rejeitadas")
 		}
 
-		// 3. Rejeitar reserva
+		// 3. Rejeitar reserva (encerra a negociação em andamento)
 		if err := s.reservationRepo.Reject(ctx, tx, reservationID, approverID, reason); err != nil {
 			return err
 		}
 
+		if err := s.offerRepo.ClosePending(ctx, tx, reservationID, entity.ReservationOfferStatusEncerrada); err != nil {
+			return err
+		}
+
+		if err := s.recordEvent(ctx, tx, entity.ReservationEvent{
+			ReservationID: reservationID,
+			Type:          entity.ReservationEventRejeitada,
+			FromStatus:    reservation.Status,
+			ToStatus:      entity.ReservationStatusRejeitada,
+			ActorUserID:   &approverID,
+			Notes:         &reason,
+		}); err != nil {
+			return err
+		}
+
+		if reservation.RemnantID != nil {
+			return s.releaseRemnant(ctx, tx, reservation)
+		}
+
 		// 4. Devolver chapas ao lote
 		batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, reservation.BatchID)
 		if err != nil {
@@ -715,6 +1243,23 @@ func (s *reservationService) Reject(ctx context.Context, reservationID, approver
 			newReservedSlabs = 0
 		}
 
+		// Liberar chapas individuais da reserva
+		if _, err := s.slabs.move(ctx, tx, reservation.BatchID, slabMove{
+			From:          entity.BatchStatusReservado,
+			To:            entity.BatchStatusDisponivel,
+			Quantity:      reservation.QuantitySlabsReserved,
+			ReservationID: &reservation.ID,
+			Reason:        entity.MovementReasonRejeicaoReserva,
+			ActorID:       &approverID,
+		}); err != nil {
+			return err
+		}
+
+		newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs, err = s.slabs.derive(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs)
+		if err != nil {
+			return err
+		}
+
 		if err := s.batchRepo.UpdateSlabCounts(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs); err != nil {
 			return err
 		}
@@ -733,6 +1278,7 @@ func (s *reservationService) Reject(ctx context.Context, reservationID, approver
 			zap.Int("slabsReturned", reservation.QuantitySlabsReserved),
 		)
 
+		releasedBatchID = reservation.BatchID
 		return nil
 	})
 
@@ -745,7 +1291,18 @@ func (s *reservationService) Reject(ctx context.Context, reservationID, approver
 		return nil, err
 	}
 
-	return s.GetByID(ctx, reservationID)
+	if releasedBatchID != "" {
+		s.ProcessWaitlist(ctx, releasedBatchID)
+	}
+
+	rejected, err := s.GetByID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifyReservationRejected(ctx, rejected, reason)
+
+	return rejected, nil
 }
 
 func (s *reservationService) ExpirePendingApprovals(ctx context.Context) (int, error) {
@@ -757,14 +1314,49 @@ func (s *reservationService) ExpirePendingApprovals(ctx context.Context) (int, e
 	}
 
 	count := 0
+	releasedBatches := make(map[string]bool)
 	for _, reservation := range expiredPending {
+		skipped := false
+
 		// Executar cada expiração em transação
 		err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+			// Releitura com lock: aprovação, rejeição ou aceite concorrente tira a reserva da expiração
+			current, err := s.reservationRepo.FindByIDForUpdate(ctx, tx, reservation.ID)
+			if err != nil {
+				return err
+			}
+			if !current.IsApprovalExpired() {
+				skipped = true
+				return nil
+			}
+			reservation = *current
+
 			// 1. Atualizar status da reserva para EXPIRADA
-			if err := s.reservationRepo.UpdateStatus(ctx, tx, reservation.ID, entity.ReservationStatusExpirada); err != nil {
+			if err := s.reservationRepo.UpdateStatus(ctx, tx, reservation.ID, reservation.Status, entity.ReservationStatusExpirada); err != nil {
 				return err
 			}
 
+			if err := s.recordEvent(ctx, tx, entity.ReservationEvent{
+				ReservationID: reservation.ID,
+				Type:          entity.ReservationEventExpirada,
+				FromStatus:    reservation.Status,
+				ToStatus:      entity.ReservationStatusExpirada,
+			}); err != nil {
+				return err
+			}
+
+			if err := s.offerRepo.ClosePending(ctx, tx, reservation.ID, entity.ReservationOfferStatusEncerrada); err != nil {
+				return err
+			}
+
+			if reservation.RemnantID != nil {
+				if err := s.releaseRemnant(ctx, tx, &reservation); err != nil {
+					return err
+				}
+				count++
+				return nil
+			}
+
 			// 2. Devolver chapas ao lote
 			batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, reservation.BatchID)
 			if err != nil {
@@ -779,6 +1371,22 @@ func (s *reservationService) ExpirePendingApprovals(ctx context.Context) (int, e
 				newReservedSlabs = 0
 			}
 
+			// Liberar chapas individuais da reserva
+			if _, err := s.slabs.move(ctx, tx, reservation.BatchID, slabMove{
+				From:          entity.BatchStatusReservado,
+				To:            entity.BatchStatusDisponivel,
+				Quantity:      reservation.QuantitySlabsReserved,
+				ReservationID: &reservation.ID,
+				Reason:        entity.MovementReasonExpiracaoReserva,
+			}); err != nil {
+				return err
+			}
+
+			newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs, err = s.slabs.derive(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs)
+			if err != nil {
+				return err
+			}
+
 			if err := s.batchRepo.UpdateSlabCounts(ctx, tx, reservation.BatchID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs); err != nil {
 				return err
 			}
@@ -797,6 +1405,7 @@ func (s *reservationService) ExpirePendingApprovals(ctx context.Context) (int, e
 				zap.Int("slabsReturned", reservation.QuantitySlabsReserved),
 			)
 
+			releasedBatches[reservation.BatchID] = true
 			return nil
 		})
 
@@ -805,7 +1414,17 @@ func (s *reservationService) ExpirePendingApprovals(ctx context.Context) (int, e
 				zap.String("reservationId", reservation.ID),
 				zap.Error(err),
 			)
+			continue
 		}
+		if skipped {
+			continue
+		}
+
+		s.notifyReservationExpired(ctx, &reservation)
+	}
+
+	for batchID := range releasedBatches {
+		s.ProcessWaitlist(ctx, batchID)
 	}
 
 	s.logger.Info("job de expiração de reservas pendentes concluído",
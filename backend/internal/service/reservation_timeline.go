@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"go.uber.org/zap"
+)
+
+func (s *reservationService) GetTimeline(ctx context.Context, reservationID, userID string) ([]entity.ReservationEvent, error) {
+	reservation, err := s.reservationRepo.FindByID(ctx, reservationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reservation.ReservedByUserID != userID {
+		industryID, err := s.reservationIndustryID(ctx, reservation)
+		if err != nil {
+			return nil, err
+		}
+		user, err := s.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user.Role != entity.RoleAdminIndustria || user.IndustryID == nil || *user.IndustryID != industryID {
+			return nil, domainErrors.ForbiddenError()
+		}
+	}
+
+	events, err := s.eventRepo.FindByReservation(ctx, reservationID)
+	if err != nil {
+		s.logger.Error("erro ao buscar linha do tempo da reserva",
+			zap.String("reservationId", reservationID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// recordEvent registra uma transição da reserva na linha do tempo, na mesma transação da mudança
+func (s *reservationService) recordEvent(ctx context.Context, tx *sql.Tx, event entity.ReservationEvent) error {
+	event.ID = uuid.New().String()
+	event.CreatedAt = time.Now()
+	return s.eventRepo.Create(ctx, tx, &event)
+}
+
+// recordCreated registra a criação da reserva com o status inicial, o preço proposto e o prazo
+func (s *reservationService) recordCreated(ctx context.Context, tx *sql.Tx, reservation *entity.Reservation, notes *string) error {
+	event := entity.ReservationEvent{
+		ReservationID: reservation.ID,
+		Type:          entity.ReservationEventCriada,
+		ToStatus:      reservation.Status,
+		ActorUserID:   &reservation.ReservedByUserID,
+		NewPrice:      reservation.ReservedPrice,
+		Notes:         notes,
+	}
+	// Reservas pendentes só recebem o prazo na aprovação
+	if reservation.Status != entity.ReservationStatusPendenteAprovacao {
+		event.NewExpiresAt = &reservation.ExpiresAt
+	}
+	return s.recordEvent(ctx, tx, event)
+}
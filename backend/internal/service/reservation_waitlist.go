@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	domainService "github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	infraEmail "github.com/thiagomes07/CAVA/backend/internal/infra/email"
+	"go.uber.org/zap"
+)
+
+func (s *reservationService) JoinWaitlist(ctx context.Context, userID string, input entity.JoinWaitlistInput) (*entity.WaitlistEntry, error) {
+	batch, err := s.batchRepo.FindByID(ctx, input.BatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.checkBatchAccess(ctx, userID, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if !batch.IsActive {
+		return nil, domainErrors.ValidationError("Lote não está disponível para reserva")
+	}
+
+	// Se as chapas disponíveis cobrem a fila e o pedido a reserva é direta; senão o pedido entra no fim da fila
+	demand, err := s.waitlistRepo.WaitingDemand(ctx, nil, batch.ID)
+	if err != nil {
+		return nil, err
+	}
+	if batch.AvailableSlabs-demand >= input.QuantitySlabs {
+		return nil, domainErrors.ValidationError("Há chapas disponíveis: crie a reserva diretamente")
+	}
+	// Só chapas reservadas podem voltar ao estoque; pedidos maiores nunca seriam atendidos
+	if input.QuantitySlabs > batch.AvailableSlabs+batch.ReservedSlabs {
+		return nil, domainErrors.ValidationError("Quantidade solicitada excede as chapas do lote que podem ser liberadas")
+	}
+
+	// Os limites do broker valem desde a entrada na fila (e são conferidos de novo na conversão)
+	if user.Role == entity.RoleBroker {
+		policy, err := s.reservationPolicy(ctx, batch.IndustryID)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkBrokerLimits(ctx, nil, userID, batch.IndustryID, input.QuantitySlabs, policy); err != nil {
+			return nil, err
+		}
+	}
+
+	entry := &entity.WaitlistEntry{
+		ID:                uuid.New().String(),
+		BatchID:           batch.ID,
+		IndustryID:        batch.IndustryID,
+		RequestedByUserID: userID,
+		ClienteID:         input.ClienteID,
+		QuantitySlabs:     input.QuantitySlabs,
+		ReservedPrice:     input.ReservedPrice,
+		BrokerSoldPrice:   input.BrokerSoldPrice,
+		Notes:             input.Notes,
+		Status:            entity.WaitlistStatusAguardando,
+	}
+
+	if err := s.waitlistRepo.Create(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("pedido adicionado à fila de espera",
+		zap.String("waitlistId", entry.ID),
+		zap.String("batchId", batch.ID),
+		zap.String("userId", userID),
+		zap.Int("quantity", input.QuantitySlabs),
+	)
+
+	return s.waitlistRepo.FindByID(ctx, entry.ID)
+}
+
+func (s *reservationService) ListWaitlist(ctx context.Context, filters entity.WaitlistFilters) ([]entity.WaitlistEntry, error) {
+	entries, err := s.waitlistRepo.List(ctx, filters)
+	if err != nil {
+		s.logger.Error("erro ao listar fila de espera", zap.Error(err))
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *reservationService) LeaveWaitlist(ctx context.Context, id, userID string) error {
+	entry, err := s.waitlistRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if entry.RequestedByUserID != userID {
+		user, err := s.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if user.Role != entity.RoleAdminIndustria || user.IndustryID == nil || *user.IndustryID != entry.IndustryID {
+			return domainErrors.ForbiddenError()
+		}
+	}
+
+	if entry.Status != entity.WaitlistStatusAguardando {
+		return domainErrors.ValidationError("Apenas pedidos aguardando podem ser retirados da fila")
+	}
+
+	if err := s.waitlistRepo.Cancel(ctx, nil, id); err != nil {
+		return err
+	}
+
+	s.logger.Info("pedido retirado da fila de espera",
+		zap.String("waitlistId", id),
+		zap.String("batchId", entry.BatchID),
+		zap.String("userId", userID),
+	)
+
+	return nil
+}
+
+// WaitlistProcessor atende a fila de espera de um lote depois que chapas voltam a ficar disponíveis
+type WaitlistProcessor interface {
+	ProcessWaitlist(ctx context.Context, batchID string)
+}
+
+// waitlistConversion guarda o pedido atendido para a notificação após o commit
+type waitlistConversion struct {
+	entry       entity.WaitlistEntry
+	reservation *entity.Reservation
+	batchCode   string
+}
+
+// ProcessWaitlist atende a fila de espera do lote com as chapas disponíveis, por ordem de chegada.
+// Chamado após a transação que devolveu chapas ao estoque; falhas ficam apenas no log
+// (os pedidos seguem aguardando a próxima liberação)
+func (s *reservationService) ProcessWaitlist(ctx context.Context, batchID string) {
+	if s.waitlistRepo == nil {
+		return
+	}
+
+	var converted []waitlistConversion
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+		converted = nil
+
+		batch, err := s.batchRepo.FindByIDForUpdate(ctx, tx, batchID)
+		if err != nil {
+			return err
+		}
+
+		entries, err := s.waitlistRepo.FindWaitingForUpdate(ctx, tx, batchID)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			// Só chapas reservadas ainda podem voltar ao estoque; pedidos maiores nunca seriam atendidos
+			if entry.QuantitySlabs > batch.AvailableSlabs+batch.ReservedSlabs {
+				if err := s.waitlistRepo.Cancel(ctx, tx, entry.ID); err != nil {
+					return err
+				}
+				s.logger.Info("pedido da fila de espera retirado por exceder as chapas do lote",
+					zap.String("waitlistId", entry.ID),
+					zap.String("batchId", batch.ID),
+					zap.Int("quantity", entry.QuantitySlabs),
+				)
+				continue
+			}
+			// Pedido que ainda não cabe aguarda a próxima liberação sem segurar os menores atrás dele
+			if !batch.HasAvailableSlabs(entry.QuantitySlabs) {
+				continue
+			}
+
+			reservation, err := s.convertWaitlistEntry(ctx, tx, batch, entry)
+			if err != nil {
+				return err
+			}
+			if reservation == nil {
+				continue
+			}
+			converted = append(converted, waitlistConversion{entry: entry, reservation: reservation, batchCode: batch.BatchCode})
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("erro ao processar fila de espera",
+			zap.String("batchId", batchID),
+			zap.Error(err),
+		)
+		return
+	}
+
+	for _, c := range converted {
+		s.notifyWaitlistConverted(ctx, c)
+		if c.reservation.Status == entity.ReservationStatusPendenteAprovacao {
+			s.notifyAdminsPendingReservation(ctx, c.reservation)
+		}
+	}
+}
+
+// convertWaitlistEntry cria a reserva do pedido seguindo a política da indústria (como em Create) e atualiza
+// os contadores do lote. Pedidos de brokers que já não cabem nos limites da política são retirados da fila (nil),
+// assim como pedidos que falham antes de qualquer escrita (ver skipWaitlistEntry)
+func (s *reservationService) convertWaitlistEntry(ctx context.Context, tx *sql.Tx, batch *entity.Batch, entry entity.WaitlistEntry) (*entity.Reservation, error) {
+	requester, err := s.userRepo.FindByID(ctx, entry.RequestedByUserID)
+	if err != nil {
+		return nil, s.skipWaitlistEntry(ctx, tx, entry, err)
+	}
+	policy, err := s.reservationPolicy(ctx, batch.IndustryID)
+	if err != nil {
+		return nil, s.skipWaitlistEntry(ctx, tx, entry, err)
+	}
+
+	isBroker := requester.Role == entity.RoleBroker
+	if isBroker {
+		violation, err := s.brokerLimitViolation(ctx, tx, requester.ID, batch.IndustryID, entry.QuantitySlabs, policy)
+		if err != nil {
+			return nil, err
+		}
+		if violation != "" {
+			if err := s.waitlistRepo.Cancel(ctx, tx, entry.ID); err != nil {
+				return nil, err
+			}
+			s.logger.Info("pedido da fila de espera retirado por exceder os limites do broker",
+				zap.String("waitlistId", entry.ID),
+				zap.String("batchId", batch.ID),
+				zap.String("userId", requester.ID),
+				zap.String("reason", violation),
+			)
+			return nil, nil
+		}
+	}
+
+	price, err := s.priceLists.ResolveBatchPrice(ctx, batch, entry.RequestedByUserID, entry.ClienteID)
+	if err != nil {
+		return nil, s.skipWaitlistEntry(ctx, tx, entry, err)
+	}
+
+	// Mesma regra de Create: broker fica pendente quando a política exige aprovação e nenhuma regra automática se aplica
+	now := time.Now()
+	status := entity.ReservationStatusAtiva
+	expiresAt := policy.DefaultExpiry(now)
+	var approvalExpiresAt *time.Time
+	if isBroker && policy.RequireBrokerApproval {
+		batchPricePerM2 := entity.ConvertPrice(price.Price, price.PriceUnit, entity.PriceUnitM2)
+		if !policy.AutoApprove.Matches(requester.ID, entry.QuantitySlabs, entry.ReservedPrice, batchPricePerM2) {
+			status = entity.ReservationStatusPendenteAprovacao
+			deadline := now.Add(entity.WaitlistApprovalWindow)
+			approvalExpiresAt = &deadline
+			expiresAt = deadline // redefinido na aprovação
+		}
+	}
+
+	reservation := &entity.Reservation{
+		ID:                    uuid.New().String(),
+		BatchID:               batch.ID,
+		IndustryID:            &batch.IndustryID,
+		ClienteID:             entry.ClienteID,
+		ReservedByUserID:      entry.RequestedByUserID,
+		QuantitySlabsReserved: entry.QuantitySlabs,
+		Status:                status,
+		ReservedPrice:         entry.ReservedPrice,
+		BrokerSoldPrice:       entry.BrokerSoldPrice,
+		IndustryPrice:         &price.Price,
+		PriceUnit:             &price.PriceUnit,
+		Currency:              &price.Currency,
+		Notes:                 entry.Notes,
+		ExpiresAt:             expiresAt,
+		ApprovalExpiresAt:     approvalExpiresAt,
+		IsActive:              true,
+		CreatedAt:             now,
+	}
+
+	if err := s.reservationRepo.Create(ctx, tx, reservation); err != nil {
+		return nil, err
+	}
+
+	note := "Reserva criada a partir da fila de espera"
+	if err := s.recordCreated(ctx, tx, reservation, &note); err != nil {
+		return nil, err
+	}
+	reservation.SlabNumbers, err = s.slabs.move(ctx, tx, batch.ID, slabMove{
+		From:          entity.BatchStatusDisponivel,
+		To:            entity.BatchStatusReservado,
+		Quantity:      entry.QuantitySlabs,
+		ReservationID: &reservation.ID,
+		Reason:        entity.MovementReasonReserva,
+		Notes:         &note,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs, err := s.slabs.derive(ctx, tx, batch.ID,
+		batch.AvailableSlabs-entry.QuantitySlabs, batch.ReservedSlabs+entry.QuantitySlabs, batch.SoldSlabs, batch.InactiveSlabs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.batchRepo.UpdateSlabCounts(ctx, tx, batch.ID, newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs); err != nil {
+		return nil, err
+	}
+
+	newStatus := deriveBatchStatus(newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs)
+	if newStatus != batch.Status {
+		if err := s.batchRepo.UpdateStatus(ctx, tx, batch.ID, newStatus); err != nil {
+			return nil, err
+		}
+	}
+
+	// Próximos pedidos da fila enxergam os contadores já atualizados
+	batch.AvailableSlabs, batch.ReservedSlabs, batch.SoldSlabs, batch.InactiveSlabs = newAvailableSlabs, newReservedSlabs, newSoldSlabs, newInactiveSlabs
+	batch.Status = newStatus
+
+	if err := s.waitlistRepo.MarkConverted(ctx, tx, entry.ID, reservation.ID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("pedido da fila de espera convertido em reserva",
+		zap.String("waitlistId", entry.ID),
+		zap.String("reservationId", reservation.ID),
+		zap.String("batchId", batch.ID),
+		zap.String("status", string(status)),
+		zap.Int("quantity", entry.QuantitySlabs),
+	)
+
+	return reservation, nil
+}
+
+// skipWaitlistEntry trata a falha de um pedido antes de qualquer escrita sem interromper a fila: erros de negócio
+// (ex.: lote sem preço para quem pediu) retiram o pedido da fila; erros internos o deixam aguardando a próxima liberação
+func (s *reservationService) skipWaitlistEntry(ctx context.Context, tx *sql.Tx, entry entity.WaitlistEntry, cause error) error {
+	appErr, ok := cause.(*domainErrors.AppError)
+	if !ok || appErr.StatusCode >= 500 {
+		s.logger.Warn("pedido da fila de espera mantido após falha na conversão",
+			zap.String("waitlistId", entry.ID),
+			zap.String("batchId", entry.BatchID),
+			zap.Error(cause),
+		)
+		return nil
+	}
+
+	if err := s.waitlistRepo.Cancel(ctx, tx, entry.ID); err != nil {
+		return err
+	}
+	s.logger.Info("pedido da fila de espera retirado por falha na conversão",
+		zap.String("waitlistId", entry.ID),
+		zap.String("batchId", entry.BatchID),
+		zap.String("reason", appErr.Message),
+	)
+	return nil
+}
+
+// notifyWaitlistConverted avisa por email quem pediu que as chapas foram reservadas
+func (s *reservationService) notifyWaitlistConverted(ctx context.Context, c waitlistConversion) {
+	if s.emailSender == nil {
+		return
+	}
+
+	user, err := s.userRepo.FindByID(ctx, c.entry.RequestedByUserID)
+	if err != nil {
+		s.logger.Warn("erro ao buscar usuário da fila de espera", zap.String("userId", c.entry.RequestedByUserID), zap.Error(err))
+		return
+	}
+
+	message := fmt.Sprintf(
+		"As %d chapa(s) que você aguardava do lote %s foram liberadas e reservadas para você. A reserva vence em %s.",
+		c.entry.QuantitySlabs, c.batchCode, c.reservation.ExpiresAt.Format("02/01/2006 15:04"),
+	)
+	if c.reservation.Status == entity.ReservationStatusPendenteAprovacao {
+		message = fmt.Sprintf(
+			"As %d chapa(s) que você aguardava do lote %s foram liberadas e reservadas para você. A reserva está pendente de aprovação até %s.",
+			c.entry.QuantitySlabs, c.batchCode, formatOptionalTime(c.reservation.ApprovalExpiresAt),
+		)
+	}
+
+	htmlBody, textBody, err := infraEmail.RenderNotificationEmail(infraEmail.NotificationData{
+		UserName:    user.Name,
+		Title:       "Chapas liberadas para sua reserva",
+		Message:     message,
+		ActionURL:   s.frontendURL + "/reservations",
+		ActionLabel: "Ver reservas",
+	})
+	if err != nil {
+		s.logger.Warn("erro ao renderizar email da fila de espera", zap.Error(err))
+		return
+	}
+
+	if err := s.emailSender.Send(ctx, domainService.EmailMessage{
+		To:       user.Email,
+		Subject:  fmt.Sprintf("Lote %s: chapas reservadas para você - CAVA Stone Platform", c.batchCode),
+		HTMLBody: htmlBody,
+		TextBody: textBody,
+	}); err != nil {
+		s.logger.Warn("erro ao enviar email da fila de espera",
+			zap.String("reservationId", c.reservation.ID),
+			zap.String("userId", user.ID),
+			zap.Error(err),
+		)
+	}
+}
@@ -20,6 +20,10 @@ type salesHistoryService struct {
 	batchRepo   repository.BatchRepository
 	userRepo    repository.UserRepository
 	clienteRepo repository.ClienteRepository
+	slabRepo    repository.SlabRepository
+	moveRepo    repository.BatchMovementRepository
+	waitlist    WaitlistProcessor
+	slabs       slabTracker
 	db          SalesHistoryDB
 	logger      *zap.Logger
 }
@@ -29,6 +33,9 @@ func NewSalesHistoryService(
 	batchRepo repository.BatchRepository,
 	userRepo repository.UserRepository,
 	clienteRepo repository.ClienteRepository,
+	slabRepo repository.SlabRepository,
+	moveRepo repository.BatchMovementRepository,
+	waitlist WaitlistProcessor,
 	db SalesHistoryDB,
 	logger *zap.Logger,
 ) *salesHistoryService {
@@ -37,6 +44,10 @@ func NewSalesHistoryService(
 		batchRepo:   batchRepo,
 		userRepo:    userRepo,
 		clienteRepo: clienteRepo,
+		slabRepo:    slabRepo,
+		moveRepo:    moveRepo,
+		waitlist:    waitlist,
+		slabs:       slabTracker{slabRepo: slabRepo, movementRepo: moveRepo},
 		db:          db,
 		logger:      logger,
 	}
@@ -134,6 +145,18 @@ func (s *salesHistoryService) GetByID(ctx context.Context, id string) (*entity.S
 		)
 	}
 
+	// Buscar chapas vendidas (lotes com rastreamento por chapa)
+	slabs, err := s.slabRepo.FindBySaleID(ctx, id)
+	if err != nil {
+		s.logger.Warn("erro ao buscar chapas da venda",
+			zap.String("saleId", id),
+			zap.Error(err),
+		)
+	}
+	for _, slab := range slabs {
+		sale.SlabNumbers = append(sale.SlabNumbers, slab.SlabNumber)
+	}
+
 	return sale, nil
 }
 
@@ -241,8 +264,10 @@ func (s *salesHistoryService) populateSaleData(ctx context.Context, sale *entity
 	return nil
 }
 
-func (s *salesHistoryService) Delete(ctx context.Context, id string) error {
-	return s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
+func (s *salesHistoryService) Delete(ctx context.Context, id, userID string) error {
+	var batchID string
+
+	err := s.db.ExecuteInTx(ctx, func(tx *sql.Tx) error {
 		// 1. Buscar venda (para saber qtos itens restaurar)
 		sale, err := s.salesRepo.FindByID(ctx, id)
 		if err != nil {
@@ -255,6 +280,8 @@ func (s *salesHistoryService) Delete(ctx context.Context, id string) error {
 			return err
 		}
 
+		batchID = batch.ID
+
 		// 3. Restaurar contadores
 		newAvailable := batch.AvailableSlabs + sale.QuantitySlabsSold
 		newSold := batch.SoldSlabs - sale.QuantitySlabsSold
@@ -267,12 +294,29 @@ func (s *salesHistoryService) Delete(ctx context.Context, id string) error {
 			newSold = 0
 		}
 
-		if err := s.batchRepo.UpdateSlabCounts(ctx, tx, batch.ID, newAvailable, batch.ReservedSlabs, newSold, batch.InactiveSlabs); err != nil {
+		// Devolver chapas individuais da venda ao estoque disponível
+		if _, err := s.slabs.move(ctx, tx, batch.ID, slabMove{
+			From:     entity.BatchStatusVendido,
+			To:       entity.BatchStatusDisponivel,
+			Quantity: sale.QuantitySlabsSold,
+			SaleID:   &sale.ID,
+			Reason:   entity.MovementReasonEstornoVenda,
+			ActorID:  &userID,
+		}); err != nil {
+			return err
+		}
+
+		newAvailable, newReserved, newSold, newInactive, err := s.slabs.derive(ctx, tx, batch.ID, newAvailable, batch.ReservedSlabs, newSold, batch.InactiveSlabs)
+		if err != nil {
+			return err
+		}
+
+		if err := s.batchRepo.UpdateSlabCounts(ctx, tx, batch.ID, newAvailable, newReserved, newSold, newInactive); err != nil {
 			return err
 		}
 
 		// 4. Atualizar Status do Lote
-		newStatus := deriveBatchStatus(newAvailable, batch.ReservedSlabs, newSold, batch.InactiveSlabs)
+		newStatus := deriveBatchStatus(newAvailable, newReserved, newSold, newInactive)
 		if newStatus != batch.Status {
 			if err := s.batchRepo.UpdateStatus(ctx, tx, batch.ID, newStatus); err != nil {
 				return err
@@ -292,6 +336,14 @@ func (s *salesHistoryService) Delete(ctx context.Context, id string) error {
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// Chapas devolvidas ao estoque atendem primeiro a fila de espera
+	s.waitlist.ProcessWaitlist(ctx, batchID)
+
+	return nil
 }
 
 
@@ -11,6 +11,7 @@ import (
 	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
 	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
 	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	domainService "github.com/thiagomes07/CAVA/backend/internal/domain/service"
 	"go.uber.org/zap"
 )
 
@@ -23,6 +24,8 @@ type salesLinkService struct {
 	mediaRepo        repository.MediaRepository
 	userRepo         repository.UserRepository
 	sharedInventoryRepo repository.SharedInventoryRepository
+	priceLists       domainService.PriceListService
+	exchangeRates    domainService.ExchangeRateService
 	baseURL          string
 	logger           *zap.Logger
 }
@@ -34,6 +37,8 @@ func NewSalesLinkService(
 	mediaRepo repository.MediaRepository,
 	userRepo repository.UserRepository,
 	sharedInventoryRepo repository.SharedInventoryRepository,
+	priceLists domainService.PriceListService,
+	exchangeRates domainService.ExchangeRateService,
 	baseURL string,
 	logger *zap.Logger,
 ) *salesLinkService {
@@ -44,6 +49,8 @@ func NewSalesLinkService(
 		mediaRepo:        mediaRepo,
 		userRepo:         userRepo,
 		sharedInventoryRepo: sharedInventoryRepo,
+		priceLists:       priceLists,
+		exchangeRates:    exchangeRates,
 		baseURL:          baseURL,
 		logger:           logger,
 	}
@@ -120,6 +127,20 @@ func (s *salesLinkService) Create(ctx context.Context, userID, industryID string
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
 	}
+	var priceCurrency entity.Currency
+	link.PriceSnapshot, link.PriceSnapshotUnit, priceCurrency = s.currentPrice(ctx, userID, input)
+	link.Currency = input.Currency
+	if link.Currency == "" {
+		link.Currency = priceCurrency.OrDefault()
+	}
+	// O preço vigente fica guardado na moeda do link (convertido pela cotação do dia, se a moeda escolhida for outra)
+	if link.PriceSnapshot != nil && priceCurrency.OrDefault() != link.Currency {
+		converted, err := s.exchangeRates.Convert(ctx, industryID, *link.PriceSnapshot, priceCurrency.OrDefault(), link.Currency, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		link.PriceSnapshot = &converted
+	}
 
 	if err := s.linkRepo.Create(ctx, link); err != nil {
 		s.logger.Error("erro ao criar link de venda",
@@ -154,6 +175,7 @@ func (s *salesLinkService) createMultipleBatchesLink(ctx context.Context, userID
 
 	// Validar cada item e verificar disponibilidade
 	var totalPrice float64
+	currency := input.Currency
 	items := make([]entity.SalesLinkItem, 0, len(input.Items))
 
 	for _, itemInput := range input.Items {
@@ -204,6 +226,15 @@ func (s *salesLinkService) createMultipleBatchesLink(ctx context.Context, userID
 			}
 		}
 
+		// Sem moeda informada, os preços dos itens seguem a moeda dos lotes, que precisa ser a mesma
+		if input.Currency == "" {
+			if currency == "" {
+				currency = batch.Currency.OrDefault()
+			} else if batch.Currency.OrDefault() != currency {
+				return nil, domainErrors.ValidationError("Lotes com moedas diferentes: informe a moeda do link")
+			}
+		}
+
 		// Criar item
 		item := entity.SalesLinkItem{
 			ID:        uuid.New().String(),
@@ -246,6 +277,7 @@ func (s *salesLinkService) createMultipleBatchesLink(ctx context.Context, userID
 		Title:           input.Title,
 		CustomMessage:   input.CustomMessage,
 		DisplayPrice:    displayPrice,
+		Currency:        currency.OrDefault(),
 		ShowPrice:       input.ShowPrice,
 		ViewsCount:      0,
 		ExpiresAt:       expiresAt,
@@ -350,7 +382,7 @@ func (s *salesLinkService) GetBySlug(ctx context.Context, slug string) (*entity.
 	return link, nil
 }
 
-func (s *salesLinkService) GetPublicBySlug(ctx context.Context, slug string) (*entity.PublicSalesLink, error) {
+func (s *salesLinkService) GetPublicBySlug(ctx context.Context, slug string, currency *entity.Currency) (*entity.PublicSalesLink, error) {
 	link, err := s.linkRepo.FindBySlug(ctx, slug)
 	if err != nil {
 		return nil, err
@@ -365,6 +397,7 @@ func (s *salesLinkService) GetPublicBySlug(ctx context.Context, slug string) (*e
 	}
 
 	result := &entity.PublicSalesLink{
+		Currency:  link.Currency.OrDefault(),
 		ShowPrice: link.ShowPrice,
 	}
 
@@ -376,6 +409,18 @@ func (s *salesLinkService) GetPublicBySlug(ctx context.Context, slug string) (*e
 	}
 	if link.ShowPrice && link.DisplayPrice != nil {
 		result.DisplayPrice = link.DisplayPrice
+	} else if link.ShowPrice && link.PriceSnapshot != nil {
+		// Sem preço customizado, exibir o preço vigente quando o link foi criado
+		result.DisplayPrice = link.PriceSnapshot
+		result.PriceUnit = link.PriceSnapshotUnit
+	}
+	if result.DisplayPrice != nil {
+		price, priceCurrency, err := convertForDisplay(ctx, s.exchangeRates, link.IndustryID, *result.DisplayPrice, link.Currency, currency)
+		if err != nil {
+			return nil, err
+		}
+		result.DisplayPrice = &price
+		result.Currency = priceCurrency
 	}
 
 	// Para MULTIPLOS_LOTES, buscar itens
@@ -414,8 +459,13 @@ func (s *salesLinkService) GetPublicBySlug(ctx context.Context, slug string) (*e
 
 				// Se showPrice, incluir preços
 				if link.ShowPrice {
-					publicItem.UnitPrice = item.UnitPrice
-					publicItem.TotalPrice = float64(item.Quantity) * item.UnitPrice
+					unitPrice, priceCurrency, err := convertForDisplay(ctx, s.exchangeRates, link.IndustryID, item.UnitPrice, link.Currency, currency)
+					if err != nil {
+						return nil, err
+					}
+					publicItem.UnitPrice = unitPrice
+					publicItem.TotalPrice = float64(item.Quantity) * unitPrice
+					result.Currency = priceCurrency
 				}
 
 				publicItems = append(publicItems, publicItem)
@@ -716,6 +766,36 @@ func (s *salesLinkService) validateLinkTypeFields(ctx context.Context, userID, i
 	return industryID, nil
 }
 
+// currentPrice retorna o preço vigente do lote/produto, resolvido pela tabela de quem cria o link
+func (s *salesLinkService) currentPrice(ctx context.Context, userID string, input entity.CreateSalesLinkInput) (*float64, *entity.PriceUnit, entity.Currency) {
+	var resolved *entity.ResolvedPrice
+	var err error
+
+	switch {
+	case input.LinkType == entity.LinkTypeLoteUnico && input.BatchID != nil:
+		batch, findErr := s.batchRepo.FindByID(ctx, *input.BatchID)
+		if findErr != nil {
+			return nil, nil, ""
+		}
+		resolved, err = s.priceLists.ResolveBatchPrice(ctx, batch, userID, nil)
+	case input.LinkType == entity.LinkTypeProdutoGeral && input.ProductID != nil:
+		product, findErr := s.productRepo.FindByID(ctx, *input.ProductID)
+		if findErr != nil {
+			return nil, nil, ""
+		}
+		resolved, err = s.priceLists.ResolveProductPrice(ctx, product, userID)
+	}
+
+	if err != nil {
+		s.logger.Warn("erro ao resolver preço do link", zap.Error(err))
+		return nil, nil, ""
+	}
+	if resolved == nil {
+		return nil, nil, ""
+	}
+	return &resolved.Price, &resolved.PriceUnit, resolved.Currency
+}
+
 // populateLinkData popula dados relacionados do link
 func (s *salesLinkService) populateLinkData(ctx context.Context, link *entity.SalesLink) error {
 	// Buscar batch se for lote único
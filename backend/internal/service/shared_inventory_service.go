@@ -8,6 +8,7 @@ import (
 	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
 	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
 	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	domainService "github.com/thiagomes07/CAVA/backend/internal/domain/service"
 	"go.uber.org/zap"
 )
 
@@ -17,6 +18,7 @@ type sharedInventoryService struct {
 	userRepo    repository.UserRepository
 	mediaRepo   repository.MediaRepository
 	productRepo repository.ProductRepository
+	priceLists  domainService.PriceListService
 	logger      *zap.Logger
 }
 
@@ -26,6 +28,7 @@ func NewSharedInventoryService(
 	userRepo repository.UserRepository,
 	mediaRepo repository.MediaRepository,
 	productRepo repository.ProductRepository,
+	priceLists domainService.PriceListService,
 	logger *zap.Logger,
 ) *sharedInventoryService {
 	return &sharedInventoryService{
@@ -34,6 +37,7 @@ func NewSharedInventoryService(
 		userRepo:    userRepo,
 		mediaRepo:   mediaRepo,
 		productRepo: productRepo,
+		priceLists:  priceLists,
 		logger:      logger,
 	}
 }
@@ -205,6 +209,18 @@ func (s *sharedInventoryService) GetUserInventory(ctx context.Context, userID st
 			}
 
 			shared[i].Batch = batch
+
+			// Resolver preço pela tabela atribuída ao usuário
+			resolved, err := s.priceLists.ResolveBatchPrice(ctx, batch, shared[i].SharedWithUserID, nil)
+			if err != nil {
+				s.logger.Warn("erro ao resolver tabela de preço",
+					zap.String("batchId", batch.ID),
+					zap.Error(err),
+				)
+			} else {
+				shared[i].PriceList = resolved
+			}
+
 			// Populate calculated fields (effectivePrice, effectiveSlabPrice)
 			shared[i].PopulateCalculatedFields()
 		}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+)
+
+// slabMove descreve a movimentação de chapas entre status
+type slabMove struct {
+	From          entity.BatchStatus
+	To            entity.BatchStatus
+	Quantity      int
+	Numbers       []int   // números específicos (opcional)
+	ReservationID *string // reserva de origem (From=RESERVADO) ou destino (To=RESERVADO/VENDIDO)
+	SaleID        *string // venda de origem (From=VENDIDO) ou destino (To=VENDIDO)
+	Reason        entity.MovementReason
+	ActorID       *string
+	Notes         *string
+}
+
+// slabTracker mantém as chapas individuais e o razão de movimentações sincronizados
+// com os contadores do lote. Lotes sem chapas cadastradas (legados) seguem controlados
+// apenas pelos contadores, mas continuam registrando movimentações.
+type slabTracker struct {
+	slabRepo     repository.SlabRepository
+	movementRepo repository.BatchMovementRepository
+}
+
+// tracked indica se o lote possui rastreamento por chapa
+func (t slabTracker) tracked(ctx context.Context, tx *sql.Tx, batchID string) (bool, error) {
+	count, err := t.slabRepo.CountByBatchID(ctx, tx, batchID)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// move movimenta chapas entre status, registra a movimentação no razão e retorna os números afetados
+func (t slabTracker) move(ctx context.Context, tx *sql.Tx, batchID string, m slabMove) ([]int, error) {
+	if m.Quantity <= 0 {
+		return nil, nil
+	}
+
+	numbers, err := t.moveSlabs(ctx, tx, batchID, m)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := m.From, m.To
+	if err := t.record(ctx, tx, entity.BatchMovement{
+		BatchID:       batchID,
+		FromStatus:    &from,
+		ToStatus:      &to,
+		Quantity:      m.Quantity,
+		SlabNumbers:   numbers,
+		Reason:        m.Reason,
+		Notes:         m.Notes,
+		ActorUserID:   m.ActorID,
+		ReservationID: m.ReservationID,
+		SaleID:        m.SaleID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return numbers, nil
+}
+
+// record grava um lançamento no razão de movimentações
+func (t slabTracker) record(ctx context.Context, tx *sql.Tx, movement entity.BatchMovement) error {
+	if t.movementRepo == nil {
+		return nil
+	}
+	movement.ID = uuid.New().String()
+	return t.movementRepo.Create(ctx, tx, &movement)
+}
+
+// moveSlabs atualiza as chapas individuais de lotes rastreados
+func (t slabTracker) moveSlabs(ctx context.Context, tx *sql.Tx, batchID string, m slabMove) ([]int, error) {
+	tracked, err := t.tracked(ctx, tx, batchID)
+	if err != nil {
+		return nil, err
+	}
+	if !tracked {
+		if len(m.Numbers) > 0 {
+			return nil, domainErrors.ValidationError("Lote não possui rastreamento por chapa")
+		}
+		return nil, nil
+	}
+
+	var sourceReservation, sourceSale *string
+	if m.From == entity.BatchStatusReservado {
+		sourceReservation = m.ReservationID
+	}
+	if m.From == entity.BatchStatusVendido {
+		sourceSale = m.SaleID
+	}
+
+	var slabs []entity.Slab
+	if len(m.Numbers) > 0 {
+		if len(m.Numbers) != m.Quantity {
+			return nil, domainErrors.ValidationError("Quantidade deve corresponder às chapas informadas")
+		}
+		seen := make(map[int]bool, len(m.Numbers))
+		for _, n := range m.Numbers {
+			if seen[n] {
+				return nil, domainErrors.ValidationError(fmt.Sprintf("Chapa %d informada mais de uma vez", n))
+			}
+			seen[n] = true
+		}
+
+		slabs, err = t.slabRepo.FindByNumbersForUpdate(ctx, tx, batchID, m.Numbers)
+		if err != nil {
+			return nil, err
+		}
+		if len(slabs) != len(m.Numbers) {
+			return nil, domainErrors.ValidationError("Uma ou mais chapas não pertencem ao lote")
+		}
+		for _, slab := range slabs {
+			if slab.Status != m.From {
+				return nil, domainErrors.ValidationError(fmt.Sprintf("Chapa %d não está com status %s", slab.SlabNumber, m.From))
+			}
+			if sourceReservation != nil && slab.ReservationID != nil && *slab.ReservationID != *sourceReservation {
+				return nil, domainErrors.ValidationError(fmt.Sprintf("Chapa %d pertence a outra reserva", slab.SlabNumber))
+			}
+			if sourceSale != nil && slab.SaleID != nil && *slab.SaleID != *sourceSale {
+				return nil, domainErrors.ValidationError(fmt.Sprintf("Chapa %d pertence a outra venda", slab.SlabNumber))
+			}
+		}
+	} else {
+		slabs, err = t.slabRepo.FindByStatusForUpdate(ctx, tx, batchID, m.From, sourceReservation, sourceSale, m.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		if len(slabs) < m.Quantity {
+			return nil, domainErrors.InsufficientSlabsError(m.Quantity, len(slabs))
+		}
+	}
+
+	// Vínculos do destino: reserva mantém a chapa, venda preserva a reserva de origem
+	var reservationID, saleID *string
+	switch m.To {
+	case entity.BatchStatusReservado:
+		reservationID = m.ReservationID
+	case entity.BatchStatusVendido:
+		reservationID = m.ReservationID
+		saleID = m.SaleID
+	}
+
+	ids := make([]string, len(slabs))
+	numbers := make([]int, len(slabs))
+	for i, slab := range slabs {
+		ids[i] = slab.ID
+		numbers[i] = slab.SlabNumber
+	}
+
+	if err := t.slabRepo.UpdateStatus(ctx, tx, ids, m.To, reservationID, saleID); err != nil {
+		return nil, err
+	}
+
+	return numbers, nil
+}
+
+// derive retorna os contadores do lote a partir das chapas quando rastreado,
+// caso contrário mantém os contadores calculados
+func (t slabTracker) derive(ctx context.Context, tx *sql.Tx, batchID string, available, reserved, sold, inactive int) (int, int, int, int, error) {
+	counts, err := t.slabRepo.CountByStatus(ctx, tx, batchID)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if counts.Total() == 0 {
+		return available, reserved, sold, inactive, nil
+	}
+	return counts.Available, counts.Reserved, counts.Sold, counts.Inactive, nil
+}
+
+// generate cria chapas sequenciais a partir de `start` com as dimensões do lote
+func (t slabTracker) generate(ctx context.Context, tx *sql.Tx, batch *entity.Batch, start, quantity int, status entity.BatchStatus) error {
+	if quantity <= 0 {
+		return nil
+	}
+
+	slabs := make([]entity.Slab, quantity)
+	for i := range slabs {
+		slabs[i] = entity.Slab{
+			ID:         uuid.New().String(),
+			BatchID:    batch.ID,
+			SlabNumber: start + i,
+			Height:     batch.Height,
+			Width:      batch.Width,
+			Thickness:  batch.Thickness,
+			Status:     status,
+		}
+	}
+
+	return t.slabRepo.CreateMany(ctx, tx, slabs)
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	domainService "github.com/thiagomes07/CAVA/backend/internal/domain/service"
+	infraEmail "github.com/thiagomes07/CAVA/backend/internal/infra/email"
+	"go.uber.org/zap"
+)
+
+type stockAlertService struct {
+	alertRepo    repository.StockAlertRepository
+	industryRepo repository.IndustryRepository
+	userRepo     repository.UserRepository
+	emailSender  domainService.EmailSender
+	frontendURL  string
+	logger       *zap.Logger
+}
+
+func NewStockAlertService(
+	alertRepo repository.StockAlertRepository,
+	industryRepo repository.IndustryRepository,
+	userRepo repository.UserRepository,
+	emailSender domainService.EmailSender,
+	frontendURL string,
+	logger *zap.Logger,
+) *stockAlertService {
+	return &stockAlertService{
+		alertRepo:    alertRepo,
+		industryRepo: industryRepo,
+		userRepo:     userRepo,
+		emailSender:  emailSender,
+		frontendURL:  frontendURL,
+		logger:       logger,
+	}
+}
+
+func (s *stockAlertService) CheckAlerts(ctx context.Context) (int, error) {
+	opened, resolved, err := s.alertRepo.Sync(ctx)
+	if err != nil {
+		s.logger.Error("erro ao sincronizar alertas de estoque", zap.Error(err))
+		return 0, err
+	}
+
+	pending, err := s.alertRepo.FindPendingNotification(ctx)
+	if err != nil {
+		s.logger.Error("erro ao buscar alertas de estoque pendentes", zap.Error(err))
+		return 0, err
+	}
+
+	byIndustry := make(map[string][]entity.StockAlert)
+	for _, alert := range pending {
+		byIndustry[alert.IndustryID] = append(byIndustry[alert.IndustryID], alert)
+	}
+
+	notified := 0
+	for industryID, alerts := range byIndustry {
+		// Falha de uma indústria não impede as demais; os alertas seguem pendentes para a próxima execução
+		if err := s.notifyIndustry(ctx, industryID, alerts); err != nil {
+			s.logger.Error("erro ao notificar alertas de estoque",
+				zap.String("industryId", industryID),
+				zap.Int("alerts", len(alerts)),
+				zap.Error(err),
+			)
+			continue
+		}
+		notified += len(alerts)
+	}
+
+	s.logger.Info("job de alertas de estoque concluído",
+		zap.Int("opened", opened),
+		zap.Int("resolved", resolved),
+		zap.Int("notified", notified),
+	)
+
+	return notified, nil
+}
+
+// notifyIndustry envia um único email por administrador com todos os alertas novos da indústria
+func (s *stockAlertService) notifyIndustry(ctx context.Context, industryID string, alerts []entity.StockAlert) error {
+	industry, err := s.industryRepo.FindByID(ctx, industryID)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, len(alerts))
+	for i, alert := range alerts {
+		ids[i] = alert.ID
+	}
+
+	// Com email desativado (ou sem sender) os alertas ficam apenas na listagem
+	if !industry.StockAlertSettings.EmailEnabled || s.emailSender == nil {
+		return s.alertRepo.MarkNotified(ctx, ids)
+	}
+
+	role := entity.RoleAdminIndustria
+	admins, err := s.userRepo.ListByIndustry(ctx, industryID, &role)
+	if err != nil {
+		return err
+	}
+
+	data := infraEmail.StockAlertEmailData{ActionURL: s.frontendURL + "/inventory"}
+	for _, alert := range alerts {
+		line := infraEmail.StockAlertLine{BatchCode: alert.BatchCode}
+		if alert.ProductName != nil {
+			line.ProductName = *alert.ProductName
+		}
+		switch alert.Type {
+		case entity.StockAlertTypeEstoqueBaixo:
+			line.Detail = fmt.Sprintf("%d chapa(s) disponível(is) (limite: %d)", alert.CurrentValue, alert.Threshold)
+			data.LowStock = append(data.LowStock, line)
+		case entity.StockAlertTypeEstoqueParado:
+			line.Detail = fmt.Sprintf("%d dias sem movimento (limite: %d)", alert.CurrentValue, alert.Threshold)
+			data.Stale = append(data.Stale, line)
+		}
+	}
+
+	sent := 0
+	for _, admin := range admins {
+		if !admin.IsActive {
+			continue
+		}
+
+		data.UserName = admin.Name
+		htmlBody, textBody, err := infraEmail.RenderStockAlertEmail(data)
+		if err != nil {
+			return fmt.Errorf("falha ao renderizar email de alertas de estoque: %w", err)
+		}
+
+		if err := s.emailSender.Send(ctx, domainService.EmailMessage{
+			To:       admin.Email,
+			Subject:  fmt.Sprintf("%d alerta(s) de estoque - CAVA Stone Platform", len(alerts)),
+			HTMLBody: htmlBody,
+			TextBody: textBody,
+		}); err != nil {
+			s.logger.Warn("erro ao enviar alerta de estoque",
+				zap.String("industryId", industryID),
+				zap.String("userId", admin.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		sent++
+	}
+
+	// Sem nenhum envio, mantém pendente para nova tentativa (exceto se não há administradores ativos)
+	if sent == 0 && hasActiveUser(admins) {
+		return fmt.Errorf("nenhum email de alerta de estoque enviado")
+	}
+
+	return s.alertRepo.MarkNotified(ctx, ids)
+}
+
+func (s *stockAlertService) List(ctx context.Context, industryID string, filters entity.StockAlertFilters) (*entity.StockAlertListResponse, error) {
+	alerts, total, err := s.alertRepo.List(ctx, industryID, filters)
+	if err != nil {
+		s.logger.Error("erro ao listar alertas de estoque",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &entity.StockAlertListResponse{
+		Alerts: alerts,
+		Total:  total,
+		Page:   filters.Page,
+	}, nil
+}
+
+// hasActiveUser verifica se há algum usuário ativo na lista
+func hasActiveUser(users []entity.User) bool {
+	for _, user := range users {
+		if user.IsActive {
+			return true
+		}
+	}
+	return false
+}
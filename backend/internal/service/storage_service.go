@@ -52,6 +52,7 @@ func (s *storageService) UploadFile(ctx context.Context, bucket, key string, rea
 		"image/png",
 		"image/webp",
 		"application/pdf",
+		"application/json",
 	}
 	if !s.ValidateFileType(contentType, allowedTypes) {
 		return "", domainErrors.ValidationError("Tipo de arquivo não permitido")
@@ -111,6 +112,55 @@ func (s *storageService) UploadBatchMedia(ctx context.Context, batchID string, r
 	return s.UploadFile(ctx, s.bucketName, key, reader, contentType, size)
 }
 
+func (s *storageService) UploadDamagePhoto(ctx context.Context, reportID string, reader io.Reader, filename, contentType string, size int64) (string, error) {
+	// Validar tipo de arquivo (apenas imagens para avarias)
+	allowedTypes := []string{"image/jpeg", "image/png", "image/webp"}
+	if !s.ValidateFileType(contentType, allowedTypes) {
+		return "", domainErrors.ValidationError("Apenas imagens são permitidas para avarias")
+	}
+
+	// Validar tamanho (5MB máximo para imagens)
+	if !s.ValidateFileSize(size, 5*1024*1024) {
+		return "", domainErrors.ValidationError("Imagem muito grande. Tamanho máximo: 5MB")
+	}
+
+	// Gerar key única
+	key := s.generateDamagePhotoKey(reportID, filename)
+
+	return s.UploadFile(ctx, s.bucketName, key, reader, contentType, size)
+}
+
+func (s *storageService) UploadRemnantPhoto(ctx context.Context, remnantID string, reader io.Reader, filename, contentType string, size int64) (string, error) {
+	// Validar tipo de arquivo (apenas imagens para retalhos)
+	allowedTypes := []string{"image/jpeg", "image/png", "image/webp"}
+	if !s.ValidateFileType(contentType, allowedTypes) {
+		return "", domainErrors.ValidationError("Apenas imagens são permitidas para retalhos")
+	}
+
+	// Validar tamanho (5MB máximo para imagens)
+	if !s.ValidateFileSize(size, 5*1024*1024) {
+		return "", domainErrors.ValidationError("Imagem muito grande. Tamanho máximo: 5MB")
+	}
+
+	// Gerar key única
+	key := s.generateRemnantPhotoKey(remnantID, filename)
+
+	return s.UploadFile(ctx, s.bucketName, key, reader, contentType, size)
+}
+
+func (s *storageService) UploadPackingList(ctx context.Context, saleID string, reader io.Reader, filename, contentType string, size int64) (string, error) {
+	// Validar tipo de arquivo (PDF e versão estruturada em JSON)
+	allowedTypes := []string{"application/pdf", "application/json"}
+	if !s.ValidateFileType(contentType, allowedTypes) {
+		return "", domainErrors.ValidationError("Apenas PDF ou JSON são permitidos para romaneios")
+	}
+
+	// Key fixa por venda: a regeneração substitui o arquivo anterior
+	key := s.generatePackingListKey(saleID, filename)
+
+	return s.UploadFile(ctx, s.bucketName, key, reader, contentType, size)
+}
+
 func (s *storageService) UploadIndustryLogo(ctx context.Context, industryID string, reader io.Reader, filename, contentType string, size int64) (string, error) {
 	// Validar tipo de arquivo
 	allowedTypes := []string{"image/jpeg", "image/png", "image/webp"}
@@ -207,6 +257,32 @@ func (s *storageService) generateBatchMediaKey(batchID, filename string) string
 	return fmt.Sprintf("batches/%s/%d_%s_%s", batchID, timestamp, uniqueID, sanitized)
 }
 
+// generateDamagePhotoKey gera a key para foto de avaria
+// Formato: damage-reports/{reportID}/{timestamp}_{uuid}_{filename}
+func (s *storageService) generateDamagePhotoKey(reportID, filename string) string {
+	timestamp := time.Now().Unix()
+	uniqueID := uuid.New().String()[:8]
+	sanitized := sanitizeFilename(filename)
+
+	return fmt.Sprintf("damage-reports/%s/%d_%s_%s", reportID, timestamp, uniqueID, sanitized)
+}
+
+// generateRemnantPhotoKey gera a key para foto de retalho
+// Formato: remnants/{remnantID}/{timestamp}_{uuid}_{filename}
+func (s *storageService) generateRemnantPhotoKey(remnantID, filename string) string {
+	timestamp := time.Now().Unix()
+	uniqueID := uuid.New().String()[:8]
+	sanitized := sanitizeFilename(filename)
+
+	return fmt.Sprintf("remnants/%s/%d_%s_%s", remnantID, timestamp, uniqueID, sanitized)
+}
+
+// generatePackingListKey gera a key para arquivo de romaneio
+// Formato: packing-lists/{saleID}/{filename}
+func (s *storageService) generatePackingListKey(saleID, filename string) string {
+	return fmt.Sprintf("packing-lists/%s/%s", saleID, sanitizeFilename(filename))
+}
+
 // generateIndustryLogoKey gera a key para logo da indústria
 // Formato: industries/{industryID}/logo_{timestamp}_{filename}
 func (s *storageService) generateIndustryLogoKey(industryID, filename string) string {
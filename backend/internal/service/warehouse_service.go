@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/entity"
+	domainErrors "github.com/thiagomes07/CAVA/backend/internal/domain/errors"
+	"github.com/thiagomes07/CAVA/backend/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+type warehouseService struct {
+	warehouseRepo repository.WarehouseRepository
+	logger        *zap.Logger
+}
+
+func NewWarehouseService(
+	warehouseRepo repository.WarehouseRepository,
+	logger *zap.Logger,
+) *warehouseService {
+	return &warehouseService{
+		warehouseRepo: warehouseRepo,
+		logger:        logger,
+	}
+}
+
+func (s *warehouseService) Create(ctx context.Context, industryID string, input entity.CreateWarehouseInput) (*entity.Warehouse, error) {
+	code := strings.ToUpper(strings.TrimSpace(input.Code))
+	if code == "" {
+		return nil, domainErrors.ValidationError("Código do depósito é obrigatório")
+	}
+
+	exists, err := s.warehouseRepo.ExistsByCode(ctx, industryID, code, "")
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, domainErrors.NewConflictError("Código de depósito já cadastrado")
+	}
+
+	warehouse := &entity.Warehouse{
+		ID:         uuid.New().String(),
+		IndustryID: industryID,
+		Code:       code,
+		Name:       strings.TrimSpace(input.Name),
+		Address:    input.Address,
+		City:       input.City,
+		State:      upperState(input.State),
+		IsActive:   true,
+		Locations:  []entity.WarehouseLocation{},
+	}
+
+	if err := s.warehouseRepo.Create(ctx, warehouse); err != nil {
+		s.logger.Error("erro ao criar depósito",
+			zap.String("industryId", industryID),
+			zap.String("code", code),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("depósito criado",
+		zap.String("warehouseId", warehouse.ID),
+		zap.String("code", warehouse.Code),
+	)
+
+	return warehouse, nil
+}
+
+func (s *warehouseService) GetByID(ctx context.Context, industryID, id string) (*entity.Warehouse, error) {
+	return s.findOwned(ctx, industryID, id)
+}
+
+func (s *warehouseService) List(ctx context.Context, industryID string, includeInactive bool) ([]entity.Warehouse, error) {
+	warehouses, err := s.warehouseRepo.List(ctx, industryID, includeInactive)
+	if err != nil {
+		s.logger.Error("erro ao listar depósitos",
+			zap.String("industryId", industryID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return warehouses, nil
+}
+
+func (s *warehouseService) Update(ctx context.Context, industryID, id string, input entity.UpdateWarehouseInput) (*entity.Warehouse, error) {
+	warehouse, err := s.findOwned(ctx, industryID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Code != nil {
+		code := strings.ToUpper(strings.TrimSpace(*input.Code))
+		if code == "" {
+			return nil, domainErrors.ValidationError("Código do depósito é obrigatório")
+		}
+		if code != warehouse.Code {
+			exists, err := s.warehouseRepo.ExistsByCode(ctx, industryID, code, id)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				return nil, domainErrors.NewConflictError("Código de depósito já cadastrado")
+			}
+		}
+		warehouse.Code = code
+	}
+	if input.Name != nil {
+		warehouse.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Address != nil {
+		warehouse.Address = input.Address
+	}
+	if input.City != nil {
+		warehouse.City = input.City
+	}
+	if input.State != nil {
+		warehouse.State = upperState(input.State)
+	}
+	if input.IsActive != nil {
+		warehouse.IsActive = *input.IsActive
+	}
+
+	if err := s.warehouseRepo.Update(ctx, warehouse); err != nil {
+		s.logger.Error("erro ao atualizar depósito",
+			zap.String("warehouseId", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("depósito atualizado", zap.String("warehouseId", id))
+
+	return warehouse, nil
+}
+
+func (s *warehouseService) Delete(ctx context.Context, industryID, id string) error {
+	if _, err := s.findOwned(ctx, industryID, id); err != nil {
+		return err
+	}
+
+	count, err := s.warehouseRepo.CountBatches(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return domainErrors.NewConflictError("Não foi possível excluir este depósito pois ele possui lotes alocados")
+	}
+
+	if err := s.warehouseRepo.Delete(ctx, id); err != nil {
+		s.logger.Error("erro ao excluir depósito",
+			zap.String("warehouseId", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	s.logger.Info("depósito excluído", zap.String("warehouseId", id))
+	return nil
+}
+
+func (s *warehouseService) CreateLocation(ctx context.Context, industryID, warehouseID string, input entity.CreateWarehouseLocationInput) (*entity.WarehouseLocation, error) {
+	if _, err := s.findOwned(ctx, industryID, warehouseID); err != nil {
+		return nil, err
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(input.Code))
+	if code == "" {
+		return nil, domainErrors.ValidationError("Código da posição é obrigatório")
+	}
+
+	exists, err := s.warehouseRepo.ExistsLocationCode(ctx, warehouseID, code, "")
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, domainErrors.NewConflictError("Código de posição já cadastrado neste depósito")
+	}
+
+	location := &entity.WarehouseLocation{
+		ID:          uuid.New().String(),
+		WarehouseID: warehouseID,
+		Code:        code,
+		Description: input.Description,
+		IsActive:    true,
+	}
+
+	if err := s.warehouseRepo.CreateLocation(ctx, location); err != nil {
+		s.logger.Error("erro ao criar posição",
+			zap.String("warehouseId", warehouseID),
+			zap.String("code", code),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("posição criada",
+		zap.String("warehouseId", warehouseID),
+		zap.String("locationId", location.ID),
+		zap.String("code", location.Code),
+	)
+
+	return location, nil
+}
+
+func (s *warehouseService) UpdateLocation(ctx context.Context, industryID, warehouseID, locationID string, input entity.UpdateWarehouseLocationInput) (*entity.WarehouseLocation, error) {
+	location, err := s.findOwnedLocation(ctx, industryID, warehouseID, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Code != nil {
+		code := strings.ToUpper(strings.TrimSpace(*input.Code))
+		if code == "" {
+			return nil, domainErrors.ValidationError("Código da posição é obrigatório")
+		}
+		if code != location.Code {
+			exists, err := s.warehouseRepo.ExistsLocationCode(ctx, warehouseID, code, locationID)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				return nil, domainErrors.NewConflictError("Código de posição já cadastrado neste depósito")
+			}
+		}
+		location.Code = code
+	}
+	if input.Description != nil {
+		location.Description = input.Description
+	}
+	if input.IsActive != nil {
+		location.IsActive = *input.IsActive
+	}
+
+	if err := s.warehouseRepo.UpdateLocation(ctx, location); err != nil {
+		s.logger.Error("erro ao atualizar posição",
+			zap.String("locationId", locationID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	s.logger.Info("posição atualizada", zap.String("locationId", locationID))
+
+	return location, nil
+}
+
+func (s *warehouseService) DeleteLocation(ctx context.Context, industryID, warehouseID, locationID string) error {
+	if _, err := s.findOwnedLocation(ctx, industryID, warehouseID, locationID); err != nil {
+		return err
+	}
+
+	count, err := s.warehouseRepo.CountLocationBatches(ctx, locationID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return domainErrors.NewConflictError("Não foi possível excluir esta posição pois ela possui lotes alocados")
+	}
+
+	if err := s.warehouseRepo.DeleteLocation(ctx, locationID); err != nil {
+		s.logger.Error("erro ao excluir posição",
+			zap.String("locationId", locationID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	s.logger.Info("posição excluída", zap.String("locationId", locationID))
+	return nil
+}
+
+// findOwned busca o depósito garantindo que pertence à indústria
+func (s *warehouseService) findOwned(ctx context.Context, industryID, id string) (*entity.Warehouse, error) {
+	warehouse, err := s.warehouseRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if warehouse.IndustryID != industryID {
+		return nil, domainErrors.ForbiddenError()
+	}
+	return warehouse, nil
+}
+
+// findOwnedLocation busca a posição garantindo que pertence ao depósito da indústria
+func (s *warehouseService) findOwnedLocation(ctx context.Context, industryID, warehouseID, locationID string) (*entity.WarehouseLocation, error) {
+	if _, err := s.findOwned(ctx, industryID, warehouseID); err != nil {
+		return nil, err
+	}
+
+	location, err := s.warehouseRepo.FindLocationByID(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+	if location.WarehouseID != warehouseID {
+		return nil, domainErrors.NewNotFoundError("Posição")
+	}
+	return location, nil
+}
+
+// upperState normaliza a UF para maiúsculas
+func upperState(state *string) *string {
+	if state == nil {
+		return nil
+	}
+	upper := strings.ToUpper(strings.TrimSpace(*state))
+	return &upper
+}
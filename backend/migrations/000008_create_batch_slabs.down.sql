@@ -0,0 +1,8 @@
+-- =============================================
+-- Migration: 000008_create_batch_slabs (DOWN)
+-- Description: Remove rastreamento de chapas individuais
+-- =============================================
+
+DROP TRIGGER IF EXISTS update_batch_slabs_updated_at ON batch_slabs;
+DROP TABLE IF EXISTS batch_slab_medias;
+DROP TABLE IF EXISTS batch_slabs;
@@ -0,0 +1,70 @@
+-- =============================================
+-- Migration: 000008_create_batch_slabs
+-- Description: Rastreamento de chapas individuais dentro de um lote
+-- =============================================
+
+-- =============================================
+-- TABELA: batch_slabs
+-- =============================================
+CREATE TABLE batch_slabs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    slab_number INTEGER NOT NULL CHECK (slab_number > 0),
+
+    -- Dimensões próprias da chapa
+    height DECIMAL(8,2) NOT NULL CHECK (height > 0),
+    width DECIMAL(8,2) NOT NULL CHECK (width > 0),
+    thickness DECIMAL(8,2) NOT NULL CHECK (thickness > 0),
+    area DECIMAL(10,4) GENERATED ALWAYS AS ((height * width) / 10000) STORED,
+
+    -- Estado da chapa
+    status batch_status_type NOT NULL DEFAULT 'DISPONIVEL',
+    defects TEXT,
+    reservation_id UUID REFERENCES reservations(id) ON DELETE SET NULL,
+    sale_id UUID REFERENCES sales_history(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT idx_batch_slabs_unique_number UNIQUE (batch_id, slab_number)
+);
+
+COMMENT ON TABLE batch_slabs IS 'Chapas individuais de um lote (numeradas sequencialmente)';
+COMMENT ON COLUMN batch_slabs.slab_number IS 'Número sequencial da chapa dentro do lote (1..N)';
+COMMENT ON COLUMN batch_slabs.height IS 'Altura real da chapa em centímetros';
+COMMENT ON COLUMN batch_slabs.width IS 'Largura real da chapa em centímetros';
+COMMENT ON COLUMN batch_slabs.thickness IS 'Espessura real da chapa em centímetros';
+COMMENT ON COLUMN batch_slabs.area IS 'Área da chapa em m² (calculada automaticamente)';
+COMMENT ON COLUMN batch_slabs.status IS 'Status da chapa: DISPONIVEL, RESERVADO, VENDIDO ou INATIVO';
+COMMENT ON COLUMN batch_slabs.defects IS 'Descrição de defeitos (trincas, manchas, etc)';
+COMMENT ON COLUMN batch_slabs.reservation_id IS 'Reserva que mantém a chapa (quando RESERVADO)';
+COMMENT ON COLUMN batch_slabs.sale_id IS 'Venda em que a chapa foi vendida (quando VENDIDO)';
+
+-- =============================================
+-- TABELA: batch_slab_medias
+-- =============================================
+CREATE TABLE batch_slab_medias (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    slab_id UUID NOT NULL REFERENCES batch_slabs(id) ON DELETE CASCADE,
+    url VARCHAR(500) NOT NULL,
+    display_order INTEGER DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE batch_slab_medias IS 'Mídias (fotos reais) de chapas individuais';
+
+-- =============================================
+-- ÍNDICES
+-- =============================================
+CREATE INDEX idx_batch_slabs_batch_status ON batch_slabs(batch_id, status, slab_number);
+CREATE INDEX idx_batch_slabs_reservation ON batch_slabs(reservation_id) WHERE reservation_id IS NOT NULL;
+CREATE INDEX idx_batch_slabs_sale ON batch_slabs(sale_id) WHERE sale_id IS NOT NULL;
+CREATE INDEX idx_batch_slab_medias_slab_id ON batch_slab_medias(slab_id, display_order);
+
+-- =============================================
+-- TRIGGERS
+-- =============================================
+CREATE TRIGGER update_batch_slabs_updated_at
+    BEFORE UPDATE ON batch_slabs
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
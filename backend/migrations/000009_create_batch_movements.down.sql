@@ -0,0 +1,8 @@
+-- =============================================
+-- Migration: 000009_create_batch_movements (DOWN)
+-- Description: Remove razão de movimentações de estoque
+-- =============================================
+
+DROP TRIGGER IF EXISTS prevent_batch_movements_changes ON batch_movements;
+DROP FUNCTION IF EXISTS prevent_batch_movements_changes();
+DROP TABLE IF EXISTS batch_movements;
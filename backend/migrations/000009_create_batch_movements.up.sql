@@ -0,0 +1,84 @@
+-- =============================================
+-- Migration: 000009_create_batch_movements
+-- Description: Razão (ledger) de movimentações de estoque dos lotes
+-- =============================================
+
+-- =============================================
+-- TABELA: batch_movements
+-- =============================================
+CREATE TABLE batch_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+
+    -- Origem/destino (NULL representa entrada ou saída do lote)
+    from_status batch_status_type,
+    to_status batch_status_type,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    slab_numbers INTEGER[],
+
+    reason VARCHAR(40) NOT NULL,
+    notes TEXT,
+
+    actor_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    reservation_id UUID REFERENCES reservations(id) ON DELETE SET NULL,
+    sale_id UUID REFERENCES sales_history(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_movement_direction CHECK (
+        (from_status IS NOT NULL OR to_status IS NOT NULL)
+        AND from_status IS DISTINCT FROM to_status
+    )
+);
+
+COMMENT ON TABLE batch_movements IS 'Razão imutável de movimentações de chapas entre status';
+COMMENT ON COLUMN batch_movements.from_status IS 'Status de origem (NULL = entrada no lote)';
+COMMENT ON COLUMN batch_movements.to_status IS 'Status de destino (NULL = saída do lote)';
+COMMENT ON COLUMN batch_movements.slab_numbers IS 'Números das chapas movimentadas (lotes com rastreamento por chapa)';
+COMMENT ON COLUMN batch_movements.reason IS 'Código do motivo da movimentação';
+COMMENT ON COLUMN batch_movements.actor_user_id IS 'Usuário responsável (NULL = sistema)';
+
+-- =============================================
+-- ÍNDICES
+-- =============================================
+CREATE INDEX idx_batch_movements_batch_created ON batch_movements(batch_id, created_at DESC);
+CREATE INDEX idx_batch_movements_reservation ON batch_movements(reservation_id) WHERE reservation_id IS NOT NULL;
+CREATE INDEX idx_batch_movements_sale ON batch_movements(sale_id) WHERE sale_id IS NOT NULL;
+
+-- =============================================
+-- APPEND-ONLY
+-- =============================================
+-- Bloqueia UPDATE/DELETE diretos; ações referenciais (CASCADE/SET NULL) são permitidas
+CREATE OR REPLACE FUNCTION prevent_batch_movements_changes()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF pg_trigger_depth() > 1 THEN
+        IF TG_OP = 'DELETE' THEN
+            RETURN OLD;
+        END IF;
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'batch_movements é somente inserção';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_batch_movements_changes
+    BEFORE UPDATE OR DELETE ON batch_movements
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_batch_movements_changes();
+
+-- =============================================
+-- SALDO INICIAL
+-- =============================================
+-- Lotes existentes recebem uma entrada por status para que o razão reconstrua os contadores
+INSERT INTO batch_movements (batch_id, from_status, to_status, quantity, reason, created_at)
+SELECT b.id, NULL, s.status, s.quantity, 'SALDO_INICIAL', b.created_at
+FROM batches b
+CROSS JOIN LATERAL (
+    VALUES
+        ('DISPONIVEL'::batch_status_type, b.available_slabs),
+        ('RESERVADO'::batch_status_type, b.reserved_slabs),
+        ('VENDIDO'::batch_status_type, b.sold_slabs),
+        ('INATIVO'::batch_status_type, b.inactive_slabs)
+) AS s(status, quantity)
+WHERE s.quantity > 0;
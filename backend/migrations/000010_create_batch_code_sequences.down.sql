@@ -0,0 +1,7 @@
+-- =============================================
+-- Migration: 000010_create_batch_code_sequences (DOWN)
+-- Description: Remove geração automática de códigos de lote
+-- =============================================
+
+DROP TABLE IF EXISTS batch_code_sequences;
+ALTER TABLE industries DROP COLUMN IF EXISTS batch_code_settings;
@@ -0,0 +1,29 @@
+-- =============================================
+-- Migration: 000010_create_batch_code_sequences
+-- Description: Geração automática de códigos de lote por indústria
+-- =============================================
+
+-- =============================================
+-- CONFIGURAÇÃO: industries.batch_code_settings
+-- =============================================
+ALTER TABLE industries
+    ADD COLUMN batch_code_settings JSONB NOT NULL DEFAULT '{"prefixSource": "MATERIAL"}'::jsonb;
+
+COMMENT ON COLUMN industries.batch_code_settings IS 'Configuração de códigos de lote automáticos: prefixSource (MATERIAL|PRODUTO|FIXO), fixedPrefix';
+
+-- =============================================
+-- TABELA: batch_code_sequences
+-- =============================================
+CREATE TABLE batch_code_sequences (
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    prefix CHAR(3) NOT NULL,
+    last_value INTEGER NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (industry_id, prefix),
+    CONSTRAINT check_batch_code_prefix CHECK (prefix ~ '^[A-Z]{3}$'),
+    CONSTRAINT check_batch_code_last_value CHECK (last_value BETWEEN 1 AND 999999)
+);
+
+COMMENT ON TABLE batch_code_sequences IS 'Contadores sem lacunas de códigos de lote (AAA-999999) por indústria e prefixo';
+COMMENT ON COLUMN batch_code_sequences.last_value IS 'Último número utilizado; incrementado na mesma transação que cria o lote';
@@ -0,0 +1,9 @@
+-- =============================================
+-- Migration: 000011_create_batch_lineage (DOWN)
+-- Description: Remove rastreio de divisão e unificação de lotes
+-- =============================================
+
+DROP TABLE IF EXISTS batch_lineage;
+
+ALTER TABLE batches DROP CONSTRAINT IF EXISTS batches_quantity_slabs_check;
+ALTER TABLE batches ADD CONSTRAINT batches_quantity_slabs_check CHECK (quantity_slabs > 0);
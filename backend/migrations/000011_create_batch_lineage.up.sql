@@ -0,0 +1,34 @@
+-- =============================================
+-- Migration: 000011_create_batch_lineage
+-- Description: Divisão e unificação de lotes com rastreio de origem (pai/filho)
+-- =============================================
+
+-- Lotes de origem de uma unificação podem ficar sem chapas (arquivados)
+ALTER TABLE batches DROP CONSTRAINT IF EXISTS batches_quantity_slabs_check;
+ALTER TABLE batches ADD CONSTRAINT batches_quantity_slabs_check CHECK (quantity_slabs >= 0);
+
+-- =============================================
+-- TABELA: batch_lineage
+-- =============================================
+CREATE TABLE batch_lineage (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    parent_batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    child_batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    operation VARCHAR(20) NOT NULL CHECK (operation IN ('DIVISAO', 'UNIFICACAO')),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    slab_numbers INTEGER[],
+    notes TEXT,
+    actor_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_lineage_distinct CHECK (parent_batch_id <> child_batch_id)
+);
+
+COMMENT ON TABLE batch_lineage IS 'Origem dos lotes gerados por divisão ou unificação';
+COMMENT ON COLUMN batch_lineage.parent_batch_id IS 'Lote de origem das chapas';
+COMMENT ON COLUMN batch_lineage.child_batch_id IS 'Lote que recebeu as chapas';
+COMMENT ON COLUMN batch_lineage.operation IS 'DIVISAO (novo lote a partir de chapas do pai) ou UNIFICACAO (chapas do pai incorporadas ao filho)';
+COMMENT ON COLUMN batch_lineage.slab_numbers IS 'Números das chapas no lote de origem (lotes com rastreamento por chapa)';
+
+CREATE INDEX idx_batch_lineage_parent ON batch_lineage(parent_batch_id);
+CREATE INDEX idx_batch_lineage_child ON batch_lineage(child_batch_id);
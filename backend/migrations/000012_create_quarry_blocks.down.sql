@@ -0,0 +1,7 @@
+-- =============================================
+-- Migration: 000012_create_quarry_blocks (DOWN)
+-- Description: Remove blocos de pedreira
+-- =============================================
+
+ALTER TABLE batches DROP COLUMN IF EXISTS block_id;
+DROP TABLE IF EXISTS quarry_blocks;
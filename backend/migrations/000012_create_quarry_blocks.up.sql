@@ -0,0 +1,56 @@
+-- =============================================
+-- Migration: 000012_create_quarry_blocks
+-- Description: Blocos de pedreira (matéria-prima) vinculados aos lotes serrados
+-- =============================================
+
+-- =============================================
+-- TABELA: quarry_blocks
+-- =============================================
+CREATE TABLE quarry_blocks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    block_code VARCHAR(50) NOT NULL,
+    quarry_name VARCHAR(255) NOT NULL,
+    supplier_name VARCHAR(255),
+    material_type VARCHAR(50),
+
+    -- Dimensões brutas (opcionais) e volume comprado
+    length DECIMAL(8,2) CHECK (length IS NULL OR length > 0),
+    width DECIMAL(8,2) CHECK (width IS NULL OR width > 0),
+    height DECIMAL(8,2) CHECK (height IS NULL OR height > 0),
+    volume_m3 DECIMAL(10,3) NOT NULL CHECK (volume_m3 > 0),
+
+    purchase_cost DECIMAL(14,2) NOT NULL DEFAULT 0 CHECK (purchase_cost >= 0),
+    arrival_date TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    notes TEXT,
+
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT idx_quarry_blocks_unique_code UNIQUE (industry_id, block_code)
+);
+
+COMMENT ON TABLE quarry_blocks IS 'Blocos brutos comprados das pedreiras e serrados em chapas';
+COMMENT ON COLUMN quarry_blocks.block_code IS 'Código do bloco (numeração da pedreira ou interna)';
+COMMENT ON COLUMN quarry_blocks.length IS 'Comprimento em centímetros';
+COMMENT ON COLUMN quarry_blocks.width IS 'Largura em centímetros';
+COMMENT ON COLUMN quarry_blocks.height IS 'Altura em centímetros';
+COMMENT ON COLUMN quarry_blocks.volume_m3 IS 'Volume comprado em m³ (base do cálculo de rendimento)';
+COMMENT ON COLUMN quarry_blocks.purchase_cost IS 'Custo de compra do bloco';
+
+CREATE INDEX idx_quarry_blocks_industry ON quarry_blocks(industry_id, arrival_date DESC);
+
+CREATE TRIGGER update_quarry_blocks_updated_at
+    BEFORE UPDATE ON quarry_blocks
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- VÍNCULO: batches.block_id
+-- =============================================
+ALTER TABLE batches ADD COLUMN block_id UUID REFERENCES quarry_blocks(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN batches.block_id IS 'Bloco de pedreira do qual as chapas foram serradas';
+
+CREATE INDEX idx_batches_block ON batches(block_id) WHERE block_id IS NOT NULL;
@@ -0,0 +1,10 @@
+-- =============================================
+-- Migration: 000013_create_warehouses (DOWN)
+-- Description: Remove depósitos, posições e transferências de lotes
+-- =============================================
+
+DROP TABLE IF EXISTS batch_transfers;
+ALTER TABLE batches DROP COLUMN IF EXISTS location_id;
+ALTER TABLE batches DROP COLUMN IF EXISTS warehouse_id;
+DROP TABLE IF EXISTS warehouse_locations;
+DROP TABLE IF EXISTS warehouses;
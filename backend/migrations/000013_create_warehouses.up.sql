@@ -0,0 +1,85 @@
+-- =============================================
+-- Migration: 000013_create_warehouses
+-- Description: Depósitos, posições (pátios/racks) e transferências de lotes entre posições
+-- =============================================
+
+-- =============================================
+-- TABELA: warehouses
+-- =============================================
+CREATE TABLE warehouses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    code VARCHAR(20) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    address TEXT,
+    city VARCHAR(100),
+    state VARCHAR(2),
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT idx_warehouses_unique_code UNIQUE (industry_id, code)
+);
+
+COMMENT ON TABLE warehouses IS 'Depósitos/unidades físicas onde os lotes ficam armazenados';
+COMMENT ON COLUMN warehouses.code IS 'Código curto do depósito (ex: DEP1)';
+
+-- =============================================
+-- TABELA: warehouse_locations
+-- =============================================
+CREATE TABLE warehouse_locations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL,
+    description VARCHAR(255),
+    is_active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT idx_warehouse_locations_unique_code UNIQUE (warehouse_id, code)
+);
+
+COMMENT ON TABLE warehouse_locations IS 'Posições dentro do depósito (pátio, rack, cavalete)';
+COMMENT ON COLUMN warehouse_locations.code IS 'Código da posição (ex: PATIO-A/RACK-03)';
+
+CREATE TRIGGER update_warehouses_updated_at
+    BEFORE UPDATE ON warehouses
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_warehouse_locations_updated_at
+    BEFORE UPDATE ON warehouse_locations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- VÍNCULO: batches.warehouse_id / batches.location_id
+-- =============================================
+ALTER TABLE batches
+    ADD COLUMN warehouse_id UUID REFERENCES warehouses(id) ON DELETE SET NULL,
+    ADD COLUMN location_id UUID REFERENCES warehouse_locations(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN batches.warehouse_id IS 'Depósito onde o lote está armazenado';
+COMMENT ON COLUMN batches.location_id IS 'Posição do lote dentro do depósito';
+
+CREATE INDEX idx_batches_warehouse ON batches(warehouse_id, location_id) WHERE warehouse_id IS NOT NULL;
+
+-- =============================================
+-- TABELA: batch_transfers
+-- =============================================
+CREATE TABLE batch_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    from_warehouse_id UUID REFERENCES warehouses(id) ON DELETE SET NULL,
+    from_location_id UUID REFERENCES warehouse_locations(id) ON DELETE SET NULL,
+    to_warehouse_id UUID REFERENCES warehouses(id) ON DELETE SET NULL,
+    to_location_id UUID REFERENCES warehouse_locations(id) ON DELETE SET NULL,
+    notes TEXT,
+    actor_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE batch_transfers IS 'Histórico de transferências de lotes entre depósitos/posições';
+COMMENT ON COLUMN batch_transfers.from_warehouse_id IS 'Depósito de origem (NULL = primeira alocação)';
+
+CREATE INDEX idx_batch_transfers_batch_created ON batch_transfers(batch_id, created_at DESC);
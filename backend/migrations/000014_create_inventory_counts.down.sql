@@ -0,0 +1,7 @@
+-- =============================================
+-- Migration: 000014_create_inventory_counts (DOWN)
+-- Description: Remove sessões de inventário físico
+-- =============================================
+
+DROP TABLE IF EXISTS inventory_count_items;
+DROP TABLE IF EXISTS inventory_counts;
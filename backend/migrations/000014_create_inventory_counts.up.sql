@@ -0,0 +1,58 @@
+-- =============================================
+-- Migration: 000014_create_inventory_counts
+-- Description: Sessões de inventário físico (contagem cíclica) com divergência e ajustes aprovados
+-- =============================================
+
+-- =============================================
+-- TABELA: inventory_counts
+-- =============================================
+CREATE TABLE inventory_counts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    scope VARCHAR(20) NOT NULL CHECK (scope IN ('PRODUTO', 'MATERIAL', 'TODOS')),
+    product_id UUID REFERENCES products(id) ON DELETE SET NULL,
+    material_type VARCHAR(100),
+    status VARCHAR(20) NOT NULL DEFAULT 'ABERTA' CHECK (status IN ('ABERTA', 'APROVADA', 'CANCELADA')),
+    notes TEXT,
+    adjustment_reason VARCHAR(30),
+    created_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    closed_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    closed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE inventory_counts IS 'Sessões de inventário físico: escopo congelado na abertura e ajustes aplicados na aprovação';
+COMMENT ON COLUMN inventory_counts.scope IS 'Escopo: PRODUTO (product_id), MATERIAL (material_type) ou TODOS os lotes';
+COMMENT ON COLUMN inventory_counts.adjustment_reason IS 'Motivo registrado no razão de estoque para os ajustes aprovados';
+
+CREATE INDEX idx_inventory_counts_industry_status ON inventory_counts(industry_id, status, created_at DESC);
+
+CREATE TRIGGER update_inventory_counts_updated_at
+    BEFORE UPDATE ON inventory_counts
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- TABELA: inventory_count_items
+-- =============================================
+CREATE TABLE inventory_count_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    count_id UUID NOT NULL REFERENCES inventory_counts(id) ON DELETE CASCADE,
+    batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    expected_slabs INTEGER NOT NULL CHECK (expected_slabs >= 0),
+    counted_slabs INTEGER CHECK (counted_slabs >= 0),
+    counted_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    counted_at TIMESTAMP WITH TIME ZONE,
+    notes TEXT,
+    adjusted_quantity INTEGER,
+    adjusted_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT idx_inventory_count_items_unique_batch UNIQUE (count_id, batch_id)
+);
+
+COMMENT ON TABLE inventory_count_items IS 'Lotes congelados na sessão de inventário com a quantidade contada';
+COMMENT ON COLUMN inventory_count_items.expected_slabs IS 'Chapas disponíveis no sistema na abertura da sessão';
+COMMENT ON COLUMN inventory_count_items.adjusted_quantity IS 'Divergência aplicada na aprovação (contado - disponível no momento do ajuste)';
+
+CREATE INDEX idx_inventory_count_items_batch ON inventory_count_items(batch_id);
@@ -0,0 +1,7 @@
+-- =============================================
+-- Migration: 000015_create_damage_reports (DOWN)
+-- Description: Remove relatórios de avaria
+-- =============================================
+
+DROP TABLE IF EXISTS damage_report_photos;
+DROP TABLE IF EXISTS damage_reports;
@@ -0,0 +1,50 @@
+-- =============================================
+-- Migration: 000015_create_damage_reports
+-- Description: Relatórios de avaria com fotos e aprovação antes de inativar chapas
+-- =============================================
+
+-- =============================================
+-- TABELA: damage_reports
+-- =============================================
+CREATE TABLE damage_reports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    cause VARCHAR(30) NOT NULL CHECK (cause IN ('QUEBRA_TRANSPORTE', 'TRINCA', 'MANCHA', 'OUTRO')),
+    description TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDENTE' CHECK (status IN ('PENDENTE', 'APROVADO', 'REJEITADO')),
+    reported_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    review_notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE damage_reports IS 'Relatórios de avaria: as chapas só passam a INATIVO após aprovação';
+COMMENT ON COLUMN damage_reports.cause IS 'Causa: QUEBRA_TRANSPORTE, TRINCA, MANCHA ou OUTRO';
+COMMENT ON COLUMN damage_reports.quantity IS 'Quantidade de chapas avariadas (movidas de DISPONIVEL para INATIVO na aprovação)';
+
+CREATE INDEX idx_damage_reports_industry_status ON damage_reports(industry_id, status, created_at DESC);
+CREATE INDEX idx_damage_reports_batch ON damage_reports(batch_id);
+
+CREATE TRIGGER update_damage_reports_updated_at
+    BEFORE UPDATE ON damage_reports
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- TABELA: damage_report_photos
+-- =============================================
+CREATE TABLE damage_report_photos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    report_id UUID NOT NULL REFERENCES damage_reports(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    display_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE damage_report_photos IS 'Fotos da avaria enviadas pelo serviço de storage';
+
+CREATE INDEX idx_damage_report_photos_report ON damage_report_photos(report_id, display_order);
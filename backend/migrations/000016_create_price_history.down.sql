@@ -0,0 +1,14 @@
+-- =============================================
+-- Migration: 000016_create_price_history (DOWN)
+-- Description: Remove histórico de preços e preço vigente de links e reservas
+-- =============================================
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS price_unit,
+    DROP COLUMN IF EXISTS industry_price;
+
+ALTER TABLE sales_links
+    DROP COLUMN IF EXISTS price_snapshot_unit,
+    DROP COLUMN IF EXISTS price_snapshot;
+
+DROP TABLE IF EXISTS price_history;
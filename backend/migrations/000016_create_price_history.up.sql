@@ -0,0 +1,76 @@
+-- =============================================
+-- Migration: 000016_create_price_history
+-- Description: Histórico de preços de produtos e lotes, alterações agendadas
+--              e preço vigente registrado em links de venda e reservas
+-- =============================================
+
+-- =============================================
+-- TABELA: price_history
+-- =============================================
+CREATE TABLE price_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    product_id UUID REFERENCES products(id) ON DELETE CASCADE,
+    batch_id UUID REFERENCES batches(id) ON DELETE CASCADE,
+    price DECIMAL(15,2) NOT NULL CHECK (price > 0),
+    price_unit VARCHAR(10) NOT NULL DEFAULT 'M2' CHECK (price_unit IN ('M2', 'FT2')),
+    previous_price DECIMAL(15,2),
+    previous_price_unit VARCHAR(10),
+    effective_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'APLICADO' CHECK (status IN ('AGENDADO', 'APLICADO', 'CANCELADO')),
+    applied_at TIMESTAMP WITH TIME ZONE,
+    notes TEXT,
+    created_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_price_history_target CHECK ((product_id IS NULL) <> (batch_id IS NULL))
+);
+
+COMMENT ON TABLE price_history IS 'Histórico de preços de produtos e lotes; alterações futuras ficam AGENDADO até o job aplicá-las';
+COMMENT ON COLUMN price_history.effective_from IS 'Início da vigência do preço';
+COMMENT ON COLUMN price_history.previous_price IS 'Preço substituído no momento da aplicação';
+
+CREATE INDEX idx_price_history_product ON price_history(product_id, effective_from DESC) WHERE product_id IS NOT NULL;
+CREATE INDEX idx_price_history_batch ON price_history(batch_id, effective_from DESC) WHERE batch_id IS NOT NULL;
+CREATE INDEX idx_price_history_due ON price_history(effective_from) WHERE status = 'AGENDADO';
+
+-- Preços atuais viram o primeiro registro do histórico
+INSERT INTO price_history (industry_id, product_id, price, price_unit, effective_from, status, applied_at, created_at)
+SELECT industry_id, id, base_price, COALESCE(price_unit, 'M2'), created_at, 'APLICADO', created_at, created_at
+FROM products
+WHERE base_price IS NOT NULL AND base_price > 0 AND deleted_at IS NULL;
+
+INSERT INTO price_history (industry_id, batch_id, price, price_unit, effective_from, status, applied_at, created_at)
+SELECT industry_id, id, industry_price, COALESCE(price_unit::text, 'M2'), created_at, 'APLICADO', created_at, created_at
+FROM batches
+WHERE deleted_at IS NULL;
+
+-- =============================================
+-- PREÇO VIGENTE EM LINKS DE VENDA E RESERVAS
+-- =============================================
+ALTER TABLE sales_links
+    ADD COLUMN price_snapshot DECIMAL(15,2),
+    ADD COLUMN price_snapshot_unit VARCHAR(10);
+
+COMMENT ON COLUMN sales_links.price_snapshot IS 'Preço do lote/produto vigente na criação do link';
+
+ALTER TABLE reservations
+    ADD COLUMN industry_price DECIMAL(12,2),
+    ADD COLUMN price_unit VARCHAR(10);
+
+COMMENT ON COLUMN reservations.industry_price IS 'Preço do lote vigente na criação da reserva';
+
+-- Registros existentes assumem o preço atual como vigente
+UPDATE reservations r
+SET industry_price = b.industry_price, price_unit = b.price_unit::text
+FROM batches b
+WHERE b.id = r.batch_id;
+
+UPDATE sales_links l
+SET price_snapshot = b.industry_price, price_snapshot_unit = b.price_unit::text
+FROM batches b
+WHERE b.id = l.batch_id AND l.link_type = 'LOTE_UNICO';
+
+UPDATE sales_links l
+SET price_snapshot = p.base_price, price_snapshot_unit = COALESCE(p.price_unit::text, 'M2')
+FROM products p
+WHERE p.id = l.product_id AND l.link_type = 'PRODUTO_GERAL' AND p.base_price IS NOT NULL;
@@ -0,0 +1,8 @@
+-- =============================================
+-- Migration: 000017_create_price_lists (DOWN)
+-- Description: Remove tabelas de preço
+-- =============================================
+
+DROP TABLE IF EXISTS price_list_assignments;
+DROP TABLE IF EXISTS price_list_rules;
+DROP TABLE IF EXISTS price_lists;
@@ -0,0 +1,69 @@
+-- =============================================
+-- Migration: 000017_create_price_lists
+-- Description: Tabelas de preço com regras por produto/material atribuídas a usuários e clientes
+-- =============================================
+
+-- =============================================
+-- TABELA: price_lists
+-- =============================================
+CREATE TABLE price_lists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_price_lists_industry_name UNIQUE (industry_id, name)
+);
+
+COMMENT ON TABLE price_lists IS 'Tabelas de preço nomeadas da indústria (ex: varejo, arquiteto, exportação)';
+
+CREATE INDEX idx_price_lists_industry ON price_lists(industry_id);
+
+CREATE TRIGGER update_price_lists_updated_at
+    BEFORE UPDATE ON price_lists
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- TABELA: price_list_rules
+-- =============================================
+CREATE TABLE price_list_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    price_list_id UUID NOT NULL REFERENCES price_lists(id) ON DELETE CASCADE,
+    product_id UUID REFERENCES products(id) ON DELETE CASCADE,
+    material_type VARCHAR(100),
+    rule_type VARCHAR(20) NOT NULL CHECK (rule_type IN ('PERCENTUAL', 'FIXO')),
+    value DECIMAL(12,2) NOT NULL,
+    price_unit VARCHAR(10) CHECK (price_unit IN ('M2', 'FT2')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_price_list_rules_target CHECK (product_id IS NULL OR material_type IS NULL),
+    CONSTRAINT chk_price_list_rules_value CHECK (
+        (rule_type = 'PERCENTUAL' AND value > -100) OR (rule_type = 'FIXO' AND value > 0 AND price_unit IS NOT NULL)
+    )
+);
+
+COMMENT ON TABLE price_list_rules IS 'Regras da tabela de preço: produto > material > regra geral (ambos nulos)';
+COMMENT ON COLUMN price_list_rules.rule_type IS 'PERCENTUAL ajusta o preço do lote em value%; FIXO substitui pelo value na price_unit';
+
+CREATE INDEX idx_price_list_rules_list ON price_list_rules(price_list_id);
+
+-- =============================================
+-- TABELA: price_list_assignments
+-- =============================================
+CREATE TABLE price_list_assignments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    price_list_id UUID NOT NULL REFERENCES price_lists(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    cliente_id UUID REFERENCES clientes(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_price_list_assignments_target CHECK ((user_id IS NULL) <> (cliente_id IS NULL))
+);
+
+COMMENT ON TABLE price_list_assignments IS 'Tabela de preço atribuída a um broker, vendedor ou cliente (uma por indústria)';
+
+CREATE UNIQUE INDEX uq_price_list_assignments_user ON price_list_assignments(industry_id, user_id) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX uq_price_list_assignments_cliente ON price_list_assignments(industry_id, cliente_id) WHERE cliente_id IS NOT NULL;
+CREATE INDEX idx_price_list_assignments_list ON price_list_assignments(price_list_id);
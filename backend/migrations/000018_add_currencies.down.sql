@@ -0,0 +1,16 @@
+-- =============================================
+-- Migration: 000018_add_currencies (DOWN)
+-- Description: Remove moedas e tabela de câmbio
+-- =============================================
+
+DROP FUNCTION IF EXISTS to_base_currency(UUID, NUMERIC, VARCHAR, DATE);
+DROP FUNCTION IF EXISTS currency_rate(UUID, VARCHAR, VARCHAR, DATE);
+DROP TABLE IF EXISTS exchange_rates;
+
+ALTER TABLE sales_history DROP COLUMN IF EXISTS currency;
+ALTER TABLE reservations DROP COLUMN IF EXISTS currency;
+ALTER TABLE catalog_links DROP COLUMN IF EXISTS show_price;
+ALTER TABLE sales_links DROP COLUMN IF EXISTS currency;
+ALTER TABLE batches DROP COLUMN IF EXISTS currency;
+ALTER TABLE products DROP COLUMN IF EXISTS currency;
+ALTER TABLE industries DROP COLUMN IF EXISTS base_currency;
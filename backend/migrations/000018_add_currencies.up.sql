@@ -0,0 +1,108 @@
+-- =============================================
+-- Migration: 000018_add_currencies
+-- Description: Moeda em preços e vendas, tabela de câmbio e moeda base da indústria
+-- =============================================
+
+-- =============================================
+-- MOEDA BASE DA INDÚSTRIA E MOEDA DOS PREÇOS
+-- =============================================
+ALTER TABLE industries
+    ADD COLUMN base_currency VARCHAR(3) NOT NULL DEFAULT 'BRL' CHECK (base_currency IN ('BRL', 'USD', 'EUR'));
+
+COMMENT ON COLUMN industries.base_currency IS 'Moeda base usada para normalizar métricas de BI';
+
+ALTER TABLE products
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'BRL' CHECK (currency IN ('BRL', 'USD', 'EUR'));
+
+COMMENT ON COLUMN products.currency IS 'Moeda do preço base';
+
+ALTER TABLE batches
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'BRL' CHECK (currency IN ('BRL', 'USD', 'EUR'));
+
+COMMENT ON COLUMN batches.currency IS 'Moeda do preço da indústria';
+
+ALTER TABLE sales_links
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'BRL' CHECK (currency IN ('BRL', 'USD', 'EUR'));
+
+COMMENT ON COLUMN sales_links.currency IS 'Moeda do preço de exibição, do preço vigente e dos itens';
+
+ALTER TABLE catalog_links
+    ADD COLUMN show_price BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN catalog_links.show_price IS 'Se exibe o preço dos lotes no catálogo público';
+
+ALTER TABLE reservations
+    ADD COLUMN currency VARCHAR(3) CHECK (currency IN ('BRL', 'USD', 'EUR'));
+
+COMMENT ON COLUMN reservations.currency IS 'Moeda do preço vigente na criação da reserva';
+
+UPDATE reservations SET currency = 'BRL' WHERE industry_price IS NOT NULL;
+
+ALTER TABLE sales_history
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'BRL' CHECK (currency IN ('BRL', 'USD', 'EUR'));
+
+COMMENT ON COLUMN sales_history.currency IS 'Moeda dos valores da venda';
+
+-- =============================================
+-- TABELA: exchange_rates
+-- =============================================
+CREATE TABLE exchange_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    from_currency VARCHAR(3) NOT NULL CHECK (from_currency IN ('BRL', 'USD', 'EUR')),
+    to_currency VARCHAR(3) NOT NULL CHECK (to_currency IN ('BRL', 'USD', 'EUR')),
+    rate DECIMAL(18,8) NOT NULL CHECK (rate > 0),
+    effective_date DATE NOT NULL,
+    source VARCHAR(20) NOT NULL DEFAULT 'MANUAL' CHECK (source IN ('MANUAL', 'ARQUIVO')),
+    created_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_exchange_rates_pair CHECK (from_currency <> to_currency),
+    CONSTRAINT uq_exchange_rates_pair_date UNIQUE (industry_id, from_currency, to_currency, effective_date)
+);
+
+COMMENT ON TABLE exchange_rates IS 'Cotações mantidas pela indústria: 1 from_currency = rate to_currency a partir de effective_date';
+
+CREATE INDEX idx_exchange_rates_lookup ON exchange_rates(industry_id, from_currency, to_currency, effective_date DESC);
+
+CREATE TRIGGER update_exchange_rates_updated_at
+    BEFORE UPDATE ON exchange_rates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- FUNÇÃO: currency_rate
+-- Cotação vigente na data (usa o par inverso quando necessário).
+-- Sem cotação até a data, usa a primeira posterior; NULL se o par não existir.
+-- =============================================
+CREATE OR REPLACE FUNCTION currency_rate(p_industry_id UUID, p_from VARCHAR, p_to VARCHAR, p_date DATE)
+RETURNS NUMERIC AS $$
+    SELECT CASE WHEN p_from = p_to THEN 1::NUMERIC ELSE (
+        SELECT r.rate
+        FROM (
+            SELECT rate, effective_date
+            FROM exchange_rates
+            WHERE industry_id = p_industry_id AND from_currency = p_from AND to_currency = p_to
+            UNION ALL
+            SELECT 1 / rate, effective_date
+            FROM exchange_rates
+            WHERE industry_id = p_industry_id AND from_currency = p_to AND to_currency = p_from
+        ) r
+        ORDER BY (r.effective_date <= p_date) DESC,
+                 CASE WHEN r.effective_date <= p_date THEN r.effective_date END DESC,
+                 r.effective_date ASC
+        LIMIT 1
+    ) END
+$$ LANGUAGE SQL STABLE;
+
+-- =============================================
+-- FUNÇÃO: to_base_currency
+-- Converte um valor para a moeda base da indústria (sem cotação, mantém o valor original)
+-- =============================================
+CREATE OR REPLACE FUNCTION to_base_currency(p_industry_id UUID, p_amount NUMERIC, p_currency VARCHAR, p_date DATE)
+RETURNS NUMERIC AS $$
+    SELECT p_amount * COALESCE(
+        currency_rate(p_industry_id, p_currency, (SELECT base_currency FROM industries WHERE id = p_industry_id), p_date),
+        1
+    )
+$$ LANGUAGE SQL STABLE;
@@ -0,0 +1,10 @@
+-- =============================================
+-- Migration: 000019_create_remnants (DOWN)
+-- Description: Remove retalhos
+-- =============================================
+
+ALTER TABLE sales_history DROP COLUMN IF EXISTS remnant_id;
+ALTER TABLE reservations DROP COLUMN IF EXISTS remnant_id;
+
+DROP TABLE IF EXISTS remnant_photos;
+DROP TABLE IF EXISTS remnants;
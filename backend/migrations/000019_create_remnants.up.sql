@@ -0,0 +1,77 @@
+-- =============================================
+-- Migration: 000019_create_remnants
+-- Description: Retalhos (sobras irregulares de corte) vinculados ao lote de origem
+-- =============================================
+
+-- =============================================
+-- TABELA: remnants
+-- =============================================
+CREATE TABLE remnants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    source_batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE RESTRICT,
+    sequence INTEGER NOT NULL CHECK (sequence > 0),
+    code VARCHAR(120) NOT NULL,
+    height DECIMAL(8,2) NOT NULL CHECK (height > 0),
+    width DECIMAL(8,2) NOT NULL CHECK (width > 0),
+    thickness DECIMAL(8,2) NOT NULL CHECK (thickness > 0),
+    area DECIMAL(10,4) NOT NULL CHECK (area > 0),
+    industry_price DECIMAL(12,2) NOT NULL CHECK (industry_price > 0),
+    price_unit price_unit_type NOT NULL DEFAULT 'M2',
+    currency VARCHAR(3) NOT NULL DEFAULT 'BRL' CHECK (currency IN ('BRL', 'USD', 'EUR')),
+    status batch_status_type NOT NULL DEFAULT 'DISPONIVEL',
+    notes TEXT,
+    created_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_remnants_batch_sequence UNIQUE (source_batch_id, sequence),
+    CONSTRAINT chk_remnants_area CHECK (area <= height * width / 10000)
+);
+
+COMMENT ON TABLE remnants IS 'Retalhos: peças irregulares que sobram do corte, vendidas separadamente do lote';
+COMMENT ON COLUMN remnants.code IS 'Código do lote de origem seguido do sequencial do retalho (ex: GRA-000123-R01)';
+COMMENT ON COLUMN remnants.height IS 'Maior comprimento da peça em cm';
+COMMENT ON COLUMN remnants.width IS 'Maior largura da peça em cm';
+COMMENT ON COLUMN remnants.area IS 'Área útil em m² (pode ser menor que altura × largura por ser irregular)';
+COMMENT ON COLUMN remnants.status IS 'DISPONIVEL, RESERVADO, VENDIDO ou INATIVO (reserva e venda pelo fluxo de reservas)';
+
+CREATE INDEX idx_remnants_industry_status ON remnants(industry_id, status, created_at DESC);
+CREATE INDEX idx_remnants_source_batch ON remnants(source_batch_id);
+CREATE INDEX idx_remnants_dimensions ON remnants(industry_id, height, width) WHERE status = 'DISPONIVEL';
+
+CREATE TRIGGER update_remnants_updated_at
+    BEFORE UPDATE ON remnants
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- TABELA: remnant_photos
+-- =============================================
+CREATE TABLE remnant_photos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    remnant_id UUID NOT NULL REFERENCES remnants(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    display_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE remnant_photos IS 'Fotos do retalho enviadas pelo serviço de storage';
+
+CREATE INDEX idx_remnant_photos_remnant ON remnant_photos(remnant_id, display_order);
+
+-- =============================================
+-- RESERVAS E VENDAS DE RETALHOS
+-- =============================================
+ALTER TABLE reservations
+    ADD COLUMN remnant_id UUID REFERENCES remnants(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN reservations.remnant_id IS 'Retalho reservado (batch_id aponta para o lote de origem)';
+
+CREATE INDEX idx_reservations_remnant ON reservations(remnant_id) WHERE remnant_id IS NOT NULL;
+
+ALTER TABLE sales_history
+    ADD COLUMN remnant_id UUID REFERENCES remnants(id) ON DELETE SET NULL;
+
+COMMENT ON COLUMN sales_history.remnant_id IS 'Retalho vendido (batch_id aponta para o lote de origem)';
+
+CREATE INDEX idx_sales_history_remnant ON sales_history(remnant_id) WHERE remnant_id IS NOT NULL;
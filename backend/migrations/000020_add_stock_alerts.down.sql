@@ -0,0 +1,9 @@
+-- =============================================
+-- Migration: 000020_add_stock_alerts (DOWN)
+-- Description: Remove limites e alertas de estoque
+-- =============================================
+
+DROP TABLE IF EXISTS stock_alerts;
+DROP VIEW IF EXISTS batch_stock_thresholds;
+
+ALTER TABLE industries DROP COLUMN IF EXISTS stock_alert_settings;
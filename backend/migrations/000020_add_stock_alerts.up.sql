@@ -0,0 +1,65 @@
+-- =============================================
+-- Migration: 000020_add_stock_alerts
+-- Description: Limites configuráveis de estoque baixo/parado e alertas deduplicados
+-- =============================================
+
+-- =============================================
+-- CONFIGURAÇÃO: industries.stock_alert_settings
+-- =============================================
+ALTER TABLE industries
+    ADD COLUMN stock_alert_settings JSONB NOT NULL DEFAULT '{"lowStockSlabs": 3, "staleDays": 90, "emailEnabled": true, "productOverrides": []}'::jsonb;
+
+COMMENT ON COLUMN industries.stock_alert_settings IS 'Limites de alerta: lowStockSlabs, staleDays, emailEnabled e productOverrides [{productId, lowStockSlabs, staleDays}]';
+
+-- =============================================
+-- VIEW: batch_stock_thresholds
+-- Limites efetivos por lote (produto > indústria > padrão)
+-- =============================================
+CREATE VIEW batch_stock_thresholds AS
+SELECT
+    b.id AS batch_id,
+    b.industry_id,
+    COALESCE(
+        (o.value->>'lowStockSlabs')::INTEGER,
+        (i.stock_alert_settings->>'lowStockSlabs')::INTEGER,
+        3
+    ) AS low_stock_slabs,
+    COALESCE(
+        (o.value->>'staleDays')::INTEGER,
+        (i.stock_alert_settings->>'staleDays')::INTEGER,
+        90
+    ) AS stale_days
+FROM batches b
+INNER JOIN industries i ON i.id = b.industry_id
+LEFT JOIN LATERAL (
+    SELECT value
+    FROM jsonb_array_elements(COALESCE(i.stock_alert_settings->'productOverrides', '[]'::jsonb))
+    WHERE value->>'productId' = b.product_id::TEXT
+    LIMIT 1
+) o ON TRUE;
+
+COMMENT ON VIEW batch_stock_thresholds IS 'Limites de estoque baixo (chapas) e parado (dias) efetivos de cada lote';
+
+-- =============================================
+-- TABELA: stock_alerts
+-- =============================================
+CREATE TABLE stock_alerts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    alert_type VARCHAR(20) NOT NULL CHECK (alert_type IN ('ESTOQUE_BAIXO', 'ESTOQUE_PARADO')),
+    threshold INTEGER NOT NULL,
+    current_value INTEGER NOT NULL,
+    triggered_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    notified_at TIMESTAMP WITH TIME ZONE,
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Um único alerta aberto por lote e tipo: o mesmo aviso não é reenviado até o lote sair da condição
+CREATE UNIQUE INDEX uq_stock_alerts_open ON stock_alerts(batch_id, alert_type) WHERE resolved_at IS NULL;
+CREATE INDEX idx_stock_alerts_industry ON stock_alerts(industry_id, triggered_at DESC);
+CREATE INDEX idx_stock_alerts_pending ON stock_alerts(industry_id) WHERE notified_at IS NULL AND resolved_at IS NULL;
+
+COMMENT ON TABLE stock_alerts IS 'Cruzamentos de limite de estoque baixo/parado; resolvidos quando o lote sai da condição';
+COMMENT ON COLUMN stock_alerts.current_value IS 'Chapas disponíveis (ESTOQUE_BAIXO) ou dias sem movimento (ESTOQUE_PARADO) no disparo';
+COMMENT ON COLUMN stock_alerts.notified_at IS 'Envio do email aos administradores; NULL = pendente';
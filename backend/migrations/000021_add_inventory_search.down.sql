@@ -0,0 +1,21 @@
+-- =============================================
+-- Migration: 000021_add_inventory_search (DOWN)
+-- Description: Remove busca textual de produtos e lotes
+-- =============================================
+
+DROP TRIGGER IF EXISTS products_refresh_batch_search ON products;
+DROP TRIGGER IF EXISTS batches_search_document ON batches;
+DROP TRIGGER IF EXISTS products_search_document ON products;
+
+DROP FUNCTION IF EXISTS products_refresh_batch_search();
+DROP FUNCTION IF EXISTS batches_search_document();
+DROP FUNCTION IF EXISTS products_search_document();
+
+ALTER TABLE batches DROP COLUMN IF EXISTS search_vector, DROP COLUMN IF EXISTS search_text;
+ALTER TABLE products DROP COLUMN IF EXISTS search_vector, DROP COLUMN IF EXISTS search_text;
+
+DROP FUNCTION IF EXISTS search_tsquery(TEXT);
+DROP FUNCTION IF EXISTS search_normalize(TEXT);
+DROP FUNCTION IF EXISTS immutable_unaccent(TEXT);
+
+DROP EXTENSION IF EXISTS "unaccent";
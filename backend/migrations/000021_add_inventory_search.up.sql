@@ -0,0 +1,133 @@
+-- =============================================
+-- Migration: 000021_add_inventory_search
+-- Description: Busca textual (full-text + trigram) sem acentos em produtos e lotes
+-- =============================================
+
+CREATE EXTENSION IF NOT EXISTS "unaccent";
+
+COMMENT ON EXTENSION unaccent IS 'Extensão para remover acentos na busca textual';
+
+-- =============================================
+-- FUNÇÕES DE BUSCA
+-- =============================================
+
+-- unaccent é STABLE; o wrapper com dicionário explícito permite uso em índices e triggers
+CREATE OR REPLACE FUNCTION immutable_unaccent(TEXT)
+RETURNS TEXT AS $$
+    SELECT public.unaccent('public.unaccent'::regdictionary, $1)
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+-- Normaliza texto para busca: minúsculas e sem acentos
+CREATE OR REPLACE FUNCTION search_normalize(TEXT)
+RETURNS TEXT AS $$
+    SELECT lower(immutable_unaccent(COALESCE($1, '')))
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+COMMENT ON FUNCTION search_normalize(TEXT) IS 'Texto em minúsculas e sem acentos, usado nos documentos e termos de busca';
+
+-- Converte o texto digitado em tsquery por prefixo (todas as palavras, ex.: "gra 0001" -> gra:* & 0001:*)
+CREATE OR REPLACE FUNCTION search_tsquery(TEXT)
+RETURNS TSQUERY AS $$
+    SELECT to_tsquery('simple', COALESCE(string_agg(term || ':*', ' & '), ''))
+    FROM regexp_split_to_table(btrim(regexp_replace(search_normalize($1), '[^[:alnum:]]+', ' ', 'g')), ' ') AS term
+    WHERE term <> ''
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+COMMENT ON FUNCTION search_tsquery(TEXT) IS 'tsquery por prefixo a partir do texto digitado pelo usuário';
+
+-- =============================================
+-- PRODUTOS: documento de busca
+-- Nome e SKU (A), material e acabamento (B), descrição (C)
+-- =============================================
+ALTER TABLE products
+    ADD COLUMN search_text TEXT,
+    ADD COLUMN search_vector TSVECTOR;
+
+COMMENT ON COLUMN products.search_text IS 'Nome, SKU, material, acabamento e descrição normalizados (trigram)';
+COMMENT ON COLUMN products.search_vector IS 'Documento full-text ponderado do produto';
+
+CREATE OR REPLACE FUNCTION products_search_document()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.search_text := search_normalize(concat_ws(' ', NEW.name, NEW.sku_code, NEW.material_type, NEW.finish_type::TEXT, NEW.description));
+    NEW.search_vector :=
+        setweight(to_tsvector('simple', search_normalize(concat_ws(' ', NEW.name, NEW.sku_code))), 'A') ||
+        setweight(to_tsvector('simple', search_normalize(concat_ws(' ', NEW.material_type, NEW.finish_type::TEXT))), 'B') ||
+        setweight(to_tsvector('simple', search_normalize(NEW.description)), 'C');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER products_search_document
+    BEFORE INSERT OR UPDATE OF name, sku_code, material_type, finish_type, description ON products
+    FOR EACH ROW
+    EXECUTE FUNCTION products_search_document();
+
+-- =============================================
+-- LOTES: documento de busca
+-- Código (A), produto (B), pedreira e material/acabamento (C), descrição do produto (D)
+-- =============================================
+ALTER TABLE batches
+    ADD COLUMN search_text TEXT,
+    ADD COLUMN search_vector TSVECTOR;
+
+COMMENT ON COLUMN batches.search_text IS 'Código, pedreira e dados do produto normalizados (trigram)';
+COMMENT ON COLUMN batches.search_vector IS 'Documento full-text ponderado do lote, incluindo o produto';
+
+CREATE OR REPLACE FUNCTION batches_search_document()
+RETURNS TRIGGER AS $$
+DECLARE
+    p products%ROWTYPE;
+BEGIN
+    SELECT * INTO p FROM products WHERE id = NEW.product_id;
+
+    NEW.search_text := search_normalize(concat_ws(' ',
+        NEW.batch_code, NEW.origin_quarry, p.name, p.sku_code, p.material_type, p.finish_type::TEXT, p.description));
+    NEW.search_vector :=
+        setweight(to_tsvector('simple', search_normalize(NEW.batch_code)), 'A') ||
+        setweight(to_tsvector('simple', search_normalize(concat_ws(' ', p.name, p.sku_code))), 'B') ||
+        setweight(to_tsvector('simple', search_normalize(concat_ws(' ', NEW.origin_quarry, p.material_type, p.finish_type::TEXT))), 'C') ||
+        setweight(to_tsvector('simple', search_normalize(p.description)), 'D');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER batches_search_document
+    BEFORE INSERT OR UPDATE OF batch_code, origin_quarry, product_id ON batches
+    FOR EACH ROW
+    EXECUTE FUNCTION batches_search_document();
+
+-- Alterações no produto refletem nos documentos dos lotes
+CREATE OR REPLACE FUNCTION products_refresh_batch_search()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE batches SET product_id = product_id WHERE product_id = NEW.id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER products_refresh_batch_search
+    AFTER UPDATE OF name, sku_code, material_type, finish_type, description ON products
+    FOR EACH ROW
+    WHEN (OLD.search_text IS DISTINCT FROM NEW.search_text)
+    EXECUTE FUNCTION products_refresh_batch_search();
+
+-- =============================================
+-- BACKFILL (sem alterar updated_at dos registros existentes)
+-- =============================================
+ALTER TABLE products DISABLE TRIGGER update_products_updated_at;
+ALTER TABLE batches DISABLE TRIGGER update_batches_updated_at;
+
+UPDATE products SET name = name;
+UPDATE batches SET batch_code = batch_code;
+
+ALTER TABLE products ENABLE TRIGGER update_products_updated_at;
+ALTER TABLE batches ENABLE TRIGGER update_batches_updated_at;
+
+-- =============================================
+-- ÍNDICES
+-- =============================================
+CREATE INDEX idx_products_search_vector ON products USING gin(search_vector);
+CREATE INDEX idx_products_search_trgm ON products USING gin(search_text gin_trgm_ops);
+CREATE INDEX idx_batches_search_vector ON batches USING gin(search_vector);
+CREATE INDEX idx_batches_search_trgm ON batches USING gin(search_text gin_trgm_ops);
@@ -0,0 +1,6 @@
+-- =============================================
+-- Migration: 000022_add_packing_lists (DOWN)
+-- Description: Remove romaneios de vendas
+-- =============================================
+
+DROP TABLE IF EXISTS packing_lists;
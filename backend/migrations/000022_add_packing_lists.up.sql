@@ -0,0 +1,30 @@
+-- =============================================
+-- Migration: 000022_add_packing_lists
+-- Description: Romaneios gerados para as vendas (PDF e JSON no storage)
+-- =============================================
+
+CREATE TABLE packing_lists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sale_id UUID NOT NULL UNIQUE REFERENCES sales_history(id) ON DELETE CASCADE,
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    number VARCHAR(20) NOT NULL,
+    document JSONB NOT NULL,
+    pdf_url TEXT NOT NULL,
+    json_url TEXT NOT NULL,
+    generated_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    generated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE packing_lists IS 'Romaneio da venda: um por venda, regerado sob demanda (ex.: troca de transportadora)';
+COMMENT ON COLUMN packing_lists.document IS 'Conteúdo estruturado do romaneio (itens, chapas, dimensões, área, peso estimado, cliente e transportadora)';
+COMMENT ON COLUMN packing_lists.pdf_url IS 'PDF do romaneio no storage';
+COMMENT ON COLUMN packing_lists.json_url IS 'JSON do romaneio no storage';
+
+CREATE INDEX idx_packing_lists_industry ON packing_lists(industry_id, generated_at DESC);
+
+CREATE TRIGGER update_packing_lists_updated_at
+    BEFORE UPDATE ON packing_lists
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
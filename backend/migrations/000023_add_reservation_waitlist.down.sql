@@ -0,0 +1,6 @@
+-- =============================================
+-- Migration: 000023_add_reservation_waitlist (DOWN)
+-- Description: Remove a fila de espera de reservas
+-- =============================================
+
+DROP TABLE IF EXISTS reservation_waitlist;
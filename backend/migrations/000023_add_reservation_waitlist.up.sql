@@ -0,0 +1,36 @@
+-- =============================================
+-- Migration: 000023_add_reservation_waitlist
+-- Description: Fila de espera (FIFO) por lote para reservas sem chapas disponíveis
+-- =============================================
+
+CREATE TABLE reservation_waitlist (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    batch_id UUID NOT NULL REFERENCES batches(id) ON DELETE CASCADE,
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    requested_by_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    cliente_id UUID REFERENCES clientes(id) ON DELETE SET NULL,
+    quantity_slabs INTEGER NOT NULL CHECK (quantity_slabs > 0),
+    reserved_price DECIMAL(14,2),
+    broker_sold_price DECIMAL(14,2),
+    notes TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'AGUARDANDO' CHECK (status IN ('AGUARDANDO', 'CONVERTIDA', 'CANCELADA')),
+    reservation_id UUID REFERENCES reservations(id) ON DELETE SET NULL,
+    converted_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE reservation_waitlist IS 'Pedidos de reserva aguardando chapas de um lote, atendidos por ordem de chegada';
+COMMENT ON COLUMN reservation_waitlist.status IS 'AGUARDANDO, CONVERTIDA (virou reserva pendente de aprovação) ou CANCELADA';
+COMMENT ON COLUMN reservation_waitlist.reservation_id IS 'Reserva criada quando o pedido foi atendido';
+
+-- Um pedido aguardando por usuário e lote
+CREATE UNIQUE INDEX uq_reservation_waitlist_waiting ON reservation_waitlist(batch_id, requested_by_user_id) WHERE status = 'AGUARDANDO';
+CREATE INDEX idx_reservation_waitlist_queue ON reservation_waitlist(batch_id, created_at) WHERE status = 'AGUARDANDO';
+CREATE INDEX idx_reservation_waitlist_industry ON reservation_waitlist(industry_id, created_at DESC);
+CREATE INDEX idx_reservation_waitlist_user ON reservation_waitlist(requested_by_user_id, created_at DESC);
+
+CREATE TRIGGER update_reservation_waitlist_updated_at
+    BEFORE UPDATE ON reservation_waitlist
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,8 @@
+-- =============================================
+-- Migration: 000024_add_reservation_extensions (DOWN)
+-- Description: Remove prorrogações de reservas e política de reservas
+-- =============================================
+
+DROP TABLE IF EXISTS reservation_extensions;
+
+ALTER TABLE industries DROP COLUMN IF EXISTS reservation_policy;
@@ -0,0 +1,49 @@
+-- =============================================
+-- Migration: 000024_add_reservation_extensions
+-- Description: Pedidos de prorrogação de reservas e política de reservas por indústria
+-- =============================================
+
+-- =============================================
+-- CONFIGURAÇÃO: industries.reservation_policy
+-- =============================================
+ALTER TABLE industries
+    ADD COLUMN reservation_policy JSONB NOT NULL DEFAULT '{"maxExtensions": 2, "maxExtensionDays": 7}'::jsonb;
+
+COMMENT ON COLUMN industries.reservation_policy IS 'Política de reservas: maxExtensions (prorrogações aprovadas por reserva) e maxExtensionDays (dias por prorrogação)';
+
+-- =============================================
+-- TABELA: reservation_extensions
+-- =============================================
+CREATE TABLE reservation_extensions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reservation_id UUID NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    industry_id UUID NOT NULL REFERENCES industries(id) ON DELETE CASCADE,
+    requested_by_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    justification TEXT NOT NULL,
+    old_expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    requested_expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    new_expires_at TIMESTAMP WITH TIME ZONE,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDENTE' CHECK (status IN ('PENDENTE', 'APROVADA', 'NEGADA')),
+    decided_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMP WITH TIME ZONE,
+    decision_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK (requested_expires_at > old_expires_at)
+);
+
+COMMENT ON TABLE reservation_extensions IS 'Histórico de pedidos de prorrogação do prazo das reservas';
+COMMENT ON COLUMN reservation_extensions.old_expires_at IS 'Prazo da reserva antes da prorrogação (atualizado na decisão)';
+COMMENT ON COLUMN reservation_extensions.new_expires_at IS 'Prazo aplicado à reserva quando aprovada';
+COMMENT ON COLUMN reservation_extensions.decision_reason IS 'Motivo informado pelo admin ao negar';
+
+-- Um pedido pendente por reserva
+CREATE UNIQUE INDEX uq_reservation_extensions_pending ON reservation_extensions(reservation_id) WHERE status = 'PENDENTE';
+CREATE INDEX idx_reservation_extensions_reservation ON reservation_extensions(reservation_id, created_at);
+CREATE INDEX idx_reservation_extensions_industry ON reservation_extensions(industry_id, status, created_at DESC);
+CREATE INDEX idx_reservation_extensions_user ON reservation_extensions(requested_by_user_id, created_at DESC);
+
+CREATE TRIGGER update_reservation_extensions_updated_at
+    BEFORE UPDATE ON reservation_extensions
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
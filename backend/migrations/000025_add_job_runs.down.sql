@@ -0,0 +1,6 @@
+-- =============================================
+-- Migration: 000025_add_job_runs (DOWN)
+-- Description: Remove o histórico de execuções dos jobs
+-- =============================================
+
+DROP TABLE IF EXISTS job_runs;
@@ -0,0 +1,23 @@
+-- =============================================
+-- Migration: 000025_add_job_runs
+-- Description: Histórico de execuções dos jobs agendados
+-- =============================================
+
+CREATE TABLE job_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_name VARCHAR(100) NOT NULL,
+    trigger VARCHAR(20) NOT NULL CHECK (trigger IN ('AGENDADO', 'MANUAL')),
+    status VARCHAR(20) NOT NULL DEFAULT 'EXECUTANDO' CHECK (status IN ('EXECUTANDO', 'SUCESSO', 'FALHA')),
+    triggered_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    instance VARCHAR(255) NOT NULL,
+    affected INTEGER,
+    error TEXT,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON TABLE job_runs IS 'Execuções dos jobs agendados (cada job roda em uma única réplica, via advisory lock)';
+COMMENT ON COLUMN job_runs.instance IS 'Réplica da API que executou o job (host-pid)';
+COMMENT ON COLUMN job_runs.affected IS 'Quantidade de registros processados pelo job';
+
+CREATE INDEX idx_job_runs_job ON job_runs(job_name, started_at DESC);
@@ -0,0 +1,15 @@
+-- =============================================
+-- Migration: 000026_extend_reservation_policy (DOWN)
+-- Description: Volta a política de reservas apenas com as prorrogações
+-- =============================================
+
+UPDATE industries
+SET reservation_policy = jsonb_build_object(
+    'maxExtensions', COALESCE(reservation_policy->'maxExtensions', '2'::jsonb),
+    'maxExtensionDays', COALESCE(reservation_policy->'maxExtensionDays', '7'::jsonb)
+);
+
+ALTER TABLE industries
+    ALTER COLUMN reservation_policy SET DEFAULT '{"maxExtensions": 2, "maxExtensionDays": 7}'::jsonb;
+
+COMMENT ON COLUMN industries.reservation_policy IS 'Política de reservas: maxExtensions (prorrogações aprovadas por reserva) e maxExtensionDays (dias por prorrogação)';
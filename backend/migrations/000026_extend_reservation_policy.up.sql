@@ -0,0 +1,14 @@
+-- =============================================
+-- Migration: 000026_extend_reservation_policy
+-- Description: Prazos, limites por broker e regras de aprovação automática na política de reservas
+-- =============================================
+
+ALTER TABLE industries
+    ALTER COLUMN reservation_policy SET DEFAULT '{"defaultExpiryDays": 7, "maxExpiryDays": 0, "maxActivePerBroker": 0, "maxSlabsPerBroker": 0, "requireBrokerApproval": false, "autoApprove": {"trustedBrokerIds": [], "slabsBelow": 0, "priceAtOrAboveBatch": false}, "maxExtensions": 2, "maxExtensionDays": 7}'::jsonb;
+
+-- Mantém as configurações de prorrogação já salvas
+UPDATE industries
+SET reservation_policy = '{"defaultExpiryDays": 7, "maxExpiryDays": 0, "maxActivePerBroker": 0, "maxSlabsPerBroker": 0, "requireBrokerApproval": false, "autoApprove": {"trustedBrokerIds": [], "slabsBelow": 0, "priceAtOrAboveBatch": false}}'::jsonb || reservation_policy;
+
+COMMENT ON COLUMN industries.reservation_policy IS 'Política de reservas: defaultExpiryDays, maxExpiryDays, maxActivePerBroker, maxSlabsPerBroker (0 = sem limite), requireBrokerApproval, autoApprove {trustedBrokerIds, slabsBelow, priceAtOrAboveBatch}, maxExtensions e maxExtensionDays';
+
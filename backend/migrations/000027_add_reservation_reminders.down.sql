@@ -0,0 +1,14 @@
+-- =============================================
+-- Migration: 000027_add_reservation_reminders (DOWN)
+-- Description: Remove os lembretes de vencimento das reservas
+-- =============================================
+
+UPDATE industries
+SET reservation_policy = reservation_policy - 'reminderHoursBefore';
+
+ALTER TABLE industries
+    ALTER COLUMN reservation_policy SET DEFAULT '{"defaultExpiryDays": 7, "maxExpiryDays": 0, "maxActivePerBroker": 0, "maxSlabsPerBroker": 0, "requireBrokerApproval": false, "autoApprove": {"trustedBrokerIds": [], "slabsBelow": 0, "priceAtOrAboveBatch": false}, "maxExtensions": 2, "maxExtensionDays": 7}'::jsonb;
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS approval_reminder_sent_at,
+    DROP COLUMN IF EXISTS expiry_reminder_sent_at;
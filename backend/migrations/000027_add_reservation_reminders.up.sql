@@ -0,0 +1,19 @@
+-- =============================================
+-- Migration: 000027_add_reservation_reminders
+-- Description: Lembretes por email antes do vencimento das reservas
+-- =============================================
+
+ALTER TABLE reservations
+    ADD COLUMN expiry_reminder_sent_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN approval_reminder_sent_at TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN reservations.expiry_reminder_sent_at IS 'Lembrete de vencimento do prazo enviado (limpo quando o prazo muda)';
+COMMENT ON COLUMN reservations.approval_reminder_sent_at IS 'Lembrete de vencimento do prazo de aprovação enviado';
+
+ALTER TABLE industries
+    ALTER COLUMN reservation_policy SET DEFAULT '{"defaultExpiryDays": 7, "maxExpiryDays": 0, "maxActivePerBroker": 0, "maxSlabsPerBroker": 0, "requireBrokerApproval": false, "autoApprove": {"trustedBrokerIds": [], "slabsBelow": 0, "priceAtOrAboveBatch": false}, "maxExtensions": 2, "maxExtensionDays": 7, "reminderHoursBefore": 24}'::jsonb;
+
+UPDATE industries
+SET reservation_policy = '{"reminderHoursBefore": 24}'::jsonb || reservation_policy;
+
+COMMENT ON COLUMN industries.reservation_policy IS 'Política de reservas: defaultExpiryDays, maxExpiryDays, maxActivePerBroker, maxSlabsPerBroker (0 = sem limite), requireBrokerApproval, autoApprove {trustedBrokerIds, slabsBelow, priceAtOrAboveBatch}, maxExtensions, maxExtensionDays e reminderHoursBefore (0 = sem lembrete)';
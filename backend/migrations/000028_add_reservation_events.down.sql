@@ -0,0 +1,6 @@
+-- =============================================
+-- Migration: 000028_add_reservation_events (DOWN)
+-- Description: Remove o histórico de eventos das reservas
+-- =============================================
+
+DROP TABLE IF EXISTS reservation_events;
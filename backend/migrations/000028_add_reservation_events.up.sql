@@ -0,0 +1,66 @@
+-- =============================================
+-- Migration: 000028_add_reservation_events
+-- Description: Histórico de eventos das reservas (linha do tempo)
+-- =============================================
+
+-- =============================================
+-- TABELA: reservation_events
+-- =============================================
+CREATE TABLE reservation_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reservation_id UUID NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    event_type VARCHAR(30) NOT NULL,
+    from_status reservation_status_type,
+    to_status reservation_status_type,
+    actor_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    old_price DECIMAL(14,2),
+    new_price DECIMAL(14,2),
+    old_expires_at TIMESTAMP WITH TIME ZONE,
+    new_expires_at TIMESTAMP WITH TIME ZONE,
+    sale_id UUID REFERENCES sales_history(id) ON DELETE SET NULL,
+    notes TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_reservation_events_type CHECK (event_type IN (
+        'CRIADA', 'APROVADA', 'REJEITADA', 'CANCELADA', 'EXPIRADA', 'VENDIDA',
+        'PRORROGACAO_SOLICITADA', 'PRORROGADA', 'PRORROGACAO_NEGADA'
+    ))
+);
+
+COMMENT ON TABLE reservation_events IS 'Linha do tempo das reservas: cada transição registrada pelo serviço de reservas';
+COMMENT ON COLUMN reservation_events.actor_user_id IS 'Usuário que executou a ação (NULL = sistema, ex: expiração automática)';
+COMMENT ON COLUMN reservation_events.old_price IS 'Preço por m² proposto antes do evento';
+COMMENT ON COLUMN reservation_events.new_price IS 'Preço por m² proposto após o evento';
+COMMENT ON COLUMN reservation_events.sale_id IS 'Venda gerada (evento VENDIDA)';
+
+CREATE INDEX idx_reservation_events_reservation ON reservation_events(reservation_id, created_at);
+
+-- =============================================
+-- BACKFILL: eventos reconstruídos das reservas existentes
+-- =============================================
+INSERT INTO reservation_events (reservation_id, event_type, to_status, actor_user_id, new_price, new_expires_at, created_at)
+SELECT id, 'CRIADA',
+       CASE WHEN approval_expires_at IS NOT NULL THEN 'PENDENTE_APROVACAO'::reservation_status_type ELSE 'ATIVA'::reservation_status_type END,
+       reserved_by_user_id, reserved_price,
+       CASE WHEN approval_expires_at IS NULL THEN expires_at END,
+       created_at
+FROM reservations;
+
+INSERT INTO reservation_events (reservation_id, event_type, from_status, to_status, actor_user_id, notes, created_at)
+SELECT id,
+       CASE WHEN status = 'REJEITADA' THEN 'REJEITADA' ELSE 'APROVADA' END,
+       'PENDENTE_APROVACAO',
+       CASE WHEN status = 'REJEITADA' THEN 'REJEITADA'::reservation_status_type ELSE 'APROVADA'::reservation_status_type END,
+       approved_by, rejection_reason, approved_at
+FROM reservations
+WHERE approved_at IS NOT NULL;
+
+INSERT INTO reservation_events (reservation_id, event_type, actor_user_id, old_expires_at, new_expires_at, notes, created_at)
+SELECT reservation_id, 'PRORROGACAO_SOLICITADA', requested_by_user_id, old_expires_at, requested_expires_at, justification, created_at
+FROM reservation_extensions;
+
+INSERT INTO reservation_events (reservation_id, event_type, actor_user_id, old_expires_at, new_expires_at, notes, created_at)
+SELECT reservation_id,
+       CASE WHEN status = 'APROVADA' THEN 'PRORROGADA' ELSE 'PRORROGACAO_NEGADA' END,
+       decided_by_user_id, old_expires_at, new_expires_at, decision_reason, decided_at
+FROM reservation_extensions
+WHERE status IN ('APROVADA', 'NEGADA') AND decided_at IS NOT NULL;
@@ -0,0 +1,17 @@
+-- =============================================
+-- Migration: 000029_add_reservation_offers (DOWN)
+-- Description: Remove a negociação de preço das reservas
+-- =============================================
+
+DELETE FROM reservation_events WHERE event_type IN ('PROPOSTA_PRECO', 'PROPOSTA_ACEITA');
+
+ALTER TABLE reservation_events DROP CONSTRAINT chk_reservation_events_type;
+ALTER TABLE reservation_events ADD CONSTRAINT chk_reservation_events_type CHECK (event_type IN (
+    'CRIADA', 'APROVADA', 'REJEITADA', 'CANCELADA', 'EXPIRADA', 'VENDIDA',
+    'PRORROGACAO_SOLICITADA', 'PRORROGADA', 'PRORROGACAO_NEGADA'
+));
+
+DROP TABLE IF EXISTS reservation_offers;
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS agreed_price;
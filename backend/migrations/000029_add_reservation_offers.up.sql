@@ -0,0 +1,44 @@
+-- =============================================
+-- Migration: 000029_add_reservation_offers
+-- Description: Negociação de preço das reservas pendentes (propostas e contrapropostas)
+-- =============================================
+
+ALTER TABLE reservations
+    ADD COLUMN agreed_price DECIMAL(14,2);
+
+COMMENT ON COLUMN reservations.agreed_price IS 'Preço por m² acordado entre indústria e quem reservou (usado na confirmação da venda)';
+
+-- =============================================
+-- TABELA: reservation_offers
+-- =============================================
+CREATE TABLE reservation_offers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reservation_id UUID NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    offered_by_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    side VARCHAR(20) NOT NULL CHECK (side IN ('INDUSTRIA', 'BROKER')),
+    price DECIMAL(14,2) NOT NULL CHECK (price > 0),
+    notes TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDENTE' CHECK (status IN ('PENDENTE', 'ACEITA', 'SUBSTITUIDA', 'ENCERRADA')),
+    responded_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE reservation_offers IS 'Propostas de preço trocadas entre indústria e quem reservou enquanto a reserva aguarda aprovação';
+COMMENT ON COLUMN reservation_offers.side IS 'Lado que fez a proposta: INDUSTRIA (admin) ou BROKER (quem reservou)';
+COMMENT ON COLUMN reservation_offers.price IS 'Preço por m² proposto';
+COMMENT ON COLUMN reservation_offers.status IS 'PENDENTE (aguarda o outro lado), ACEITA, SUBSTITUIDA (nova proposta) ou ENCERRADA (reserva rejeitada/expirada)';
+
+-- Uma proposta aguardando resposta por reserva
+CREATE UNIQUE INDEX uq_reservation_offers_pending ON reservation_offers(reservation_id) WHERE status = 'PENDENTE';
+CREATE INDEX idx_reservation_offers_reservation ON reservation_offers(reservation_id, created_at);
+
+-- =============================================
+-- Eventos da negociação na linha do tempo
+-- =============================================
+ALTER TABLE reservation_events DROP CONSTRAINT chk_reservation_events_type;
+ALTER TABLE reservation_events ADD CONSTRAINT chk_reservation_events_type CHECK (event_type IN (
+    'CRIADA', 'APROVADA', 'REJEITADA', 'CANCELADA', 'EXPIRADA', 'VENDIDA',
+    'PRORROGACAO_SOLICITADA', 'PRORROGADA', 'PRORROGACAO_NEGADA',
+    'PROPOSTA_PRECO', 'PROPOSTA_ACEITA'
+));
@@ -0,0 +1,9 @@
+-- =============================================
+-- Migration: 000030_add_inventory_count_snapshot (DOWN)
+-- Description: Remove o instantâneo do sistema na contagem de inventário
+-- =============================================
+
+COMMENT ON COLUMN inventory_count_items.adjusted_quantity IS 'Divergência aplicada na aprovação (contado - disponível no momento do ajuste)';
+
+ALTER TABLE inventory_count_items
+    DROP COLUMN IF EXISTS counted_system_slabs;
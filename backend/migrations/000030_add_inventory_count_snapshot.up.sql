@@ -0,0 +1,10 @@
+-- =============================================
+-- Migration: 000030_add_inventory_count_snapshot
+-- Description: Guarda as chapas disponíveis no momento da contagem para calcular a divergência
+-- =============================================
+
+ALTER TABLE inventory_count_items
+    ADD COLUMN counted_system_slabs INTEGER CHECK (counted_system_slabs >= 0);
+
+COMMENT ON COLUMN inventory_count_items.counted_system_slabs IS 'Chapas disponíveis no sistema quando o lote foi contado (base da divergência)';
+COMMENT ON COLUMN inventory_count_items.adjusted_quantity IS 'Divergência aplicada na aprovação (contado - disponível no momento da contagem)';
@@ -0,0 +1,249 @@
+package label
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/skip2/go-qrcode"
+)
+
+// Layout descreve uma folha de etiquetas (todas as medidas em milímetros)
+type Layout struct {
+	Name        string
+	Description string
+	PageWidth   float64
+	PageHeight  float64
+	Columns     int
+	Rows        int
+	LabelWidth  float64
+	LabelHeight float64
+	MarginTop   float64
+	MarginLeft  float64
+	GapX        float64 // espaço horizontal entre etiquetas
+	GapY        float64 // espaço vertical entre etiquetas
+}
+
+// PerPage retorna a quantidade de etiquetas por folha
+func (l Layout) PerPage() int {
+	return l.Columns * l.Rows
+}
+
+// DefaultLayout é o layout usado quando nenhum é informado
+const DefaultLayout = "a4-3x8"
+
+// layouts são os formatos de papel mais comuns (Pimaco/Avery e rolos de impressora térmica)
+var layouts = []Layout{
+	{
+		Name: "a4-3x8", Description: "A4, 24 etiquetas de 70 × 37 mm",
+		PageWidth: 210, PageHeight: 297, Columns: 3, Rows: 8,
+		LabelWidth: 70, LabelHeight: 37, MarginTop: 0.5,
+	},
+	{
+		Name: "a4-2x7", Description: "A4, 14 etiquetas de 99,1 × 38,1 mm",
+		PageWidth: 210, PageHeight: 297, Columns: 2, Rows: 7,
+		LabelWidth: 99.1, LabelHeight: 38.1, MarginTop: 15.15, MarginLeft: 4.65, GapX: 2.5,
+	},
+	{
+		Name: "a4-2x4", Description: "A4, 8 etiquetas de 99,1 × 67,7 mm",
+		PageWidth: 210, PageHeight: 297, Columns: 2, Rows: 4,
+		LabelWidth: 99.1, LabelHeight: 67.7, MarginTop: 13.1, MarginLeft: 4.65, GapX: 2.5,
+	},
+	{
+		Name: "letter-3x10", Description: "Carta, 30 etiquetas de 66,7 × 25,4 mm",
+		PageWidth: 215.9, PageHeight: 279.4, Columns: 3, Rows: 10,
+		LabelWidth: 66.7, LabelHeight: 25.4, MarginTop: 12.7, MarginLeft: 4.8, GapX: 3.15,
+	},
+	{
+		Name: "letter-2x5", Description: "Carta, 10 etiquetas de 101,6 × 50,8 mm",
+		PageWidth: 215.9, PageHeight: 279.4, Columns: 2, Rows: 5,
+		LabelWidth: 101.6, LabelHeight: 50.8, MarginTop: 12.7, MarginLeft: 4, GapX: 4.7,
+	},
+	{
+		Name: "thermal-100x50", Description: "Rolo térmico, etiqueta de 100 × 50 mm",
+		PageWidth: 100, PageHeight: 50, Columns: 1, Rows: 1,
+		LabelWidth: 100, LabelHeight: 50,
+	},
+	{
+		Name: "thermal-60x40", Description: "Rolo térmico, etiqueta de 60 × 40 mm",
+		PageWidth: 60, PageHeight: 40, Columns: 1, Rows: 1,
+		LabelWidth: 60, LabelHeight: 40,
+	},
+}
+
+// Layouts retorna os layouts disponíveis
+func Layouts() []Layout {
+	result := make([]Layout, len(layouts))
+	copy(result, layouts)
+	return result
+}
+
+// FindLayout busca um layout pelo nome
+func FindLayout(name string) (Layout, bool) {
+	for _, l := range layouts {
+		if l.Name == name {
+			return l, true
+		}
+	}
+	return Layout{}, false
+}
+
+// Label é o conteúdo de uma etiqueta: título em destaque, linhas de apoio e QR code
+type Label struct {
+	Title     string
+	Lines     []string
+	QRContent string
+}
+
+// Options ajusta a impressão da folha
+type Options struct {
+	Start  int  // posição (1-based) da primeira etiqueta, para reaproveitar folhas já usadas
+	Border bool // desenha o contorno de corte de cada etiqueta
+}
+
+const (
+	ptToMM       = 25.4 / 72
+	maxTitleSize = 20.0
+	maxLineSize  = 10.0
+)
+
+// Render gera o PDF com as etiquetas distribuídas nas folhas do layout
+func Render(w io.Writer, layout Layout, labels []Label, opts Options) error {
+	if layout.PerPage() == 0 {
+		return fmt.Errorf("layout de etiquetas inválido: %s", layout.Name)
+	}
+
+	start := opts.Start - 1
+	if start < 0 {
+		start = 0
+	}
+	start %= layout.PerPage()
+
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{
+		UnitStr: "mm",
+		Size:    gofpdf.SizeType{Wd: layout.PageWidth, Ht: layout.PageHeight},
+	})
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetCreator("CAVA Stone Platform", true)
+	pdf.SetTitle("Etiquetas de lotes", true)
+
+	// Fontes padrão do PDF usam cp1252, que cobre a acentuação em português
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i, l := range labels {
+		slot := start + i
+		pos := slot % layout.PerPage()
+		if pos == 0 || i == 0 {
+			pdf.AddPage()
+		}
+
+		col := pos % layout.Columns
+		row := pos / layout.Columns
+		x := layout.MarginLeft + float64(col)*(layout.LabelWidth+layout.GapX)
+		y := layout.MarginTop + float64(row)*(layout.LabelHeight+layout.GapY)
+
+		if err := drawLabel(pdf, tr, x, y, layout.LabelWidth, layout.LabelHeight, l, opts.Border); err != nil {
+			return err
+		}
+	}
+
+	if len(labels) == 0 {
+		pdf.AddPage()
+	}
+
+	return pdf.Output(w)
+}
+
+// drawLabel desenha o QR code à esquerda e os textos à direita da etiqueta
+func drawLabel(pdf *gofpdf.Fpdf, tr func(string) string, x, y, w, h float64, l Label, border bool) error {
+	if border {
+		pdf.SetDrawColor(180, 180, 180)
+		pdf.SetLineWidth(0.1)
+		pdf.Rect(x, y, w, h, "D")
+	}
+
+	pad := math.Min(3, h*0.08)
+	innerH := h - 2*pad
+	textX := x + pad
+	textW := w - 2*pad
+
+	if l.QRContent != "" {
+		size := math.Min(innerH, w*0.42)
+		if err := drawQRCode(pdf, l.QRContent, x+pad, y+(h-size)/2, size); err != nil {
+			return err
+		}
+		textX += size + pad
+		textW -= size + pad
+	}
+
+	// Título ocupa ~30% da altura; linhas dividem o restante
+	lineCount := len(l.Lines)
+	if lineCount == 0 {
+		lineCount = 1
+	}
+	titleH := innerH * 0.3
+	lineH := (innerH - titleH) / float64(lineCount)
+	titleSize := math.Min(maxTitleSize, titleH/ptToMM*0.8)
+	lineSize := math.Min(maxLineSize, lineH/ptToMM*0.8)
+
+	pdf.SetTextColor(0, 0, 0)
+	pdf.SetFont("Helvetica", "B", titleSize)
+	pdf.SetXY(textX, y+pad)
+	pdf.CellFormat(textW, titleH, fitText(pdf, tr(l.Title), textW), "", 0, "LM", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", lineSize)
+	for i, line := range l.Lines {
+		pdf.SetXY(textX, y+pad+titleH+float64(i)*lineH)
+		pdf.CellFormat(textW, lineH, fitText(pdf, tr(line), textW), "", 0, "LM", false, 0, "")
+	}
+
+	return nil
+}
+
+// drawQRCode desenha o QR code em vetor (sequências de módulos escuros viram retângulos)
+func drawQRCode(pdf *gofpdf.Fpdf, content string, x, y, size float64) error {
+	qr, err := qrcode.New(content, qrcode.Medium)
+	if err != nil {
+		return fmt.Errorf("falha ao gerar QR code: %w", err)
+	}
+	qr.DisableBorder = true
+
+	bitmap := qr.Bitmap()
+	if len(bitmap) == 0 {
+		return nil
+	}
+	module := size / float64(len(bitmap))
+
+	pdf.SetFillColor(0, 0, 0)
+	for row, line := range bitmap {
+		for col := 0; col < len(line); {
+			if !line[col] {
+				col++
+				continue
+			}
+			run := col
+			for run < len(line) && line[run] {
+				run++
+			}
+			pdf.Rect(x+float64(col)*module, y+float64(row)*module, float64(run-col)*module, module, "F")
+			col = run
+		}
+	}
+
+	return nil
+}
+
+// fitText corta o texto com reticências para caber na largura
+func fitText(pdf *gofpdf.Fpdf, text string, width float64) string {
+	if pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	// Texto já traduzido para cp1252 (um byte por caractere)
+	const ellipsis = "\x85"
+	for len(text) > 0 && pdf.GetStringWidth(text+ellipsis) > width {
+		text = text[:len(text)-1]
+	}
+	return text + ellipsis
+}
@@ -0,0 +1,222 @@
+package spreadsheet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format representa o formato de planilha suportado
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// FormatFromFilename identifica o formato pela extensão do arquivo
+func FormatFromFilename(filename string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return FormatCSV, nil
+	case ".xlsx":
+		return FormatXLSX, nil
+	default:
+		return "", fmt.Errorf("formato de arquivo não suportado. Use .csv ou .xlsx")
+	}
+}
+
+// ReadRecords lê todas as linhas da planilha (primeira aba no caso de XLSX)
+func ReadRecords(r io.Reader, format Format) ([][]string, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatXLSX:
+		return readXLSX(r)
+	default:
+		return nil, fmt.Errorf("formato de arquivo não suportado: %s", format)
+	}
+}
+
+func readCSV(r io.Reader) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remover BOM UTF-8 (comum em arquivos exportados pelo Excel)
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("CSV inválido: %w", err)
+	}
+
+	return records, nil
+}
+
+// detectDelimiter escolhe entre vírgula e ponto e vírgula com base na primeira linha
+func detectDelimiter(data []byte) rune {
+	firstLine, _ := bufio.NewReader(bytes.NewReader(data)).ReadString('\n')
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		return ';'
+	}
+	return ','
+}
+
+func readXLSX(r io.Reader) ([][]string, error) {
+	file, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("XLSX inválido: %w", err)
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, fmt.Errorf("XLSX sem planilhas")
+	}
+
+	rows, err := file.GetRows(sheets[0])
+	if err != nil {
+		return nil, fmt.Errorf("XLSX inválido: %w", err)
+	}
+
+	return rows, nil
+}
+
+// Writer escreve linhas de planilha de forma incremental
+type Writer interface {
+	// WriteRow escreve uma linha; valores numéricos são preservados no XLSX
+	WriteRow(values []interface{}) error
+	// Close finaliza o arquivo e descarrega o conteúdo no destino
+	Close() error
+}
+
+// ContentType retorna o MIME type do formato
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// NewWriter cria um Writer para o formato informado
+func NewWriter(w io.Writer, format Format, sheetName string) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		// BOM UTF-8 para o Excel reconhecer acentuação
+		if _, err := w.Write([]byte("\xef\xbb\xbf")); err != nil {
+			return nil, err
+		}
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w, sheetName)
+	default:
+		return nil, fmt.Errorf("formato de arquivo não suportado: %s", format)
+	}
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		record[i] = formatCSVValue(v)
+	}
+	if err := c.w.Write(record); err != nil {
+		return err
+	}
+	// Descarregar a cada linha para manter o streaming
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func formatCSVValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return escapeFormula(val)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(val)
+	case bool:
+		if val {
+			return "Sim"
+		}
+		return "Não"
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// escapeFormula prefixa com apóstrofo textos que o Excel interpretaria como fórmula (injeção de fórmulas no CSV)
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+type xlsxWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+}
+
+func newXLSXWriter(w io.Writer, sheetName string) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	if sheetName == "" {
+		sheetName = "Sheet1"
+	}
+	if err := file.SetSheetName("Sheet1", sheetName); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	stream, err := file.NewStreamWriter(sheetName)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxWriter{out: w, file: file, stream: stream}, nil
+}
+
+func (x *xlsxWriter) WriteRow(values []interface{}) error {
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	return x.stream.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+	if err := x.stream.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.out)
+}
@@ -68,6 +68,8 @@ func getErrorMessage(fe validator.FieldError) string {
 	switch fe.Tag() {
 	case "required":
 		return fmt.Sprintf("%s é obrigatório", field)
+	case "required_without":
+		return fmt.Sprintf("%s é obrigatório quando %s não é informado", field, fe.Param())
 	case "email":
 		return fmt.Sprintf("%s deve ser um email válido", field)
 	case "min":